@@ -0,0 +1,197 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const minQueueSize = 1
+const minReAlertInterval = time.Second
+
+// ArgsAlertsManager is the DTO used for the creating a new alerts manager instance
+type ArgsAlertsManager struct {
+	Log                    logger.Logger
+	Timer                  core.Timer
+	Notifiers              []Notifier
+	QueueSize              int
+	RequestTime            time.Duration
+	DefaultReAlertInterval time.Duration
+	ReAlertIntervals       map[core.AlertType]time.Duration
+}
+
+type alertsManager struct {
+	log                    logger.Logger
+	timer                  core.Timer
+	notifiers              []Notifier
+	requestTime            time.Duration
+	defaultReAlertInterval time.Duration
+	reAlertIntervals       map[core.AlertType]time.Duration
+	alertsChan             chan core.Alert
+	cancel                 func()
+
+	mutLastSent sync.Mutex
+	lastSent    map[string]int64
+}
+
+// NewAlertsManager creates a new alerts manager instance that deduplicates the received alerts and dispatches them
+// towards all the provided notifiers
+func NewAlertsManager(args ArgsAlertsManager) (*alertsManager, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	reAlertIntervals := make(map[core.AlertType]time.Duration)
+	for alertType, interval := range args.ReAlertIntervals {
+		reAlertIntervals[alertType] = interval
+	}
+
+	am := &alertsManager{
+		log:                    args.Log,
+		timer:                  args.Timer,
+		notifiers:              args.Notifiers,
+		requestTime:            args.RequestTime,
+		defaultReAlertInterval: args.DefaultReAlertInterval,
+		reAlertIntervals:       reAlertIntervals,
+		alertsChan:             make(chan core.Alert, args.QueueSize),
+		lastSent:               make(map[string]int64),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	am.cancel = cancel
+	go am.processLoop(ctx)
+
+	return am, nil
+}
+
+func checkArgs(args ArgsAlertsManager) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	for i, notifier := range args.Notifiers {
+		if check.IfNil(notifier) {
+			return fmt.Errorf("%w at index %d", ErrNilNotifier, i)
+		}
+	}
+	if args.QueueSize < minQueueSize {
+		return fmt.Errorf("%w for QueueSize, got: %d, minimum: %d", ErrInvalidValue, args.QueueSize, minQueueSize)
+	}
+	if args.RequestTime <= 0 {
+		return fmt.Errorf("%w for RequestTime, got: %v", ErrInvalidValue, args.RequestTime)
+	}
+	if args.DefaultReAlertInterval < minReAlertInterval {
+		return fmt.Errorf("%w for DefaultReAlertInterval, got: %v, minimum: %v",
+			ErrInvalidValue, args.DefaultReAlertInterval, minReAlertInterval)
+	}
+	for alertType, interval := range args.ReAlertIntervals {
+		if interval < minReAlertInterval {
+			return fmt.Errorf("%w for the re-alert interval of %s, got: %v, minimum: %v",
+				ErrInvalidValue, alertType, interval, minReAlertInterval)
+		}
+	}
+
+	return nil
+}
+
+// Alert will queue the provided alert if the same alert was not dispatched in the last re-alert interval
+func (am *alertsManager) Alert(alert core.Alert) {
+	now := am.timer.NowUnix()
+	if alert.Timestamp == 0 {
+		alert.Timestamp = now
+	}
+
+	if !am.shouldDispatch(alert, now) {
+		am.log.Trace("alertsManager.Alert: duplicated alert", "type", alert.Type, "source", alert.Source)
+		return
+	}
+
+	select {
+	case am.alertsChan <- alert:
+	default:
+		am.log.Warn("alertsManager.Alert: alerts queue is full, dropping alert",
+			"type", alert.Type, "source", alert.Source, "message", alert.Message)
+	}
+}
+
+func (am *alertsManager) shouldDispatch(alert core.Alert, now int64) bool {
+	interval, found := am.reAlertIntervals[alert.Type]
+	if !found {
+		interval = am.defaultReAlertInterval
+	}
+
+	key := alert.DeduplicationKey()
+
+	am.mutLastSent.Lock()
+	defer am.mutLastSent.Unlock()
+
+	lastSent, found := am.lastSent[key]
+	if found && now-lastSent < int64(interval.Seconds()) {
+		return false
+	}
+
+	am.lastSent[key] = now
+
+	return true
+}
+
+func (am *alertsManager) processLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			am.log.Debug("alerts manager main loop is closing...")
+			return
+		case alert := <-am.alertsChan:
+			am.dispatch(ctx, alert)
+		}
+	}
+}
+
+func (am *alertsManager) dispatch(ctx context.Context, alert core.Alert) {
+	for _, notifier := range am.notifiers {
+		requestContext, cancel := context.WithTimeout(ctx, am.requestTime)
+		err := notifier.Notify(requestContext, alert)
+		cancel()
+		if err != nil {
+			am.log.Error("alertsManager.dispatch", "notifier", notifier.Name(),
+				"type", alert.Type, "source", alert.Source, "error", err)
+		}
+	}
+}
+
+// Flush will dispatch all the queued alerts. It returns an error if the context ends before the queue is empty
+func (am *alertsManager) Flush(ctx context.Context) error {
+	for {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		select {
+		case alert := <-am.alertsChan:
+			am.dispatch(ctx, alert)
+		default:
+			return nil
+		}
+	}
+}
+
+// Close will stop the dispatching go routine
+func (am *alertsManager) Close() error {
+	am.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (am *alertsManager) IsInterfaceNil() bool {
+	return am == nil
+}
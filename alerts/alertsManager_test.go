@@ -0,0 +1,286 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsAlertsManager() ArgsAlertsManager {
+	return ArgsAlertsManager{
+		Log:                    &testsCommon.LoggerStub{},
+		Timer:                  testsCommon.NewTimerStub(),
+		Notifiers:              []Notifier{&testsCommon.NotifierStub{}},
+		QueueSize:              10,
+		RequestTime:            time.Second,
+		DefaultReAlertInterval: time.Minute,
+		ReAlertIntervals: map[core.AlertType]time.Duration{
+			core.AlertLowFunds: time.Hour,
+		},
+	}
+}
+
+func createAlert(alertType core.AlertType, source string) core.Alert {
+	return core.Alert{
+		Type:     alertType,
+		Severity: core.AlertSeverityWarning,
+		Source:   source,
+		Message:  "message",
+	}
+}
+
+func TestNewAlertsManager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.Log = nil
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.Timer = nil
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("nil notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.Notifiers = append(args.Notifiers, nil)
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrNilNotifier))
+		assert.True(t, strings.Contains(err.Error(), "at index 1"))
+	})
+	t.Run("invalid queue size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.QueueSize = 0
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for QueueSize"))
+	})
+	t.Run("invalid request time should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.RequestTime = 0
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for RequestTime"))
+	})
+	t.Run("invalid default re-alert interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.DefaultReAlertInterval = time.Millisecond
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for DefaultReAlertInterval"))
+	})
+	t.Run("invalid re-alert interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.ReAlertIntervals[core.AlertInvalidBatch] = 0
+
+		am, err := NewAlertsManager(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), string(core.AlertInvalidBatch)))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+
+		am, err := NewAlertsManager(args)
+		assert.False(t, check.IfNil(am))
+		assert.Nil(t, err)
+
+		assert.Nil(t, am.Close())
+	})
+}
+
+func TestAlertsManager_Alert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should dispatch to all notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return 1000
+		}
+		args.Timer = timer
+
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		notifyHandler := func(ctx context.Context, alert core.Alert) error {
+			defer wg.Done()
+
+			assert.Equal(t, core.AlertClientUnavailable, alert.Type)
+			assert.Equal(t, "source", alert.Source)
+			assert.Equal(t, int64(1000), alert.Timestamp)
+			return nil
+		}
+		args.Notifiers = []Notifier{
+			&testsCommon.NotifierStub{
+				NotifyCalled: notifyHandler,
+			},
+			&testsCommon.NotifierStub{
+				NotifyCalled: func(ctx context.Context, alert core.Alert) error {
+					_ = notifyHandler(ctx, alert)
+					return errors.New("expected error")
+				},
+			},
+		}
+
+		am, _ := NewAlertsManager(args)
+		defer func() {
+			_ = am.Close()
+		}()
+
+		am.Alert(createAlert(core.AlertClientUnavailable, "source"))
+		wg.Wait()
+	})
+	t.Run("should deduplicate alerts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		now := int64(1000)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return atomic.LoadInt64(&now)
+		}
+		args.Timer = timer
+
+		am, _ := NewAlertsManager(args)
+		_ = am.Close()
+
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertClientUnavailable, "source"), now))
+		assert.False(t, am.shouldDispatch(createAlert(core.AlertClientUnavailable, "source"), now+59))
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertClientUnavailable, "another source"), now+59))
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertInvalidBatch, "source"), now+59))
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertClientUnavailable, "source"), now+60))
+
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertLowFunds, "source"), now))
+		assert.False(t, am.shouldDispatch(createAlert(core.AlertLowFunds, "source"), now+3599))
+		assert.True(t, am.shouldDispatch(createAlert(core.AlertLowFunds, "source"), now+3600))
+	})
+	t.Run("duplicated alerts should not be dispatched", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		numCalls := uint32(0)
+		args.Notifiers = []Notifier{
+			&testsCommon.NotifierStub{
+				NotifyCalled: func(ctx context.Context, alert core.Alert) error {
+					atomic.AddUint32(&numCalls, 1)
+					return nil
+				},
+			},
+		}
+
+		am, _ := NewAlertsManager(args)
+		defer func() {
+			_ = am.Close()
+		}()
+
+		for i := 0; i < 5; i++ {
+			am.Alert(createAlert(core.AlertContractPaused, "source"))
+		}
+
+		time.Sleep(time.Millisecond * 200)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("full queue should drop alerts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		args.QueueSize = 1
+
+		am, _ := NewAlertsManager(args)
+		_ = am.Close()
+		time.Sleep(time.Millisecond * 100) // allow the go routine to stop
+
+		am.Alert(createAlert(core.AlertClientUnavailable, "source 1"))
+		am.Alert(createAlert(core.AlertClientUnavailable, "source 2"))
+		assert.Equal(t, 1, len(am.alertsChan))
+	})
+}
+
+func TestAlertsManager_Flush(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should dispatch the queued alerts", func(t *testing.T) {
+		t.Parallel()
+
+		numNotified := uint32(0)
+		args := createMockArgsAlertsManager()
+		args.Notifiers = []Notifier{
+			&testsCommon.NotifierStub{
+				NotifyCalled: func(ctx context.Context, alert core.Alert) error {
+					atomic.AddUint32(&numNotified, 1)
+					return nil
+				},
+			},
+		}
+
+		am, _ := NewAlertsManager(args)
+		_ = am.Close()
+		time.Sleep(time.Millisecond * 100) // allow the go routine to stop
+
+		am.Alert(createAlert(core.AlertClientUnavailable, "source 1"))
+		am.Alert(createAlert(core.AlertClientUnavailable, "source 2"))
+
+		err := am.Flush(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(am.alertsChan))
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numNotified))
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlertsManager()
+		am, _ := NewAlertsManager(args)
+		_ = am.Close()
+		time.Sleep(time.Millisecond * 100) // allow the go routine to stop
+
+		am.Alert(createAlert(core.AlertClientUnavailable, "source 1"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := am.Flush(ctx)
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 1, len(am.alertsChan))
+	})
+}
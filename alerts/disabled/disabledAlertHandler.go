@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// DisabledAlertHandler implementation in case no alerting is used
+type DisabledAlertHandler struct{}
+
+// Alert does nothing
+func (dah *DisabledAlertHandler) Alert(_ core.Alert) {
+}
+
+// Flush returns nil
+func (dah *DisabledAlertHandler) Flush(_ context.Context) error {
+	return nil
+}
+
+// Close returns nil
+func (dah *DisabledAlertHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dah *DisabledAlertHandler) IsInterfaceNil() bool {
+	return dah == nil
+}
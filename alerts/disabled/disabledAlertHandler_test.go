@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledAlertHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := &DisabledAlertHandler{}
+	assert.False(t, check.IfNil(disabled))
+	disabled.Alert(core.Alert{})
+	assert.Nil(t, disabled.Flush(context.Background()))
+	assert.Nil(t, disabled.Close())
+}
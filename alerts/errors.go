@@ -0,0 +1,15 @@
+package alerts
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilNotifier signals that a nil notifier was provided
+var ErrNilNotifier = errors.New("nil notifier")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
@@ -0,0 +1,91 @@
+package factory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts"
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts/disabled"
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts/notifiers"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// CreateAlertHandler generates an implementation of ClosableAlertHandler
+func CreateAlertHandler(cfg config.AlertsConfig, log logger.Logger, timer core.Timer) (alerts.ClosableAlertHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.DisabledAlertHandler{}, nil
+	}
+
+	alertNotifiers, err := createNotifiers(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	reAlertIntervals, err := createReAlertIntervals(cfg.ReAlertIntervalsInSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	argsAlertsManager := alerts.ArgsAlertsManager{
+		Log:                    log,
+		Timer:                  timer,
+		Notifiers:              alertNotifiers,
+		QueueSize:              cfg.QueueSize,
+		RequestTime:            time.Duration(cfg.RequestTimeInSeconds) * time.Second,
+		DefaultReAlertInterval: time.Duration(cfg.DefaultReAlertIntervalInSeconds) * time.Second,
+		ReAlertIntervals:       reAlertIntervals,
+	}
+
+	return alerts.NewAlertsManager(argsAlertsManager)
+}
+
+func createNotifiers(cfg config.AlertsConfig) ([]alerts.Notifier, error) {
+	alertNotifiers := make([]alerts.Notifier, 0, len(cfg.Webhooks)+1)
+	for _, webhookConfig := range cfg.Webhooks {
+		argsWebhookNotifier := notifiers.ArgsWebhookNotifier{
+			Name:   webhookConfig.Name,
+			URL:    webhookConfig.URL,
+			Format: notifiers.WebhookFormat(webhookConfig.Format),
+		}
+
+		notifier, err := notifiers.NewWebhookNotifier(argsWebhookNotifier)
+		if err != nil {
+			return nil, fmt.Errorf("%w for webhook %q", err, webhookConfig.Name)
+		}
+
+		alertNotifiers = append(alertNotifiers, notifier)
+	}
+
+	if cfg.File.Enabled {
+		notifier, err := notifiers.NewFileNotifier(cfg.File.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		alertNotifiers = append(alertNotifiers, notifier)
+	}
+
+	return alertNotifiers, nil
+}
+
+func createReAlertIntervals(intervalsInSeconds map[string]uint64) (map[core.AlertType]time.Duration, error) {
+	knownTypes := make(map[core.AlertType]struct{})
+	for _, alertType := range core.AlertTypes {
+		knownTypes[alertType] = struct{}{}
+	}
+
+	reAlertIntervals := make(map[core.AlertType]time.Duration)
+	for name, intervalInSeconds := range intervalsInSeconds {
+		alertType := core.AlertType(name)
+		_, found := knownTypes[alertType]
+		if !found {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownAlertType, name)
+		}
+
+		reAlertIntervals[alertType] = time.Duration(intervalInSeconds) * time.Second
+	}
+
+	return reAlertIntervals, nil
+}
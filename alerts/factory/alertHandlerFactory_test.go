@@ -0,0 +1,97 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts/disabled"
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts/notifiers"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockAlertsConfig(t *testing.T) config.AlertsConfig {
+	return config.AlertsConfig{
+		Enabled:                         true,
+		QueueSize:                       10,
+		RequestTimeInSeconds:            5,
+		DefaultReAlertIntervalInSeconds: 300,
+		ReAlertIntervalsInSeconds: map[string]uint64{
+			string(core.AlertLowFunds): 3600,
+		},
+		Webhooks: []config.WebhookNotifierConfig{
+			{
+				Name:   "ops",
+				URL:    "http://localhost",
+				Format: string(notifiers.SlackWebhookFormat),
+			},
+		},
+		File: config.FileNotifierConfig{
+			Enabled: true,
+			Path:    filepath.Join(t.TempDir(), "alerts.log"),
+		},
+	}
+}
+
+func TestCreateAlertHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled alerts should create the disabled component", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAlertsConfig(t)
+		cfg.Enabled = false
+
+		alertHandler, err := CreateAlertHandler(cfg, &testsCommon.LoggerStub{}, testsCommon.NewTimerStub())
+		assert.Nil(t, err)
+		assert.IsType(t, &disabled.DisabledAlertHandler{}, alertHandler)
+	})
+	t.Run("invalid webhook should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAlertsConfig(t)
+		cfg.Webhooks[0].Format = "xml"
+
+		alertHandler, err := CreateAlertHandler(cfg, &testsCommon.LoggerStub{}, testsCommon.NewTimerStub())
+		assert.True(t, check.IfNil(alertHandler))
+		assert.True(t, errors.Is(err, notifiers.ErrInvalidWebhookFormat))
+		assert.True(t, strings.Contains(err.Error(), "ops"))
+	})
+	t.Run("invalid file notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAlertsConfig(t)
+		cfg.File.Path = ""
+
+		alertHandler, err := CreateAlertHandler(cfg, &testsCommon.LoggerStub{}, testsCommon.NewTimerStub())
+		assert.True(t, check.IfNil(alertHandler))
+		assert.Equal(t, notifiers.ErrEmptyPath, err)
+	})
+	t.Run("unknown alert type should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAlertsConfig(t)
+		cfg.ReAlertIntervalsInSeconds["unknown"] = 10
+
+		alertHandler, err := CreateAlertHandler(cfg, &testsCommon.LoggerStub{}, testsCommon.NewTimerStub())
+		assert.True(t, check.IfNil(alertHandler))
+		assert.True(t, errors.Is(err, ErrUnknownAlertType))
+		assert.True(t, strings.Contains(err.Error(), "unknown"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAlertsConfig(t)
+
+		alertHandler, err := CreateAlertHandler(cfg, &testsCommon.LoggerStub{}, testsCommon.NewTimerStub())
+		assert.Nil(t, err)
+		assert.Equal(t, "*alerts.alertsManager", fmt.Sprintf("%T", alertHandler))
+		assert.Nil(t, alertHandler.Close())
+	})
+}
@@ -0,0 +1,6 @@
+package factory
+
+import "errors"
+
+// ErrUnknownAlertType signals that an unknown alert type was provided
+var ErrUnknownAlertType = errors.New("unknown alert type")
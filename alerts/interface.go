@@ -0,0 +1,21 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// Notifier defines a sink able to deliver an alert to an external system
+type Notifier interface {
+	Notify(ctx context.Context, alert core.Alert) error
+	Name() string
+	IsInterfaceNil() bool
+}
+
+// ClosableAlertHandler defines an alert handler that needs to be flushed and closed
+type ClosableAlertHandler interface {
+	core.AlertHandler
+	Flush(ctx context.Context) error
+	Close() error
+}
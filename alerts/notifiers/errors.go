@@ -0,0 +1,15 @@
+package notifiers
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrEmptyPath signals that an empty file path was provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrInvalidWebhookFormat signals that an invalid webhook format was provided
+var ErrInvalidWebhookFormat = errors.New("invalid webhook format")
+
+// ErrUnexpectedStatusCode signals that the webhook responded with an unexpected status code
+var ErrUnexpectedStatusCode = errors.New("unexpected status code")
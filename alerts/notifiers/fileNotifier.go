@@ -0,0 +1,63 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+const fileNotifierName = "file"
+
+type fileNotifier struct {
+	mut  sync.Mutex
+	path string
+}
+
+// NewFileNotifier creates a new notifier able to append alerts, one JSON object per line, in the provided file
+func NewFileNotifier(path string) (*fileNotifier, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyPath
+	}
+
+	return &fileNotifier{
+		path: path,
+	}, nil
+}
+
+// Notify will append the alert in the configured file
+func (notifier *fileNotifier) Notify(_ context.Context, alert core.Alert) error {
+	line, err := json.Marshal(&alert)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	file, err := os.OpenFile(notifier.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(line)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Name returns the notifier's name
+func (notifier *fileNotifier) Name() string {
+	return fileNotifierName
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *fileNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -0,0 +1,74 @@
+package notifiers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewFileNotifier("")
+		assert.True(t, check.IfNil(notifier))
+		assert.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewFileNotifier("alerts.log")
+		assert.False(t, check.IfNil(notifier))
+		assert.Nil(t, err)
+		assert.Equal(t, fileNotifierName, notifier.Name())
+	})
+}
+
+func TestFileNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid path should error", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, _ := NewFileNotifier(filepath.Join(t.TempDir(), "missing directory", "alerts.log"))
+		err := notifier.Notify(context.Background(), createMockAlert())
+		assert.NotNil(t, err)
+	})
+	t.Run("should append alerts", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "alerts.log")
+		notifier, _ := NewFileNotifier(path)
+
+		firstAlert := createMockAlert()
+		secondAlert := createMockAlert()
+		secondAlert.Type = core.AlertNotWhitelisted
+		require.Nil(t, notifier.Notify(context.Background(), firstAlert))
+		require.Nil(t, notifier.Notify(context.Background(), secondAlert))
+
+		file, err := os.Open(path)
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		alerts := make([]core.Alert, 0)
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			alert := core.Alert{}
+			require.Nil(t, json.Unmarshal(scanner.Bytes(), &alert))
+			alerts = append(alerts, alert)
+		}
+		assert.Equal(t, []core.Alert{firstAlert, secondAlert}, alerts)
+	})
+}
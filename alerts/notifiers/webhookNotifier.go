@@ -0,0 +1,141 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// WebhookFormat defines the payload format used when posting an alert on a webhook
+type WebhookFormat string
+
+const (
+	// JSONWebhookFormat posts the alert as it is, JSON encoded
+	JSONWebhookFormat WebhookFormat = "json"
+
+	// SlackWebhookFormat posts the alert as a Slack incoming webhook message
+	SlackWebhookFormat WebhookFormat = "slack"
+
+	// DiscordWebhookFormat posts the alert as a Discord webhook message
+	DiscordWebhookFormat WebhookFormat = "discord"
+)
+
+const maxResponseBodyToLog = 256
+
+// ArgsWebhookNotifier is the DTO used for the creating a new webhook notifier instance
+type ArgsWebhookNotifier struct {
+	Name   string
+	URL    string
+	Format WebhookFormat
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+type discordPayload struct {
+	Content string `json:"content"`
+}
+
+type webhookNotifier struct {
+	name       string
+	url        string
+	format     WebhookFormat
+	httpClient HTTPClient
+}
+
+// NewWebhookNotifier creates a new notifier able to post alerts on a webhook
+func NewWebhookNotifier(args ArgsWebhookNotifier) (*webhookNotifier, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+
+	switch args.Format {
+	case JSONWebhookFormat, SlackWebhookFormat, DiscordWebhookFormat:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidWebhookFormat, args.Format)
+	}
+
+	name := args.Name
+	if len(name) == 0 {
+		name = string(args.Format) + " webhook"
+	}
+
+	return &webhookNotifier{
+		name:       name,
+		url:        args.URL,
+		format:     args.Format,
+		httpClient: http.DefaultClient,
+	}, nil
+}
+
+// Notify will post the alert on the configured webhook
+func (notifier *webhookNotifier) Notify(ctx context.Context, alert core.Alert) error {
+	body, err := notifier.createPayload(alert)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notifier.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := ioutil.ReadAll(response.Body)
+		if len(responseBody) > maxResponseBodyToLog {
+			responseBody = responseBody[:maxResponseBodyToLog]
+		}
+
+		return fmt.Errorf("%w %d: %q", ErrUnexpectedStatusCode, response.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+func (notifier *webhookNotifier) createPayload(alert core.Alert) ([]byte, error) {
+	switch notifier.format {
+	case SlackWebhookFormat:
+		return json.Marshal(&slackPayload{
+			Text: formatAlert(alert),
+		})
+	case DiscordWebhookFormat:
+		return json.Marshal(&discordPayload{
+			Content: formatAlert(alert),
+		})
+	default:
+		return json.Marshal(&alert)
+	}
+}
+
+func formatAlert(alert core.Alert) string {
+	timestamp := time.Unix(alert.Timestamp, 0).UTC().Format(time.RFC3339)
+
+	return fmt.Sprintf("[%s] %s alert from %s at %s: %s",
+		alert.Severity, alert.Type, alert.Source, timestamp, alert.Message)
+}
+
+// Name returns the notifier's name
+func (notifier *webhookNotifier) Name() string {
+	return notifier.name
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *webhookNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -0,0 +1,164 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockAlert() core.Alert {
+	return core.Alert{
+		Type:      core.AlertContractPaused,
+		Severity:  core.AlertSeverityCritical,
+		Source:    "EthereumToElrond",
+		Message:   "multisig contract paused",
+		Timestamp: 1640995200,
+	}
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewWebhookNotifier(ArgsWebhookNotifier{
+			Format: JSONWebhookFormat,
+		})
+		assert.True(t, check.IfNil(notifier))
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewWebhookNotifier(ArgsWebhookNotifier{
+			URL:    "http://localhost",
+			Format: "xml",
+		})
+		assert.True(t, check.IfNil(notifier))
+		assert.True(t, errors.Is(err, ErrInvalidWebhookFormat))
+	})
+	t.Run("should work with default name", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewWebhookNotifier(ArgsWebhookNotifier{
+			URL:    "http://localhost",
+			Format: SlackWebhookFormat,
+		})
+		assert.False(t, check.IfNil(notifier))
+		assert.Nil(t, err)
+		assert.Equal(t, "slack webhook", notifier.Name())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewWebhookNotifier(ArgsWebhookNotifier{
+			Name:   "ops",
+			URL:    "http://localhost",
+			Format: DiscordWebhookFormat,
+		})
+		assert.False(t, check.IfNil(notifier))
+		assert.Nil(t, err)
+		assert.Equal(t, "ops", notifier.Name())
+	})
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	expectedText := "[critical] contract_paused alert from EthereumToElrond at 2022-01-01T00:00:00Z: multisig contract paused"
+
+	testFormat := func(format WebhookFormat, checkBody func(body []byte)) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+			body, err := ioutil.ReadAll(req.Body)
+			require.Nil(t, err)
+			checkBody(body)
+
+			rw.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier, _ := NewWebhookNotifier(ArgsWebhookNotifier{
+			URL:    server.URL,
+			Format: format,
+		})
+		err := notifier.Notify(context.Background(), createMockAlert())
+		assert.Nil(t, err)
+	}
+
+	t.Run("json format", func(t *testing.T) {
+		t.Parallel()
+
+		testFormat(JSONWebhookFormat, func(body []byte) {
+			alert := core.Alert{}
+			err := json.Unmarshal(body, &alert)
+			require.Nil(t, err)
+			assert.Equal(t, createMockAlert(), alert)
+		})
+	})
+	t.Run("slack format", func(t *testing.T) {
+		t.Parallel()
+
+		testFormat(SlackWebhookFormat, func(body []byte) {
+			payload := slackPayload{}
+			err := json.Unmarshal(body, &payload)
+			require.Nil(t, err)
+			assert.Equal(t, expectedText, payload.Text)
+		})
+	})
+	t.Run("discord format", func(t *testing.T) {
+		t.Parallel()
+
+		testFormat(DiscordWebhookFormat, func(body []byte) {
+			payload := discordPayload{}
+			err := json.Unmarshal(body, &payload)
+			require.Nil(t, err)
+			assert.Equal(t, expectedText, payload.Content)
+		})
+	})
+	t.Run("unexpected status code should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusForbidden)
+			_, _ = rw.Write([]byte("invalid token"))
+		}))
+		defer server.Close()
+
+		notifier, _ := NewWebhookNotifier(ArgsWebhookNotifier{
+			URL:    server.URL,
+			Format: JSONWebhookFormat,
+		})
+		err := notifier.Notify(context.Background(), createMockAlert())
+		assert.True(t, errors.Is(err, ErrUnexpectedStatusCode))
+		assert.True(t, strings.Contains(err.Error(), "403"))
+		assert.True(t, strings.Contains(err.Error(), "invalid token"))
+	})
+	t.Run("unreachable webhook should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+		url := server.URL
+		server.Close()
+
+		notifier, _ := NewWebhookNotifier(ArgsWebhookNotifier{
+			URL:    url,
+			Format: JSONWebhookFormat,
+		})
+		err := notifier.Notify(context.Background(), createMockAlert())
+		assert.NotNil(t, err)
+	})
+}
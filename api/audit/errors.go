@@ -0,0 +1,6 @@
+package audit
+
+import "errors"
+
+// ErrEmptyPath signals that an empty path was provided
+var ErrEmptyPath = errors.New("empty path")
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+)
+
+type fileAuditLog struct {
+	mut  sync.Mutex
+	path string
+}
+
+// NewFileAuditLog creates a new audit log able to append entries, one JSON object per line, in the provided file
+func NewFileAuditLog(path string) (*fileAuditLog, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyPath
+	}
+
+	return &fileAuditLog{
+		path: path,
+	}, nil
+}
+
+// Log will append the entry in the configured file
+func (auditLog *fileAuditLog) Log(entry shared.AuditEntry) error {
+	line, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	auditLog.mut.Lock()
+	defer auditLog.mut.Unlock()
+
+	file, err := os.OpenFile(auditLog.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(line)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (auditLog *fileAuditLog) IsInterfaceNil() bool {
+	return auditLog == nil
+}
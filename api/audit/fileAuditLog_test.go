@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockAuditEntry() shared.AuditEntry {
+	return shared.AuditEntry{
+		Timestamp:     1650000000,
+		RemoteAddress: "127.0.0.1",
+		Identity:      "token",
+		Method:        "POST",
+		Path:          "/operator/signatures/clear",
+		StatusCode:    200,
+	}
+}
+
+func TestNewFileAuditLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, err := NewFileAuditLog("")
+		assert.True(t, check.IfNil(auditLog))
+		assert.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, err := NewFileAuditLog("audit.log")
+		assert.False(t, check.IfNil(auditLog))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFileAuditLog_Log(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid path should error", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, _ := NewFileAuditLog(filepath.Join(t.TempDir(), "missing directory", "audit.log"))
+		err := auditLog.Log(createMockAuditEntry())
+		assert.NotNil(t, err)
+	})
+	t.Run("should append entries", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "audit.log")
+		auditLog, _ := NewFileAuditLog(path)
+
+		firstEntry := createMockAuditEntry()
+		secondEntry := createMockAuditEntry()
+		secondEntry.Identity = ""
+		secondEntry.StatusCode = 401
+		secondEntry.Error = "unauthorized"
+		require.Nil(t, auditLog.Log(firstEntry))
+		require.Nil(t, auditLog.Log(secondEntry))
+
+		file, err := os.Open(path)
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		entries := make([]shared.AuditEntry, 0)
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			entry := shared.AuditEntry{}
+			require.Nil(t, json.Unmarshal(scanner.Bytes(), &entry))
+			entries = append(entries, entry)
+		}
+		assert.Equal(t, []shared.AuditEntry{firstEntry, secondEntry}, entries)
+	})
+}
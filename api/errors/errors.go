@@ -13,3 +13,25 @@ var ErrNilAntiFloodConfig = errors.New("nil antiflood config")
 
 // ErrNilApiConfig signals that a nil api config has been provided
 var ErrNilApiConfig = errors.New("nil api config")
+
+// ErrEmptyOperatorToken signals that the operator token file is empty
+var ErrEmptyOperatorToken = errors.New("empty operator token")
+
+// ErrMissingTLSCertificate signals that TLS was enabled without providing the certificate and the key files
+var ErrMissingTLSCertificate = errors.New("missing TLS certificate or key file")
+
+// ErrMissingClientCA signals that client certificates are required without providing the client CA file
+var ErrMissingClientCA = errors.New("missing client CA file")
+
+// ErrInvalidClientCA signals that no certificate could be loaded from the client CA file
+var ErrInvalidClientCA = errors.New("no valid PEM certificate found in the client CA file")
+
+// ErrMissingClientCertificate signals that the request did not carry a verified client certificate
+var ErrMissingClientCertificate = errors.New("missing verified client certificate")
+
+// ErrClientNotAllowed signals that the client certificate is not allowed to call the route
+var ErrClientNotAllowed = errors.New("client not allowed")
+
+// ErrClientCertificatesNotVerified signals that a group requires client certificates while the web server is not
+// configured to verify them
+var ErrClientCertificatesNotVerified = errors.New("client certificates are required but the web server does not verify them")
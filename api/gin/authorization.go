@@ -0,0 +1,64 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+
+	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	elrondShared "github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+// hasAuthorizationRules returns true if the routes of the provided group can only be called by verified clients
+func hasAuthorizationRules(packageConfig config.APIPackageConfig) bool {
+	return packageConfig.RequireClientCertificate || len(packageConfig.AllowedClients) > 0
+}
+
+// createAuthorizationHandler returns a middleware that lets a request through only if it carries a client certificate
+// verified during the TLS handshake and, if a list of allowed clients is configured, only if the certificate's
+// common name is one of them
+func createAuthorizationHandler(groupName string, packageConfig config.APIPackageConfig) gin.HandlerFunc {
+	allowedClients := make(map[string]struct{})
+	for _, client := range packageConfig.AllowedClients {
+		allowedClients[client] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		clientName, err := getVerifiedClientName(c)
+		if err == nil && len(allowedClients) > 0 {
+			_, isAllowed := allowedClients[clientName]
+			if !isAllowed {
+				err = fmt.Errorf("%w: client %q", apiErrors.ErrClientNotAllowed, clientName)
+			}
+		}
+		if err != nil {
+			log.Debug("unauthorized API request", "group", groupName, "path", c.Request.URL.Path,
+				"remote address", c.ClientIP(), "error", err)
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				elrondShared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  elrondShared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func getVerifiedClientName(c *gin.Context) (string, error) {
+	if c.Request.TLS == nil {
+		return "", apiErrors.ErrMissingClientCertificate
+	}
+
+	verifiedChains := c.Request.TLS.VerifiedChains
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return "", apiErrors.ErrMissingClientCertificate
+	}
+
+	return verifiedChains[0][0].Subject.CommonName, nil
+}
@@ -0,0 +1,157 @@
+package gin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeStatusPackageConfig(allowedClients ...string) config.APIPackageConfig {
+	return config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/status/list", Open: true},
+		},
+		AllowedClients: allowedClients,
+	}
+}
+
+func TestWebServer_CheckAuthorizationRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no rules should work", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServer())
+		assert.Nil(t, ws.checkAuthorizationRules())
+	})
+	t.Run("rules without TLS should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages["node"] = createNodeStatusPackageConfig("monitoring")
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.checkAuthorizationRules()
+		assert.True(t, errors.Is(err, apiErrors.ErrClientCertificatesNotVerified))
+	})
+	t.Run("rules without client CA should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.TLS.Enabled = true
+		args.ApiConfig.APIPackages["node"] = config.APIPackageConfig{RequireClientCertificate: true}
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.checkAuthorizationRules()
+		assert.True(t, errors.Is(err, apiErrors.ErrClientCertificatesNotVerified))
+	})
+	t.Run("rules with client CA should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.TLS.Enabled = true
+		args.ApiConfig.TLS.ClientCAFile = "ca.crt"
+		args.ApiConfig.APIPackages["node"] = createNodeStatusPackageConfig("monitoring")
+		ws, _ := NewWebServerHandler(args)
+
+		assert.Nil(t, ws.checkAuthorizationRules())
+	})
+}
+
+func TestWebServer_TLSAuthorization(t *testing.T) {
+	t.Parallel()
+
+	ca := createTestCertificate(t, "ca", nil)
+	serverCertificate := createTestCertificate(t, "server", ca)
+	monitoringCertificate := createTestCertificate(t, "monitoring", ca)
+	otherCertificate := createTestCertificate(t, "other", ca)
+
+	args := createMockArgsNewWebServer()
+	args.ApiConfig.TLS = config.ApiTLSConfig{
+		Enabled:         true,
+		CertificateFile: serverCertificate.certificateFile,
+		KeyFile:         serverCertificate.keyFile,
+		ClientCAFile:    ca.certificateFile,
+	}
+	args.ApiConfig.APIPackages["node"] = createNodeStatusPackageConfig("monitoring")
+	args.ApiConfig.APIPackages[logGroupName] = config.APIPackageConfig{AllowedClients: []string{"monitoring"}}
+	args.ApiConfig.APIPackages[debugGroupName] = config.APIPackageConfig{AllowedClients: []string{"monitoring"}}
+	ws, _ := NewWebServerHandler(args)
+	require.Nil(t, ws.createGroups())
+
+	engine := gin.New()
+	ws.registerRoutes(engine)
+
+	tlsConfig, err := createTLSConfig(args.ApiConfig.TLS)
+	require.Nil(t, err)
+	server := httptest.NewUnstartedServer(engine)
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.certificate)
+	createClient := func(clientCertificate *testCertificate) *http.Client {
+		clientTLSConfig := &tls.Config{
+			RootCAs: rootCAs,
+		}
+		if clientCertificate != nil {
+			clientTLSConfig.Certificates = []tls.Certificate{
+				{
+					Certificate: [][]byte{clientCertificate.certificate.Raw},
+					PrivateKey:  clientCertificate.privateKey,
+				},
+			}
+		}
+
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+	}
+
+	t.Run("without client certificate should be forbidden", func(t *testing.T) {
+		resp, errGet := createClient(nil).Get(server.URL + "/node/status/list")
+		require.Nil(t, errGet)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("not allowed client should be forbidden", func(t *testing.T) {
+		resp, errGet := createClient(otherCertificate).Get(server.URL + "/node/status/list")
+		require.Nil(t, errGet)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("allowed client should work", func(t *testing.T) {
+		resp, errGet := createClient(monitoringCertificate).Get(server.URL + "/node/status/list")
+		require.Nil(t, errGet)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+	t.Run("log and pprof routes without client certificate should be forbidden", func(t *testing.T) {
+		for _, path := range []string{"/log", "/debug/pprof/cmdline"} {
+			resp, errGet := createClient(nil).Get(server.URL + path)
+			require.Nil(t, errGet)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode, path)
+		}
+	})
+	t.Run("pprof routes with an allowed client should work", func(t *testing.T) {
+		resp, errGet := createClient(monitoringCertificate).Get(server.URL + "/debug/pprof/cmdline")
+		require.Nil(t, errGet)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+	t.Run("groups without rules should not require client certificates", func(t *testing.T) {
+		resp, errGet := createClient(nil).Get(server.URL + "/statemachine/EthereumToElrond/history")
+		require.Nil(t, errGet)
+		_ = resp.Body.Close()
+		assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
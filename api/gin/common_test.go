@@ -0,0 +1,72 @@
+package gin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	certificate     *x509.Certificate
+	privateKey      *ecdsa.PrivateKey
+	certificateFile string
+	keyFile         string
+}
+
+// createTestCertificate creates a certificate signed by the provided parent or a self-signed CA if the parent is nil
+func createTestCertificate(t *testing.T, commonName string, parent *testCertificate) *testCertificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	signerCertificate := template
+	signerKey := privateKey
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signerCertificate = parent.certificate
+		signerKey = parent.privateKey
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, signerCertificate, &privateKey.PublicKey, signerKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	directory := t.TempDir()
+	result := &testCertificate{
+		certificate:     certificate,
+		privateKey:      privateKey,
+		certificateFile: filepath.Join(directory, commonName+".crt"),
+		keyFile:         filepath.Join(directory, commonName+".key"),
+	}
+	err = ioutil.WriteFile(result.certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateBytes}), 0600)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(result.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	require.Nil(t, err)
+
+	return result
+}
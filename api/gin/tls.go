@@ -0,0 +1,67 @@
+package gin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+)
+
+// tlsServer is a http.Server that serves HTTPS using the certificates found in its TLS config
+type tlsServer struct {
+	*http.Server
+}
+
+// ListenAndServe listens on the server address and serves HTTPS
+func (server *tlsServer) ListenAndServe() error {
+	return server.Server.ListenAndServeTLS("", "")
+}
+
+// createTLSConfig creates the TLS config of the web server. If a client CA file is provided, the client certificates
+// are verified against it and are mandatory only if RequireClientCertificates is set
+func createTLSConfig(tlsConfig config.ApiTLSConfig) (*tls.Config, error) {
+	if len(tlsConfig.CertificateFile) == 0 || len(tlsConfig.KeyFile) == 0 {
+		return nil, apiErrors.ErrMissingTLSCertificate
+	}
+
+	certificate, err := tls.LoadX509KeyPair(tlsConfig.CertificateFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the web server certificate", err)
+	}
+
+	result := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	if len(tlsConfig.ClientCAFile) == 0 {
+		if tlsConfig.RequireClientCertificates {
+			return nil, apiErrors.ErrMissingClientCA
+		}
+
+		return result, nil
+	}
+
+	caBytes, err := ioutil.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the client CA", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("%w in %s", apiErrors.ErrInvalidClientCA, tlsConfig.ClientCAFile)
+	}
+
+	result.ClientCAs = clientCAs
+	result.ClientAuth = tls.VerifyClientCertIfGiven
+	if tlsConfig.RequireClientCertificates {
+		result.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return result, nil
+}
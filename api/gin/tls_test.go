@@ -0,0 +1,100 @@
+package gin
+
+import (
+	"crypto/tls"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	ca := createTestCertificate(t, "ca", nil)
+	serverCertificate := createTestCertificate(t, "server", ca)
+
+	t.Run("missing certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{KeyFile: serverCertificate.keyFile})
+		assert.Nil(t, tlsConfig)
+		assert.Equal(t, apiErrors.ErrMissingTLSCertificate, err)
+	})
+	t.Run("invalid key pair should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile: serverCertificate.certificateFile,
+			KeyFile:         ca.keyFile,
+		})
+		assert.Nil(t, tlsConfig)
+		assert.NotNil(t, err)
+	})
+	t.Run("required client certificates without client CA should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile:           serverCertificate.certificateFile,
+			KeyFile:                   serverCertificate.keyFile,
+			RequireClientCertificates: true,
+		})
+		assert.Nil(t, tlsConfig)
+		assert.Equal(t, apiErrors.ErrMissingClientCA, err)
+	})
+	t.Run("invalid client CA should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidCA := filepath.Join(t.TempDir(), "ca.crt")
+		require.Nil(t, ioutil.WriteFile(invalidCA, []byte("not a certificate"), 0600))
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile: serverCertificate.certificateFile,
+			KeyFile:         serverCertificate.keyFile,
+			ClientCAFile:    invalidCA,
+		})
+		assert.Nil(t, tlsConfig)
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidClientCA))
+	})
+	t.Run("without client CA should not verify client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile: serverCertificate.certificateFile,
+			KeyFile:         serverCertificate.keyFile,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+		assert.Nil(t, tlsConfig.ClientCAs)
+		assert.Equal(t, 1, len(tlsConfig.Certificates))
+	})
+	t.Run("with client CA should verify client certificates if given", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile: serverCertificate.certificateFile,
+			KeyFile:         serverCertificate.keyFile,
+			ClientCAFile:    ca.certificateFile,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+		assert.NotNil(t, tlsConfig.ClientCAs)
+	})
+	t.Run("required client certificates should work", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			CertificateFile:           serverCertificate.certificateFile,
+			KeyFile:                   serverCertificate.keyFile,
+			ClientCAFile:              ca.certificateFile,
+			RequireClientCertificates: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	})
+}
@@ -3,10 +3,13 @@ package gin
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/audit"
 	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
 	"github.com/ElrondNetwork/elrond-eth-bridge/api/groups"
 	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
@@ -24,6 +27,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	operatorGroupName = "operator"
+	logGroupName      = "log"
+	debugGroupName    = "debug"
+)
+
 var log = logger.GetOrCreate("api")
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
@@ -95,7 +104,12 @@ func (ws *webServer) StartHttpServer() error {
 	engine = gin.Default()
 	engine.Use(cors.Default())
 
-	err := ws.createGroups()
+	err := ws.checkAuthorizationRules()
+	if err != nil {
+		return err
+	}
+
+	err = ws.createGroups()
 	if err != nil {
 		return err
 	}
@@ -116,8 +130,12 @@ func (ws *webServer) StartHttpServer() error {
 
 	ws.registerRoutes(engine)
 
-	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+	server, err := ws.createServer(engine)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface(), "TLS", ws.apiConfig.TLS.Enabled)
 	ws.httpServer, err = NewHttpServer(server)
 	if err != nil {
 		return err
@@ -129,6 +147,37 @@ func (ws *webServer) StartHttpServer() error {
 	return nil
 }
 
+func (ws *webServer) createServer(engine *gin.Engine) (server, error) {
+	httpServer := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
+	if !ws.apiConfig.TLS.Enabled {
+		return httpServer, nil
+	}
+
+	tlsConfig, err := createTLSConfig(ws.apiConfig.TLS)
+	if err != nil {
+		return nil, err
+	}
+	httpServer.TLSConfig = tlsConfig
+
+	return &tlsServer{Server: httpServer}, nil
+}
+
+// checkAuthorizationRules fails if a group can only be called by verified clients while the web server is not
+// configured to verify the client certificates, as no request would be accepted on that group
+func (ws *webServer) checkAuthorizationRules() error {
+	for groupName, packageConfig := range ws.apiConfig.APIPackages {
+		if hasAuthorizationRules(packageConfig) && !ws.canVerifyClients() {
+			return fmt.Errorf("%w for the %s group", apiErrors.ErrClientCertificatesNotVerified, groupName)
+		}
+	}
+
+	return nil
+}
+
+func (ws *webServer) canVerifyClients() bool {
+	return ws.apiConfig.TLS.Enabled && len(ws.apiConfig.TLS.ClientCAFile) > 0
+}
+
 func (ws *webServer) createGroups() error {
 	groupsMap := make(map[string]shared.GroupHandler)
 
@@ -138,11 +187,77 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["node"] = nodeGroup
 
+	stateMachineGroup, err := groups.NewStateMachineGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["statemachine"] = stateMachineGroup
+
+	healthGroup, err := groups.NewHealthGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["health"] = healthGroup
+
+	if hasOpenRoutes(ws.apiConfig, operatorGroupName) {
+		operatorGroup, errCreate := ws.createOperatorGroup()
+		if errCreate != nil {
+			return fmt.Errorf("%w while creating the operator group", errCreate)
+		}
+		groupsMap[operatorGroupName] = operatorGroup
+	}
+
 	ws.groups = groupsMap
 
 	return nil
 }
 
+func (ws *webServer) createOperatorGroup() (shared.GroupHandler, error) {
+	operatorConfig := ws.apiConfig.Operator
+	if operatorConfig.AllowClientCertificates && !ws.canVerifyClients() {
+		return nil, fmt.Errorf("%w for the operator client certificates", apiErrors.ErrClientCertificatesNotVerified)
+	}
+
+	token := ""
+	if len(operatorConfig.TokenFile) > 0 {
+		tokenBytes, err := ioutil.ReadFile(operatorConfig.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		token = strings.TrimSpace(string(tokenBytes))
+		if len(token) == 0 {
+			return nil, apiErrors.ErrEmptyOperatorToken
+		}
+		if !ws.apiConfig.TLS.Enabled {
+			log.Warn("the operator token is sent in clear over HTTP, consider enabling TLS for the operator routes")
+		}
+	}
+
+	auditLog, err := audit.NewFileAuditLog(operatorConfig.AuditLogFile)
+	if err != nil {
+		return nil, err
+	}
+
+	argsOperatorGroup := groups.ArgsOperatorGroup{
+		Facade:                  ws.facade,
+		AuditLog:                auditLog,
+		Token:                   token,
+		AllowClientCertificates: operatorConfig.AllowClientCertificates,
+	}
+
+	return groups.NewOperatorGroup(argsOperatorGroup)
+}
+
+func hasOpenRoutes(apiConfig config.ApiRoutesConfig, groupName string) bool {
+	for _, route := range apiConfig.APIPackages[groupName].Routes {
+		if route.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 // UpdateFacade will update webServer facade.
 func (ws *webServer) UpdateFacade(facade shared.FacadeHandler) error {
 	if check.IfNil(facade) {
@@ -169,23 +284,33 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 
 	for groupName, groupHandler := range ws.groups {
 		log.Debug("registering gin API group", "group name", groupName)
-		ginGroup := ginRouter.Group(fmt.Sprintf("/%s", groupName))
-		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
+		groupHandler.RegisterRoutes(ws.createGinGroup(ginRouter, groupName), ws.apiConfig)
 	}
 
 	marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
-	registerLoggerWsRoute(ginRouter, marshalizerForLogs)
+	registerLoggerWsRoute(ws.createGinGroup(ginRouter, logGroupName), marshalizerForLogs)
 
 	if ws.facade.PprofEnabled() {
-		pprof.Register(ginRouter)
+		pprof.RouteRegister(ws.createGinGroup(ginRouter, debugGroupName), "/pprof")
 	}
 }
 
-// registerLoggerWsRoute will register the log route
-func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+// createGinGroup returns the gin group of the provided name, guarded by the authorization rules of its API package
+func (ws *webServer) createGinGroup(ginRouter *gin.Engine, groupName string) *gin.RouterGroup {
+	ginGroup := ginRouter.Group(fmt.Sprintf("/%s", groupName))
+	packageConfig := ws.apiConfig.APIPackages[groupName]
+	if hasAuthorizationRules(packageConfig) {
+		ginGroup.Use(createAuthorizationHandler(groupName, packageConfig))
+	}
+
+	return ginGroup
+}
+
+// registerLoggerWsRoute will register the log route on the provided log group
+func registerLoggerWsRoute(logGroup *gin.RouterGroup, marshalizer marshal.Marshalizer) {
 	upgrader := websocket.Upgrader{}
 
-	ws.GET("/log", func(c *gin.Context) {
+	logGroup.GET("", func(c *gin.Context) {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
 			return true
 		}
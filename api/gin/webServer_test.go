@@ -2,11 +2,15 @@ package gin
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/audit"
 	apiErrors "github.com/ElrondNetwork/elrond-eth-bridge/api/errors"
+	apiGroups "github.com/ElrondNetwork/elrond-eth-bridge/api/groups"
 	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
@@ -15,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgsNewWebServer() ArgsNewWebServer {
@@ -197,3 +202,93 @@ func TestWebServer_UpdateFacade(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestWebServer_CreateGroups(t *testing.T) {
+	t.Parallel()
+
+	operatorRoutes := config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/signatures/clear", Open: true},
+		},
+	}
+
+	t.Run("closed operator routes should not create the operator group", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServer())
+		err := ws.createGroups()
+		assert.Nil(t, err)
+		_, found := ws.groups[operatorGroupName]
+		assert.False(t, found)
+	})
+	t.Run("open operator routes without authentication should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages[operatorGroupName] = operatorRoutes
+		args.ApiConfig.Operator.AuditLogFile = filepath.Join(t.TempDir(), "audit.log")
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.createGroups()
+		assert.True(t, errors.Is(err, apiGroups.ErrNoOperatorAuthentication))
+	})
+	t.Run("client certificates without verifying the clients should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, ioutil.WriteFile(tokenFile, []byte("token\n"), 0600))
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages[operatorGroupName] = operatorRoutes
+		args.ApiConfig.Operator.TokenFile = tokenFile
+		args.ApiConfig.Operator.AllowClientCertificates = true
+		args.ApiConfig.Operator.AuditLogFile = filepath.Join(t.TempDir(), "audit.log")
+		args.ApiConfig.TLS.Enabled = true
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.createGroups()
+		assert.True(t, errors.Is(err, apiErrors.ErrClientCertificatesNotVerified))
+	})
+	t.Run("empty token file should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, ioutil.WriteFile(tokenFile, []byte(" \n"), 0600))
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages[operatorGroupName] = operatorRoutes
+		args.ApiConfig.Operator.TokenFile = tokenFile
+		args.ApiConfig.Operator.AuditLogFile = filepath.Join(t.TempDir(), "audit.log")
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.createGroups()
+		assert.True(t, errors.Is(err, apiErrors.ErrEmptyOperatorToken))
+	})
+	t.Run("missing audit log file should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, ioutil.WriteFile(tokenFile, []byte("token\n"), 0600))
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages[operatorGroupName] = operatorRoutes
+		args.ApiConfig.Operator.TokenFile = tokenFile
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.createGroups()
+		assert.True(t, errors.Is(err, audit.ErrEmptyPath))
+	})
+	t.Run("should create the operator group", func(t *testing.T) {
+		t.Parallel()
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, ioutil.WriteFile(tokenFile, []byte("token\n"), 0600))
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages[operatorGroupName] = operatorRoutes
+		args.ApiConfig.Operator.TokenFile = tokenFile
+		args.ApiConfig.Operator.AuditLogFile = filepath.Join(t.TempDir(), "audit.log")
+		ws, _ := NewWebServerHandler(args)
+
+		err := ws.createGroups()
+		assert.Nil(t, err)
+		_, found := ws.groups[operatorGroupName]
+		assert.True(t, found)
+	})
+}
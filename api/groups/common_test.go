@@ -37,6 +37,8 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status/list", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/peer-scores", Open: true},
+					{Name: "/cluster", Open: true},
 				},
 			},
 		},
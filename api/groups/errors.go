@@ -4,3 +4,39 @@ import "errors"
 
 // ErrGettingMetrics signals that an error occurred while getting the metrics
 var ErrGettingMetrics = errors.New("error getting metrics")
+
+// ErrReloadingConfig signals that an error occurred while reloading the configuration
+var ErrReloadingConfig = errors.New("error reloading config")
+
+// ErrGettingTransitionsHistory signals that an error occurred while getting the state machine transitions history
+var ErrGettingTransitionsHistory = errors.New("error getting transitions history")
+
+// ErrNilAuditLog signals that a nil audit log was provided
+var ErrNilAuditLog = errors.New("nil audit log")
+
+// ErrNoOperatorAuthentication signals that neither a token nor client certificates were configured for the operator group
+var ErrNoOperatorAuthentication = errors.New("no authentication method configured for the operator group")
+
+// ErrUnauthorized signals that the request did not carry valid credentials
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrPausingStateMachine signals that an error occurred while pausing a state machine
+var ErrPausingStateMachine = errors.New("error pausing state machine")
+
+// ErrResumingStateMachine signals that an error occurred while resuming a state machine
+var ErrResumingStateMachine = errors.New("error resuming state machine")
+
+// ErrResettingStateMachine signals that an error occurred while forcing a state machine back to its start step
+var ErrResettingStateMachine = errors.New("error resetting state machine")
+
+// ErrSettingLogLevel signals that an error occurred while setting the log level
+var ErrSettingLogLevel = errors.New("error setting log level")
+
+// ErrRefreshingRoleProviders signals that an error occurred while refreshing the role providers
+var ErrRefreshingRoleProviders = errors.New("error refreshing role providers")
+
+// ErrNotAlive signals that at least one of the liveness checks failed
+var ErrNotAlive = errors.New("relayer not alive")
+
+// ErrNotReady signals that at least one of the readiness checks failed
+var ErrNotReady = errors.New("relayer not ready")
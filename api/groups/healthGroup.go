@@ -0,0 +1,111 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	elrondApiShared "github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	livenessPath  = "/live"
+	readinessPath = "/ready"
+)
+
+type healthGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewHealthGroup returns a new instance of healthGroup
+func NewHealthGroup(facade shared.FacadeHandler) (*healthGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for health group", errors.ErrNilFacadeHandler)
+	}
+
+	hg := &healthGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*elrondApiShared.EndpointHandlerData{
+		{
+			Path:    livenessPath,
+			Method:  http.MethodGet,
+			Handler: hg.liveness,
+		},
+		{
+			Path:    readinessPath,
+			Method:  http.MethodGet,
+			Handler: hg.readiness,
+		},
+	}
+	hg.endpoints = endpoints
+
+	return hg, nil
+}
+
+// liveness returns the liveness report, responding with 503 if the relayer is not alive
+func (hg *healthGroup) liveness(c *gin.Context) {
+	respondWithHealthReport(c, hg.getFacade().GetLiveness(), ErrNotAlive)
+}
+
+// readiness returns the readiness report, responding with 503 if the relayer is not ready
+func (hg *healthGroup) readiness(c *gin.Context) {
+	respondWithHealthReport(c, hg.getFacade().GetReadiness(), ErrNotReady)
+}
+
+func respondWithHealthReport(c *gin.Context, report core.HealthReport, unhealthyErr error) {
+	if !report.Healthy {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			elrondApiShared.GenericAPIResponse{
+				Data:  gin.H{"report": report},
+				Error: unhealthyErr.Error(),
+				Code:  elrondApiShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  gin.H{"report": report},
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (hg *healthGroup) getFacade() shared.FacadeHandler {
+	hg.mutFacade.RLock()
+	defer hg.mutFacade.RUnlock()
+
+	return hg.facade
+}
+
+// UpdateFacade will update the facade
+func (hg *healthGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	hg.mutFacade.Lock()
+	hg.facade = newFacade
+	hg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hg *healthGroup) IsInterfaceNil() bool {
+	return hg == nil
+}
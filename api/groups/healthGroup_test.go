@@ -0,0 +1,196 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	mockFacade "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/facade"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	elrondApiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthReportResponse struct {
+	Data struct {
+		Report core.HealthReport `json:"report"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func getHealthRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"health": {
+				Routes: []config.RouteConfig{
+					{Name: "/live", Open: true},
+					{Name: "/ready", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewHealthGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		hg, err := NewHealthGroup(nil)
+
+		assert.True(t, check.IfNil(hg))
+		assert.True(t, errors.Is(err, elrondApiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hg, err := NewHealthGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(hg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHealthGroup_Liveness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("alive should respond with 200", func(t *testing.T) {
+		report := core.HealthReport{
+			Healthy: true,
+			Checks: []core.HealthCheck{
+				{Name: "EthereumToElrond execution", Healthy: true},
+			},
+		}
+		facade := mockFacade.RelayerFacadeStub{
+			GetLivenessCalled: func() core.HealthReport {
+				return report
+			},
+		}
+		hg, err := NewHealthGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(hg, "health", getHealthRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/health/live", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		healthRsp := healthReportResponse{}
+		loadResponse(resp.Body, &healthRsp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, report, healthRsp.Data.Report)
+		assert.Empty(t, healthRsp.Error)
+	})
+	t.Run("not alive should respond with 503", func(t *testing.T) {
+		report := core.HealthReport{
+			Healthy: false,
+			Checks: []core.HealthCheck{
+				{Name: "EthereumToElrond execution", Healthy: false, Message: "last execution 10m0s ago, maximum 5m0s"},
+			},
+		}
+		facade := mockFacade.RelayerFacadeStub{
+			GetLivenessCalled: func() core.HealthReport {
+				return report
+			},
+		}
+		hg, err := NewHealthGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(hg, "health", getHealthRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/health/live", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		healthRsp := healthReportResponse{}
+		loadResponse(resp.Body, &healthRsp)
+
+		require.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, report, healthRsp.Data.Report)
+		assert.Equal(t, ErrNotAlive.Error(), healthRsp.Error)
+	})
+}
+
+func TestHealthGroup_Readiness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ready should respond with 200", func(t *testing.T) {
+		report := core.HealthReport{
+			Healthy: true,
+			Checks: []core.HealthCheck{
+				{Name: "p2p peers", Healthy: true, Message: "3 connected peer(s), minimum 1"},
+			},
+		}
+		facade := mockFacade.RelayerFacadeStub{
+			GetReadinessCalled: func() core.HealthReport {
+				return report
+			},
+		}
+		hg, err := NewHealthGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(hg, "health", getHealthRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/health/ready", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		healthRsp := healthReportResponse{}
+		loadResponse(resp.Body, &healthRsp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, report, healthRsp.Data.Report)
+		assert.Empty(t, healthRsp.Error)
+	})
+	t.Run("not ready should respond with 503", func(t *testing.T) {
+		report := core.HealthReport{
+			Healthy: false,
+			Checks: []core.HealthCheck{
+				{Name: "ethereum client", Healthy: true},
+				{Name: "ethereum whitelist", Healthy: false, Message: "relayer not whitelisted"},
+			},
+		}
+		facade := mockFacade.RelayerFacadeStub{
+			GetReadinessCalled: func() core.HealthReport {
+				return report
+			},
+		}
+		hg, err := NewHealthGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(hg, "health", getHealthRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/health/ready", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		healthRsp := healthReportResponse{}
+		loadResponse(resp.Body, &healthRsp)
+
+		require.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, report, healthRsp.Data.Report)
+		assert.Equal(t, ErrNotReady.Error(), healthRsp.Error)
+	})
+}
+
+func TestHealthGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		hg, _ := NewHealthGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := hg.UpdateFacade(nil)
+		assert.Equal(t, elrondApiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		hg, _ := NewHealthGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := hg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, hg.facade == newFacade) // pointer testing
+	})
+}
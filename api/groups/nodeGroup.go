@@ -16,6 +16,8 @@ const (
 	clientQueryParam = "name"
 	statusPath       = "/status"
 	statusListPath   = "/status/list"
+	peerScoresPath   = "/peer-scores"
+	clusterPath      = "/cluster"
 )
 
 type nodeGroup struct {
@@ -46,6 +48,16 @@ func NewNodeGroup(facade shared.FacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statusListMetrics,
 		},
+		{
+			Path:    peerScoresPath,
+			Method:  http.MethodGet,
+			Handler: ng.peerScores,
+		},
+		{
+			Path:    clusterPath,
+			Method:  http.MethodGet,
+			Handler: ng.cluster,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -99,6 +111,34 @@ func (ng *nodeGroup) statusMetrics(c *gin.Context) {
 	)
 }
 
+// peerScores returns the scores of the peers that sent invalid messages, the highest scores first
+func (ng *nodeGroup) peerScores(c *gin.Context) {
+	scores := ng.getFacade().GetPeerScores()
+
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  gin.H{"scores": scores},
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// cluster returns the state of every whitelisted relayer as reported in the received heartbeats
+func (ng *nodeGroup) cluster(c *gin.Context) {
+	relayers := ng.getFacade().GetClusterInfo()
+
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  gin.H{"relayers": relayers},
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (ng *nodeGroup) getFacade() shared.FacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -131,6 +131,92 @@ func TestGetStatusList(t *testing.T) {
 	assert.Empty(t, statusRsp.Error)
 }
 
+func TestPeerScores(t *testing.T) {
+	t.Parallel()
+
+	scores := []core.PeerScore{
+		{
+			Identifier: "pid 1",
+			Type:       "peer ID",
+			Score:      5,
+			NumBans:    1,
+			LastReason: "invalid message",
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetPeerScoresCalled: func() []core.PeerScore {
+			return scores
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/peer-scores", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	scoresRsp := peerScoresResponse{}
+	loadResponse(resp.Body, &scoresRsp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, scoresRsp.Error)
+	assert.Equal(t, len(scores), len(scoresRsp.Data.Scores))
+	assert.Equal(t, scores[0].Identifier, scoresRsp.Data.Scores[0].Identifier)
+	assert.Equal(t, scores[0].NumBans, scoresRsp.Data.Scores[0].NumBans)
+	assert.Equal(t, scores[0].LastReason, scoresRsp.Data.Scores[0].LastReason)
+}
+
+type clusterResponse struct {
+	Data struct {
+		Relayers []core.RelayerStatus `json:"relayers"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func TestCluster(t *testing.T) {
+	t.Parallel()
+
+	relayers := []core.RelayerStatus{
+		{
+			Address:           "erd1",
+			IsSelf:            true,
+			AppVersion:        "v1.0.0",
+			ClockSkewInMillis: 120,
+			StateMachines: []*core.HeartbeatStateMachine{
+				{Name: "EthereumToElrond", Step: "getting the pending batch", LastBatchID: 7},
+			},
+		},
+		{
+			Address:  "erd2",
+			IsSilent: true,
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetClusterInfoCalled: func() []core.RelayerStatus {
+			return relayers
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/cluster", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	clusterRsp := clusterResponse{}
+	loadResponse(resp.Body, &clusterRsp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, clusterRsp.Error)
+	assert.Equal(t, relayers, clusterRsp.Data.Relayers)
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,358 @@
+package groups
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	elrondApiShared "github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	pauseStateMachinePath    = "/statemachine/:name/pause"
+	resumeStateMachinePath   = "/statemachine/:name/resume"
+	resetStateMachinePath    = "/statemachine/:name/reset"
+	clearSignaturesPath      = "/signatures/clear"
+	logLevelPath             = "/log-level"
+	refreshRoleProvidersPath = "/role-providers/refresh"
+	reloadConfigPath         = "/reload-config"
+
+	authorizationHeader       = "Authorization"
+	bearerPrefix              = "Bearer "
+	tokenIdentity             = "token"
+	certificateIdentityFormat = "certificate:%s"
+	auditIdentityKey          = "auditIdentity"
+	auditDetailsKey           = "auditDetails"
+	auditErrorKey             = "auditError"
+)
+
+// ArgsOperatorGroup is the DTO used to create a new instance of operatorGroup
+type ArgsOperatorGroup struct {
+	Facade                  shared.FacadeHandler
+	AuditLog                shared.AuditLogger
+	Token                   string
+	AllowClientCertificates bool
+}
+
+type logLevelRequest struct {
+	LogLevel string `json:"logLevel"`
+}
+
+type operatorGroup struct {
+	*baseGroup
+	facade                  shared.FacadeHandler
+	mutFacade               sync.RWMutex
+	auditLog                shared.AuditLogger
+	token                   []byte
+	allowClientCertificates bool
+}
+
+// NewOperatorGroup returns a new instance of operatorGroup. All its routes require either the configured bearer token
+// or, if allowed, a verified client certificate and every call is written in the audit log
+func NewOperatorGroup(args ArgsOperatorGroup) (*operatorGroup, error) {
+	if check.IfNil(args.Facade) {
+		return nil, fmt.Errorf("%w for operator group", errors.ErrNilFacadeHandler)
+	}
+	if check.IfNil(args.AuditLog) {
+		return nil, ErrNilAuditLog
+	}
+	if len(args.Token) == 0 && !args.AllowClientCertificates {
+		return nil, ErrNoOperatorAuthentication
+	}
+
+	og := &operatorGroup{
+		facade:                  args.Facade,
+		baseGroup:               &baseGroup{},
+		auditLog:                args.AuditLog,
+		token:                   []byte(args.Token),
+		allowClientCertificates: args.AllowClientCertificates,
+	}
+
+	endpoints := []*elrondApiShared.EndpointHandlerData{
+		{
+			Path:    pauseStateMachinePath,
+			Method:  http.MethodPost,
+			Handler: og.pauseStateMachine,
+		},
+		{
+			Path:    resumeStateMachinePath,
+			Method:  http.MethodPost,
+			Handler: og.resumeStateMachine,
+		},
+		{
+			Path:    resetStateMachinePath,
+			Method:  http.MethodPost,
+			Handler: og.resetStateMachine,
+		},
+		{
+			Path:    clearSignaturesPath,
+			Method:  http.MethodPost,
+			Handler: og.clearSignatures,
+		},
+		{
+			Path:    logLevelPath,
+			Method:  http.MethodPost,
+			Handler: og.setLogLevel,
+		},
+		{
+			Path:    refreshRoleProvidersPath,
+			Method:  http.MethodPost,
+			Handler: og.refreshRoleProviders,
+		},
+		{
+			Path:    reloadConfigPath,
+			Method:  http.MethodPost,
+			Handler: og.reloadConfig,
+		},
+	}
+	og.endpoints = endpoints
+
+	return og, nil
+}
+
+// RegisterRoutes will register the open endpoints behind the audit and the authentication middlewares
+func (og *operatorGroup) RegisterRoutes(
+	ws *gin.RouterGroup,
+	apiConfig config.ApiRoutesConfig,
+) {
+	ws.Use(og.audit, og.authenticate)
+	og.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// audit writes an entry in the audit log after the request was handled, including the rejected ones
+func (og *operatorGroup) audit(c *gin.Context) {
+	c.Next()
+
+	entry := shared.AuditEntry{
+		Timestamp:     time.Now().Unix(),
+		RemoteAddress: c.ClientIP(),
+		Identity:      c.GetString(auditIdentityKey),
+		Method:        c.Request.Method,
+		Path:          c.Request.URL.Path,
+		Details:       c.GetString(auditDetailsKey),
+		StatusCode:    c.Writer.Status(),
+		Error:         c.GetString(auditErrorKey),
+	}
+
+	err := og.auditLog.Log(entry)
+	if err != nil {
+		log.Error("error writing the operator API audit log", "path", entry.Path, "error", err)
+	}
+}
+
+// authenticate lets the request through only if it carries the configured bearer token or, if allowed,
+// a client certificate that was verified during the TLS handshake
+func (og *operatorGroup) authenticate(c *gin.Context) {
+	identity, ok := og.identify(c)
+	if !ok {
+		og.respondWithError(c, http.StatusUnauthorized, ErrUnauthorized, nil)
+		c.Abort()
+		return
+	}
+
+	c.Set(auditIdentityKey, identity)
+	c.Next()
+}
+
+func (og *operatorGroup) identify(c *gin.Context) (string, bool) {
+	header := c.GetHeader(authorizationHeader)
+	if len(og.token) > 0 && strings.HasPrefix(header, bearerPrefix) {
+		providedToken := []byte(strings.TrimPrefix(header, bearerPrefix))
+		if subtle.ConstantTimeCompare(providedToken, og.token) == 1 {
+			return tokenIdentity, true
+		}
+
+		return "", false
+	}
+
+	if !og.allowClientCertificates || c.Request.TLS == nil {
+		return "", false
+	}
+
+	verifiedChains := c.Request.TLS.VerifiedChains
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return fmt.Sprintf(certificateIdentityFormat, verifiedChains[0][0].Subject.CommonName), true
+}
+
+// pauseStateMachine pauses the provided state machine after its current step
+func (og *operatorGroup) pauseStateMachine(c *gin.Context) {
+	name := c.Param(stateMachineNameParam)
+	c.Set(auditDetailsKey, name)
+
+	err := og.getFacade().PauseStateMachine(name)
+	if err != nil {
+		og.respondWithError(c, http.StatusBadRequest, ErrPausingStateMachine, err)
+		return
+	}
+
+	og.respondWithSuccess(c)
+}
+
+// resumeStateMachine resumes the provided state machine
+func (og *operatorGroup) resumeStateMachine(c *gin.Context) {
+	name := c.Param(stateMachineNameParam)
+	c.Set(auditDetailsKey, name)
+
+	err := og.getFacade().ResumeStateMachine(name)
+	if err != nil {
+		og.respondWithError(c, http.StatusBadRequest, ErrResumingStateMachine, err)
+		return
+	}
+
+	og.respondWithSuccess(c)
+}
+
+// resetStateMachine forces the provided state machine back to its start step
+func (og *operatorGroup) resetStateMachine(c *gin.Context) {
+	name := c.Param(stateMachineNameParam)
+	c.Set(auditDetailsKey, name)
+
+	err := og.getFacade().ForceStartStep(c.Request.Context(), name)
+	if err != nil {
+		og.respondWithError(c, http.StatusInternalServerError, ErrResettingStateMachine, err)
+		return
+	}
+
+	og.respondWithSuccess(c)
+}
+
+// clearSignatures clears the stored p2p signatures
+func (og *operatorGroup) clearSignatures(c *gin.Context) {
+	og.getFacade().ClearStoredSignatures()
+
+	og.respondWithSuccess(c)
+}
+
+// setLogLevel changes the log level pattern of the relayer
+func (og *operatorGroup) setLogLevel(c *gin.Context) {
+	request := logLevelRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		og.respondWithError(c, http.StatusBadRequest, ErrSettingLogLevel, err)
+		return
+	}
+	c.Set(auditDetailsKey, request.LogLevel)
+
+	err = og.getFacade().SetLogLevel(request.LogLevel)
+	if err != nil {
+		og.respondWithError(c, http.StatusBadRequest, ErrSettingLogLevel, err)
+		return
+	}
+
+	og.respondWithSuccess(c)
+}
+
+// refreshRoleProviders fetches right away the whitelisted relayers from both chains
+func (og *operatorGroup) refreshRoleProviders(c *gin.Context) {
+	err := og.getFacade().RefreshRoleProviders(c.Request.Context())
+	if err != nil {
+		og.respondWithError(c, http.StatusInternalServerError, ErrRefreshingRoleProviders, err)
+		return
+	}
+
+	og.respondWithSuccess(c)
+}
+
+// reloadConfig re-reads the configuration file and applies the changes that do not require a restart
+func (og *operatorGroup) reloadConfig(c *gin.Context) {
+	report, err := og.getFacade().ReloadConfig()
+	c.Set(auditDetailsKey, fmt.Sprintf("applied: %d, rejected: %d", len(report.Applied), len(report.Rejected)))
+	if err != nil {
+		httpStatus := http.StatusInternalServerError
+		returnCode := elrondApiShared.ReturnCodeInternalError
+		if len(report.Rejected) > 0 {
+			httpStatus = http.StatusConflict
+			returnCode = elrondApiShared.ReturnCodeRequestError
+		}
+		errMessage := fmt.Sprintf("%s: %s", ErrReloadingConfig.Error(), err.Error())
+		c.Set(auditErrorKey, errMessage)
+
+		c.JSON(
+			httpStatus,
+			elrondApiShared.GenericAPIResponse{
+				Data:  gin.H{"report": report},
+				Error: errMessage,
+				Code:  returnCode,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  gin.H{"report": report},
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (og *operatorGroup) respondWithSuccess(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  nil,
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (og *operatorGroup) respondWithError(c *gin.Context, httpStatus int, baseErr error, err error) {
+	errMessage := baseErr.Error()
+	if err != nil {
+		errMessage = fmt.Sprintf("%s: %s", baseErr.Error(), err.Error())
+	}
+	c.Set(auditErrorKey, errMessage)
+
+	returnCode := elrondApiShared.ReturnCodeRequestError
+	if httpStatus == http.StatusInternalServerError {
+		returnCode = elrondApiShared.ReturnCodeInternalError
+	}
+
+	c.JSON(
+		httpStatus,
+		elrondApiShared.GenericAPIResponse{
+			Data:  nil,
+			Error: errMessage,
+			Code:  returnCode,
+		},
+	)
+}
+
+func (og *operatorGroup) getFacade() shared.FacadeHandler {
+	og.mutFacade.RLock()
+	defer og.mutFacade.RUnlock()
+
+	return og.facade
+}
+
+// UpdateFacade will update the facade
+func (og *operatorGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	og.mutFacade.Lock()
+	og.facade = newFacade
+	og.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (og *operatorGroup) IsInterfaceNil() bool {
+	return og == nil
+}
@@ -0,0 +1,488 @@
+package groups
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	mockFacade "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/facade"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	elrondApiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "operator-token"
+
+type auditEntriesHolder struct {
+	mut     sync.Mutex
+	entries []shared.AuditEntry
+}
+
+func (holder *auditEntriesHolder) auditLog() *testsCommon.AuditLogStub {
+	return &testsCommon.AuditLogStub{
+		LogCalled: func(entry shared.AuditEntry) error {
+			holder.mut.Lock()
+			holder.entries = append(holder.entries, entry)
+			holder.mut.Unlock()
+
+			return nil
+		},
+	}
+}
+
+func getOperatorRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"operator": {
+				Routes: []config.RouteConfig{
+					{Name: "/statemachine/:name/pause", Open: true},
+					{Name: "/statemachine/:name/resume", Open: true},
+					{Name: "/statemachine/:name/reset", Open: true},
+					{Name: "/signatures/clear", Open: true},
+					{Name: "/log-level", Open: true},
+					{Name: "/role-providers/refresh", Open: true},
+					{Name: "/reload-config", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func createMockArgsOperatorGroup() ArgsOperatorGroup {
+	return ArgsOperatorGroup{
+		Facade:   &mockFacade.RelayerFacadeStub{},
+		AuditLog: &testsCommon.AuditLogStub{},
+		Token:    testToken,
+	}
+}
+
+func createOperatorRequest(path string, body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+
+	return req
+}
+
+func TestNewOperatorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		args := createMockArgsOperatorGroup()
+		args.Facade = nil
+
+		og, err := NewOperatorGroup(args)
+		assert.True(t, check.IfNil(og))
+		assert.True(t, errors.Is(err, elrondApiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("nil audit log should error", func(t *testing.T) {
+		args := createMockArgsOperatorGroup()
+		args.AuditLog = nil
+
+		og, err := NewOperatorGroup(args)
+		assert.True(t, check.IfNil(og))
+		assert.Equal(t, ErrNilAuditLog, err)
+	})
+	t.Run("no authentication method should error", func(t *testing.T) {
+		args := createMockArgsOperatorGroup()
+		args.Token = ""
+
+		og, err := NewOperatorGroup(args)
+		assert.True(t, check.IfNil(og))
+		assert.Equal(t, ErrNoOperatorAuthentication, err)
+	})
+	t.Run("only client certificates should work", func(t *testing.T) {
+		args := createMockArgsOperatorGroup()
+		args.Token = ""
+		args.AllowClientCertificates = true
+
+		og, err := NewOperatorGroup(args)
+		assert.False(t, check.IfNil(og))
+		assert.Nil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		og, err := NewOperatorGroup(createMockArgsOperatorGroup())
+
+		assert.False(t, check.IfNil(og))
+		assert.Nil(t, err)
+	})
+}
+
+func TestOperatorGroup_Authentication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing or wrong token should be rejected and audited", func(t *testing.T) {
+		t.Parallel()
+
+		cleared := false
+		holder := &auditEntriesHolder{}
+		args := createMockArgsOperatorGroup()
+		args.AuditLog = holder.auditLog()
+		args.Facade = &mockFacade.RelayerFacadeStub{
+			ClearStoredSignaturesCalled: func() {
+				cleared = true
+			},
+		}
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodPost, "/operator/signatures/clear", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		req, _ = http.NewRequest(http.MethodPost, "/operator/signatures/clear", nil)
+		req.Header.Set("Authorization", "Bearer wrong-token")
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		assert.False(t, cleared)
+		require.Equal(t, 2, len(holder.entries))
+		for _, entry := range holder.entries {
+			assert.Equal(t, http.StatusUnauthorized, entry.StatusCode)
+			assert.Equal(t, "", entry.Identity)
+			assert.Equal(t, ErrUnauthorized.Error(), entry.Error)
+			assert.Equal(t, "/operator/signatures/clear", entry.Path)
+		}
+	})
+	t.Run("client certificate should be rejected if not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOperatorGroup()
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodPost, "/operator/signatures/clear", nil)
+		req.TLS = createVerifiedConnectionState("operator")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("unverified client certificate should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOperatorGroup()
+		args.AllowClientCertificates = true
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodPost, "/operator/signatures/clear", nil)
+		req.TLS = &tls.ConnectionState{}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("verified client certificate should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &auditEntriesHolder{}
+		args := createMockArgsOperatorGroup()
+		args.AuditLog = holder.auditLog()
+		args.AllowClientCertificates = true
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodPost, "/operator/signatures/clear", nil)
+		req.TLS = createVerifiedConnectionState("operator")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		require.Equal(t, 1, len(holder.entries))
+		assert.Equal(t, "certificate:operator", holder.entries[0].Identity)
+	})
+}
+
+func createVerifiedConnectionState(commonName string) *tls.ConnectionState {
+	certificate := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+	}
+
+	return &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{certificate}},
+	}
+}
+
+func TestOperatorGroup_StateMachineRoutes(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	calls := make([]string, 0)
+	holder := &auditEntriesHolder{}
+	args := createMockArgsOperatorGroup()
+	args.AuditLog = holder.auditLog()
+	args.Facade = &mockFacade.RelayerFacadeStub{
+		PauseStateMachineCalled: func(name string) error {
+			calls = append(calls, "pause "+name)
+			return nil
+		},
+		ResumeStateMachineCalled: func(name string) error {
+			calls = append(calls, "resume "+name)
+			return nil
+		},
+		ForceStartStepCalled: func(ctx context.Context, name string) error {
+			calls = append(calls, "reset "+name)
+			return expectedError
+		},
+	}
+	og, _ := NewOperatorGroup(args)
+	ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, createOperatorRequest("/operator/statemachine/EthereumToElrond/pause", ""))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, createOperatorRequest("/operator/statemachine/EthereumToElrond/resume", ""))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, createOperatorRequest("/operator/statemachine/ElrondToEthereum/reset", ""))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	response := generalResponse{}
+	loadResponse(resp.Body, &response)
+	assert.True(t, strings.Contains(response.Error, ErrResettingStateMachine.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedError.Error()))
+
+	assert.Equal(t, []string{"pause EthereumToElrond", "resume EthereumToElrond", "reset ElrondToEthereum"}, calls)
+	require.Equal(t, 3, len(holder.entries))
+	assert.Equal(t, "EthereumToElrond", holder.entries[0].Details)
+	assert.Equal(t, tokenIdentity, holder.entries[0].Identity)
+	assert.Equal(t, http.StatusOK, holder.entries[0].StatusCode)
+	assert.Equal(t, "ElrondToEthereum", holder.entries[2].Details)
+	assert.Equal(t, http.StatusInternalServerError, holder.entries[2].StatusCode)
+	assert.Equal(t, response.Error, holder.entries[2].Error)
+}
+
+func TestOperatorGroup_SetLogLevel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		og, _ := NewOperatorGroup(createMockArgsOperatorGroup())
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, createOperatorRequest("/operator/log-level", "not a json"))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should set the log level", func(t *testing.T) {
+		t.Parallel()
+
+		providedLogLevel := ""
+		holder := &auditEntriesHolder{}
+		args := createMockArgsOperatorGroup()
+		args.AuditLog = holder.auditLog()
+		args.Facade = &mockFacade.RelayerFacadeStub{
+			SetLogLevelCalled: func(logLevel string) error {
+				providedLogLevel = logLevel
+				return nil
+			},
+		}
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, createOperatorRequest("/operator/log-level", `{"logLevel":"*:DEBUG"}`))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "*:DEBUG", providedLogLevel)
+		require.Equal(t, 1, len(holder.entries))
+		assert.Equal(t, "*:DEBUG", holder.entries[0].Details)
+	})
+}
+
+func TestOperatorGroup_RefreshRoleProviders(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	args := createMockArgsOperatorGroup()
+	args.Facade = &mockFacade.RelayerFacadeStub{
+		RefreshRoleProvidersCalled: func(ctx context.Context) error {
+			return expectedError
+		},
+	}
+	og, _ := NewOperatorGroup(args)
+	ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, createOperatorRequest("/operator/role-providers/refresh", ""))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	response := generalResponse{}
+	loadResponse(resp.Body, &response)
+	assert.True(t, strings.Contains(response.Error, ErrRefreshingRoleProviders.Error()))
+}
+
+func TestOperatorGroup_ClosedRoute(t *testing.T) {
+	t.Parallel()
+
+	cleared := false
+	args := createMockArgsOperatorGroup()
+	args.Facade = &mockFacade.RelayerFacadeStub{
+		ClearStoredSignaturesCalled: func() {
+			cleared = true
+		},
+	}
+	og, _ := NewOperatorGroup(args)
+	ws := startWebServer(og, "operator", config.ApiRoutesConfig{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, createOperatorRequest("/operator/signatures/clear", ""))
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.False(t, cleared)
+}
+
+type reloadConfigResponse struct {
+	Data struct {
+		Report core.ConfigReloadReport `json:"report"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func TestOperatorGroup_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	report := core.ConfigReloadReport{
+		Applied: []core.ConfigChange{},
+		Rejected: []core.ConfigChange{
+			{
+				Path:     "P2P.Port",
+				OldValue: "10010",
+				NewValue: "10011",
+				Reason:   "requires a restart",
+			},
+		},
+	}
+
+	t.Run("missing token should not reload", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &auditEntriesHolder{}
+		args := createMockArgsOperatorGroup()
+		args.AuditLog = holder.auditLog()
+		args.Facade = &mockFacade.RelayerFacadeStub{
+			ReloadConfigCalled: func() (core.ConfigReloadReport, error) {
+				assert.Fail(t, "should have not reloaded the config")
+				return core.ConfigReloadReport{}, nil
+			},
+		}
+		og, _ := NewOperatorGroup(args)
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodPost, "/operator/reload-config", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		require.Equal(t, 1, len(holder.entries))
+		assert.Equal(t, "/operator/reload-config", holder.entries[0].Path)
+	})
+	t.Run("rejected changes should return conflict", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("expected error")
+		facade := mockFacade.RelayerFacadeStub{
+			ReloadConfigCalled: func() (core.ConfigReloadReport, error) {
+				return report, expectedError
+			},
+		}
+
+		args := createMockArgsOperatorGroup()
+		args.Facade = &facade
+		og, err := NewOperatorGroup(args)
+		require.NoError(t, err)
+
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, createOperatorRequest("/operator/reload-config", ""))
+
+		reloadRsp := reloadConfigResponse{}
+		loadResponse(resp.Body, &reloadRsp)
+
+		assert.Equal(t, report, reloadRsp.Data.Report)
+		assert.True(t, strings.Contains(reloadRsp.Error, expectedError.Error()))
+		assert.True(t, strings.Contains(reloadRsp.Error, ErrReloadingConfig.Error()))
+		require.Equal(t, http.StatusConflict, resp.Code)
+	})
+	t.Run("load error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("expected error")
+		facade := mockFacade.RelayerFacadeStub{
+			ReloadConfigCalled: func() (core.ConfigReloadReport, error) {
+				return core.ConfigReloadReport{}, expectedError
+			},
+		}
+
+		args := createMockArgsOperatorGroup()
+		args.Facade = &facade
+		og, err := NewOperatorGroup(args)
+		require.NoError(t, err)
+
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, createOperatorRequest("/operator/reload-config", ""))
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		appliedReport := core.ConfigReloadReport{
+			Applied: []core.ConfigChange{
+				{
+					Path:     "Eth.GasStation.MaximumAllowedGasPrice",
+					OldValue: "300",
+					NewValue: "500",
+				},
+			},
+			Rejected: []core.ConfigChange{},
+		}
+		facade := mockFacade.RelayerFacadeStub{
+			ReloadConfigCalled: func() (core.ConfigReloadReport, error) {
+				return appliedReport, nil
+			},
+		}
+
+		args := createMockArgsOperatorGroup()
+		args.Facade = &facade
+		og, err := NewOperatorGroup(args)
+		require.NoError(t, err)
+
+		ws := startWebServer(og, "operator", getOperatorRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, createOperatorRequest("/operator/reload-config", ""))
+
+		reloadRsp := reloadConfigResponse{}
+		loadResponse(resp.Body, &reloadRsp)
+
+		assert.Equal(t, appliedReport, reloadRsp.Data.Report)
+		assert.Empty(t, reloadRsp.Error)
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+type peerScoresResponse struct {
+	Data struct {
+		Scores []core.PeerScore `json:"scores"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,99 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	elrondApiShared "github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	stateMachineNameParam = "name"
+	historyPath           = "/:name/history"
+)
+
+type stateMachineGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStateMachineGroup returns a new instance of stateMachineGroup
+func NewStateMachineGroup(facade shared.FacadeHandler) (*stateMachineGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for state machine group", errors.ErrNilFacadeHandler)
+	}
+
+	smg := &stateMachineGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*elrondApiShared.EndpointHandlerData{
+		{
+			Path:    historyPath,
+			Method:  http.MethodGet,
+			Handler: smg.history,
+		},
+	}
+	smg.endpoints = endpoints
+
+	return smg, nil
+}
+
+// history returns the most recent transitions of the provided state machine
+func (smg *stateMachineGroup) history(c *gin.Context) {
+	name := c.Param(stateMachineNameParam)
+
+	transitions, err := smg.getFacade().GetStateMachineHistory(name)
+	if err != nil {
+		c.JSON(
+			http.StatusNotFound,
+			elrondApiShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingTransitionsHistory.Error(), err.Error()),
+				Code:  elrondApiShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		elrondApiShared.GenericAPIResponse{
+			Data:  gin.H{"history": transitions},
+			Error: "",
+			Code:  elrondApiShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (smg *stateMachineGroup) getFacade() shared.FacadeHandler {
+	smg.mutFacade.RLock()
+	defer smg.mutFacade.RUnlock()
+
+	return smg.facade
+}
+
+// UpdateFacade will update the facade
+func (smg *stateMachineGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	smg.mutFacade.Lock()
+	smg.facade = newFacade
+	smg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (smg *stateMachineGroup) IsInterfaceNil() bool {
+	return smg == nil
+}
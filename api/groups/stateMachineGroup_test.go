@@ -0,0 +1,139 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	mockFacade "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/facade"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	elrondApiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type historyResponse struct {
+	Data struct {
+		History []core.StepTransition `json:"history"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func getStateMachineRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"statemachine": {
+				Routes: []config.RouteConfig{
+					{Name: "/:name/history", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewStateMachineGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		smg, err := NewStateMachineGroup(nil)
+
+		assert.True(t, check.IfNil(smg))
+		assert.True(t, errors.Is(err, elrondApiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		smg, err := NewStateMachineGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(smg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetHistory_Errors(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	facade := mockFacade.RelayerFacadeStub{
+		GetStateMachineHistoryCalled: func(name string) ([]core.StepTransition, error) {
+			return nil, expectedError
+		},
+	}
+
+	smg, err := NewStateMachineGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(smg, "statemachine", getStateMachineRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/statemachine/missing/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	historyRsp := generalResponse{}
+	loadResponse(resp.Body, &historyRsp)
+
+	assert.Nil(t, historyRsp.Data)
+	assert.True(t, strings.Contains(historyRsp.Error, expectedError.Error()))
+	assert.True(t, strings.Contains(historyRsp.Error, ErrGettingTransitionsHistory.Error()))
+	require.Equal(t, resp.Code, http.StatusNotFound)
+}
+
+func TestGetHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	history := []core.StepTransition{
+		{
+			From:             "get pending batch from Ethereum",
+			To:               "propose transfer",
+			Timestamp:        1640995200,
+			DurationInMillis: 12000,
+			BatchID:          37,
+			ActionID:         112,
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetStateMachineHistoryCalled: func(name string) ([]core.StepTransition, error) {
+			assert.Equal(t, "EthereumToElrond", name)
+			return history, nil
+		},
+	}
+
+	smg, err := NewStateMachineGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(smg, "statemachine", getStateMachineRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/statemachine/EthereumToElrond/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	historyRsp := historyResponse{}
+	loadResponse(resp.Body, &historyRsp)
+
+	assert.Equal(t, history, historyRsp.Data.History)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, historyRsp.Error)
+}
+
+func TestStateMachineGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		smg, _ := NewStateMachineGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := smg.UpdateFacade(nil)
+		assert.Equal(t, elrondApiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		smg, _ := NewStateMachineGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := smg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, smg.facade == newFacade) // pointer testing
+	})
+}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/gin-gonic/gin"
@@ -22,6 +24,24 @@ type FacadeHandler interface {
 	PprofEnabled() bool
 	GetMetrics(name string) (core.GeneralMetrics, error)
 	GetMetricsList() core.GeneralMetrics
+	GetStateMachineHistory(name string) ([]core.StepTransition, error)
+	ReloadConfig() (core.ConfigReloadReport, error)
+	PauseStateMachine(name string) error
+	ResumeStateMachine(name string) error
+	ForceStartStep(ctx context.Context, name string) error
+	ClearStoredSignatures()
+	RefreshRoleProviders(ctx context.Context) error
+	SetLogLevel(logLevel string) error
+	GetLiveness() core.HealthReport
+	GetReadiness() core.HealthReport
+	GetPeerScores() []core.PeerScore
+	GetClusterInfo() []core.RelayerStatus
+	IsInterfaceNil() bool
+}
+
+// AuditLogger defines a component able to record the operator API calls
+type AuditLogger interface {
+	Log(entry AuditEntry) error
 	IsInterfaceNil() bool
 }
 
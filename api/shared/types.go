@@ -0,0 +1,13 @@
+package shared
+
+// AuditEntry holds the details of a single operator API call
+type AuditEntry struct {
+	Timestamp     int64  `json:"timestamp"`
+	RemoteAddress string `json:"remoteAddress"`
+	Identity      string `json:"identity"`
+	Method        string `json:"method"`
+	Path          string `json:"path"`
+	Details       string `json:"details,omitempty"`
+	StatusCode    int    `json:"statusCode"`
+	Error         string `json:"error,omitempty"`
+}
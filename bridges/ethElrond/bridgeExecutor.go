@@ -2,7 +2,9 @@ package ethElrond
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
@@ -28,9 +30,14 @@ type ArgsBridgeExecutor struct {
 	StatusHandler              core.StatusHandler
 	SignaturesHolder           SignaturesHolder
 	BatchValidator             clients.BatchValidator
+	FingerprintChecker         BatchFingerprintChecker
+	AlertHandler               core.AlertHandler
 	MaxQuorumRetriesOnEthereum uint64
 	MaxQuorumRetriesOnElrond   uint64
 	MaxRestriesOnWasProposed   uint64
+	// QuorumChecksBeforeSignaturesRequest is the number of failed quorum checks on Ethereum after which the leader
+	// requests the missing signatures from the other relayers. 0 disables the requests
+	QuorumChecksBeforeSignaturesRequest uint64
 }
 
 type bridgeExecutor struct {
@@ -42,10 +49,16 @@ type bridgeExecutor struct {
 	statusHandler              core.StatusHandler
 	sigsHolder                 SignaturesHolder
 	batchValidator             clients.BatchValidator
+	fingerprintChecker         BatchFingerprintChecker
+	alertHandler               core.AlertHandler
 	maxQuorumRetriesOnEthereum uint64
 	maxQuorumRetriesOnElrond   uint64
 	maxRetriesOnWasProposed    uint64
+	quorumChecksBeforeRequest  uint64
 
+	// the batch is written only by the state machine, the mutex guards it against the other goroutines calling
+	// GetStoredBatch (e.g. the heartbeat sender)
+	mutBatch                sync.RWMutex
 	batch                   *clients.TransferBatch
 	actionID                uint64
 	msgHash                 common.Hash
@@ -90,6 +103,12 @@ func checkArgs(args ArgsBridgeExecutor) error {
 	if check.IfNil(args.BatchValidator) {
 		return ErrNilBatchValidator
 	}
+	if check.IfNil(args.FingerprintChecker) {
+		return ErrNilBatchFingerprintChecker
+	}
+	if check.IfNil(args.AlertHandler) {
+		return ErrNilAlertHandler
+	}
 	if args.MaxQuorumRetriesOnEthereum < minRetries {
 		return fmt.Errorf("%w for args.MaxQuorumRetriesOnEthereum, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.MaxQuorumRetriesOnEthereum, minRetries)
@@ -115,9 +134,12 @@ func createBridgeExecutor(args ArgsBridgeExecutor) *bridgeExecutor {
 		timeForWaitOnEthereum:      args.TimeForWaitOnEthereum,
 		sigsHolder:                 args.SignaturesHolder,
 		batchValidator:             args.BatchValidator,
+		fingerprintChecker:         args.FingerprintChecker,
+		alertHandler:               args.AlertHandler,
 		maxQuorumRetriesOnEthereum: args.MaxQuorumRetriesOnEthereum,
 		maxQuorumRetriesOnElrond:   args.MaxQuorumRetriesOnElrond,
 		maxRetriesOnWasProposed:    args.MaxRestriesOnWasProposed,
+		quorumChecksBeforeRequest:  args.QuorumChecksBeforeSignaturesRequest,
 	}
 }
 
@@ -140,6 +162,44 @@ func (executor *bridgeExecutor) setExecutionMessageInStatusHandler(level logger.
 	executor.statusHandler.SetStringMetric(core.MetricLastError, msg)
 }
 
+func (executor *bridgeExecutor) alert(alertType core.AlertType, severity core.AlertSeverity, message string) {
+	executor.alertHandler.Alert(core.Alert{
+		Type:     alertType,
+		Severity: severity,
+		Source:   executor.statusHandler.Name(),
+		Message:  message,
+	})
+}
+
+// alertForBatch dispatches an alert scoped to the provided batch so the alerts for different batches are not
+// deduplicated against each other
+func (executor *bridgeExecutor) alertForBatch(alertType core.AlertType, severity core.AlertSeverity, batchID uint64, message string) {
+	executor.alertHandler.Alert(core.Alert{
+		Type:     alertType,
+		Severity: severity,
+		Source:   fmt.Sprintf("%s/batch-%d", executor.statusHandler.Name(), batchID),
+		Message:  message,
+	})
+}
+
+func (executor *bridgeExecutor) alertMaxQuorumRetriesReached(message string) {
+	if executor.batch == nil {
+		executor.alert(core.AlertMaxQuorumRetriesReached, core.AlertSeverityWarning, message)
+		return
+	}
+
+	executor.alertForBatch(core.AlertMaxQuorumRetriesReached, core.AlertSeverityWarning, executor.batch.ID, message)
+}
+
+func (executor *bridgeExecutor) alertOnKnownErrors(err error) {
+	switch {
+	case errors.Is(err, clients.ErrMultisigContractPaused):
+		executor.alert(core.AlertContractPaused, core.AlertSeverityCritical, err.Error())
+	case errors.Is(err, clients.ErrRelayerNotWhitelisted):
+		executor.alert(core.AlertNotWhitelisted, core.AlertSeverityCritical, err.Error())
+	}
+}
+
 // MyTurnAsLeader returns true if the current relayer node is the leader
 func (executor *bridgeExecutor) MyTurnAsLeader() bool {
 	return executor.topologyProvider.MyTurnAsLeader()
@@ -160,15 +220,34 @@ func (executor *bridgeExecutor) StoreBatchFromElrond(batch *clients.TransferBatc
 		return ErrNilBatch
 	}
 
-	executor.batch = batch
+	executor.setBatch(batch)
 	return nil
 }
 
+func (executor *bridgeExecutor) setBatch(batch *clients.TransferBatch) {
+	executor.mutBatch.Lock()
+	executor.batch = batch
+	executor.mutBatch.Unlock()
+}
+
 // GetStoredBatch returns the stored batch
 func (executor *bridgeExecutor) GetStoredBatch() *clients.TransferBatch {
+	executor.mutBatch.RLock()
+	defer executor.mutBatch.RUnlock()
+
 	return executor.batch
 }
 
+// IsBatchFingerprintAgreed returns true if enough relayers reported the same fingerprint for the stored batch. It
+// errors if the stored batch should be fetched again
+func (executor *bridgeExecutor) IsBatchFingerprintAgreed() (bool, error) {
+	if executor.batch == nil {
+		return false, ErrNilBatch
+	}
+
+	return executor.fingerprintChecker.CheckAgreement(executor.batch)
+}
+
 // GetLastExecutedEthBatchIDFromElrond returns the last executed batch ID that is stored on the Elrond SC
 func (executor *bridgeExecutor) GetLastExecutedEthBatchIDFromElrond(ctx context.Context) (uint64, error) {
 	batchID, err := executor.elrondClient.GetLastExecutedEthBatchID(ctx)
@@ -259,13 +338,14 @@ func (executor *bridgeExecutor) ProposeTransferOnElrond(ctx context.Context) err
 
 	hash, err := executor.elrondClient.ProposeTransfer(ctx, executor.batch)
 	if err != nil {
+		executor.alertOnKnownErrors(err)
 		return err
 	}
 
 	executor.log.Info("proposed transfer", "hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
 
-	return nil
+	return executor.waitForElrondTransactionOutcome(ctx, hash)
 }
 
 // ProcessMaxRetriesOnWasTransferProposedOnElrond checks if the retries on Elrond were reached and increments the counter
@@ -300,13 +380,14 @@ func (executor *bridgeExecutor) ProposeSetStatusOnElrond(ctx context.Context) er
 
 	hash, err := executor.elrondClient.ProposeSetStatus(ctx, executor.batch)
 	if err != nil {
+		executor.alertOnKnownErrors(err)
 		return err
 	}
 
 	executor.log.Info("proposed set status", "hash", hash,
 		"batch ID", executor.batch.ID)
 
-	return nil
+	return executor.waitForElrondTransactionOutcome(ctx, hash)
 }
 
 // WasActionSignedOnElrond returns true if the current relayer already signed the action
@@ -318,12 +399,13 @@ func (executor *bridgeExecutor) WasActionSignedOnElrond(ctx context.Context) (bo
 func (executor *bridgeExecutor) SignActionOnElrond(ctx context.Context) error {
 	hash, err := executor.elrondClient.Sign(ctx, executor.actionID)
 	if err != nil {
+		executor.alertOnKnownErrors(err)
 		return err
 	}
 
 	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID)
 
-	return nil
+	return executor.waitForElrondTransactionOutcome(ctx, hash)
 }
 
 // ProcessQuorumReachedOnElrond returns true if the proposed transfer reached the set quorum
@@ -405,13 +487,29 @@ func (executor *bridgeExecutor) PerformActionOnElrond(ctx context.Context) error
 
 	hash, err := executor.elrondClient.PerformAction(ctx, executor.actionID, executor.batch)
 	if err != nil {
+		executor.alertOnKnownErrors(err)
 		return err
 	}
 
 	executor.log.Info("sent perform action transaction", "hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
 
-	return nil
+	return executor.waitForElrondTransactionOutcome(ctx, hash)
+}
+
+// waitForElrondTransactionOutcome returns the decoded failure of the sent transaction. If the outcome could not be
+// determined in time, the steps will rely on the contract state queries
+func (executor *bridgeExecutor) waitForElrondTransactionOutcome(ctx context.Context, hash string) error {
+	err := executor.elrondClient.WaitForTransactionOutcome(ctx, hash)
+	if errors.Is(err, clients.ErrTransactionOutcomeTimeout) {
+		executor.log.Warn("unknown transaction outcome", "hash", hash, "error", err)
+		return nil
+	}
+	if err != nil {
+		executor.log.Error("transaction failed on Elrond", "hash", hash, "error", err)
+	}
+
+	return err
 }
 
 // ResolveNewDepositsStatuses resolves the new deposits statuses for batch
@@ -426,6 +524,9 @@ func (executor *bridgeExecutor) ProcessMaxQuorumRetriesOnElrond() bool {
 		return false
 	}
 
+	executor.alertMaxQuorumRetriesReached(
+		fmt.Sprintf("max quorum retries reached on Elrond: %d, action ID: %d", executor.maxQuorumRetriesOnElrond, executor.actionID))
+
 	return true
 }
 
@@ -447,7 +548,7 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 			ErrBatchNotFound, nonce, batch.ID, len(batch.Deposits))
 	}
 
-	executor.batch = batch
+	executor.setBatch(batch)
 
 	return nil
 }
@@ -495,6 +596,7 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 
 	hash, err := executor.ethereumClient.ExecuteTransfer(ctx, executor.msgHash, executor.batch, int(quorumSize.Int64()))
 	if err != nil {
+		executor.alertOnKnownErrors(err)
 		return err
 	}
 
@@ -506,7 +608,29 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 
 // ProcessQuorumReachedOnEthereum returns true if the proposed transfer reached the set quorum
 func (executor *bridgeExecutor) ProcessQuorumReachedOnEthereum(ctx context.Context) (bool, error) {
-	return executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	isQuorumReached, err := executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	if err != nil || isQuorumReached {
+		return isQuorumReached, err
+	}
+
+	if executor.shouldRequestSignatures() {
+		executor.log.Debug("quorum not reached, requesting the signatures from the other relayers",
+			"message hash", executor.msgHash.String(), "quorum checks", executor.quorumRetriesOnEthereum)
+		executor.ethereumClient.RequestSignaturesForMessageHash(executor.msgHash)
+	}
+
+	return false, nil
+}
+
+func (executor *bridgeExecutor) shouldRequestSignatures() bool {
+	if executor.quorumChecksBeforeRequest == 0 {
+		return false
+	}
+	if executor.quorumRetriesOnEthereum < executor.quorumChecksBeforeRequest {
+		return false
+	}
+
+	return executor.topologyProvider.MyTurnAsLeader()
 }
 
 // ProcessMaxQuorumRetriesOnEthereum checks if the retries on Ethereum were reached and increments the counter
@@ -516,6 +640,9 @@ func (executor *bridgeExecutor) ProcessMaxQuorumRetriesOnEthereum() bool {
 		return false
 	}
 
+	executor.alertMaxQuorumRetriesReached(
+		fmt.Sprintf("max quorum retries reached on Ethereum: %d, message hash: %s", executor.maxQuorumRetriesOnEthereum, executor.msgHash.String()))
+
 	return true
 }
 
@@ -532,7 +659,13 @@ func (executor *bridgeExecutor) ClearStoredP2PSignaturesForEthereum() {
 
 // ValidateBatch returns true if the given batch is validated on microservice side
 func (executor *bridgeExecutor) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
-	return executor.batchValidator.ValidateBatch(ctx, batch)
+	isValid, err := executor.batchValidator.ValidateBatch(ctx, batch)
+	if err == nil && !isValid && batch != nil {
+		executor.alertForBatch(core.AlertInvalidBatch, core.AlertSeverityCritical, batch.ID,
+			fmt.Sprintf("batch ID %d was not validated by the batch validator", batch.ID))
+	}
+
+	return isValid, err
 }
 
 // CheckElrondClientAvailability trigger a self availability check for the elrond client
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -33,6 +34,8 @@ func createMockExecutorArgs() ArgsBridgeExecutor {
 		TimeForWaitOnEthereum:      time.Second,
 		SignaturesHolder:           &testsCommon.SignaturesHolderStub{},
 		BatchValidator:             &testsCommon.BatchValidatorStub{},
+		FingerprintChecker:         &bridgeTests.BatchFingerprintCheckerStub{},
+		AlertHandler:               &testsCommon.AlertHandlerStub{},
 		MaxQuorumRetriesOnEthereum: minRetries,
 		MaxQuorumRetriesOnElrond:   minRetries,
 		MaxRestriesOnWasProposed:   minRetries,
@@ -122,6 +125,26 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, check.IfNil(executor))
 		assert.Equal(t, ErrNilBatchValidator, err)
 	})
+	t.Run("nil fingerprint checker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.FingerprintChecker = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchFingerprintChecker, err)
+	})
+	t.Run("nil alert handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.AlertHandler = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilAlertHandler, err)
+	})
 	t.Run("invalid MaxQuorumRetriesOnEthereum value", func(t *testing.T) {
 		t.Parallel()
 
@@ -364,6 +387,30 @@ func TestEthToElrondBridgeExecutor_GetAndStoreBatchFromEthereum(t *testing.T) {
 	})
 }
 
+func TestBridgeExecutor_GetStoredBatchConcurrentCalls(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	executor, _ := NewBridgeExecutor(args)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls * 2)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: uint64(idx)})
+			wg.Done()
+		}(i)
+		go func() {
+			_ = executor.GetStoredBatch()
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	assert.NotNil(t, executor.GetStoredBatch())
+}
+
 func TestEthToElrondBridgeExecutor_GetLastExecutedEthBatchIDFromElrond(t *testing.T) {
 	t.Parallel()
 
@@ -573,6 +620,37 @@ func TestEthToElrondBridgeExecutor_ProposeTransferOnElrond(t *testing.T) {
 		err := executor.ProposeTransferOnElrond(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("propose transfer fails on paused contract should alert", func(t *testing.T) {
+		t.Parallel()
+
+		pausedErr := fmt.Errorf("%w in client.ExecuteTransfer", clients.ErrMultisigContractPaused)
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			ProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+				return "", pausedErr
+			},
+		}
+		alerts := make([]core.Alert, 0)
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				alerts = append(alerts, alert)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.ProposeTransferOnElrond(context.Background())
+		assert.Equal(t, pausedErr, err)
+		expectedAlerts := []core.Alert{
+			{
+				Type:     core.AlertContractPaused,
+				Severity: core.AlertSeverityCritical,
+				Source:   "test",
+				Message:  pausedErr.Error(),
+			},
+		}
+		assert.Equal(t, expectedAlerts, alerts)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -758,6 +836,44 @@ func TestEthToElrondBridgeExecutor_PerformActionOnElrond(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
 	})
+	t.Run("failed transaction outcome should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		outcomeErr := fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutOfGas)
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error) {
+				return "hash", nil
+			},
+			WaitForTransactionOutcomeCalled: func(ctx context.Context, hash string) error {
+				assert.Equal(t, "hash", hash)
+				return outcomeErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnElrond(context.Background())
+		assert.True(t, errors.Is(err, clients.ErrTransactionOutOfGas))
+	})
+	t.Run("unknown transaction outcome should not error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error) {
+				return "hash", nil
+			},
+			WaitForTransactionOutcomeCalled: func(ctx context.Context, hash string) error {
+				return fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutcomeTimeout)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnElrond(context.Background())
+		assert.Nil(t, err)
+	})
 }
 
 func TestEthToElrondBridgeExecutor_RetriesCountOnElrond(t *testing.T) {
@@ -765,13 +881,24 @@ func TestEthToElrondBridgeExecutor_RetriesCountOnElrond(t *testing.T) {
 
 	args := createMockExecutorArgs()
 	args.MaxQuorumRetriesOnElrond = expectedMaxRetries
+	alerts := make([]core.Alert, 0)
+	args.AlertHandler = &testsCommon.AlertHandlerStub{
+		AlertCalled: func(alert core.Alert) {
+			alerts = append(alerts, alert)
+		},
+	}
 	executor, _ := NewBridgeExecutor(args)
+	executor.batch = &clients.TransferBatch{ID: 37}
 	for i := uint64(0); i < expectedMaxRetries; i++ {
 		assert.False(t, executor.ProcessMaxQuorumRetriesOnElrond())
 	}
 
 	assert.Equal(t, expectedMaxRetries, executor.quorumRetriesOnElrond)
+	assert.Equal(t, 0, len(alerts))
 	assert.True(t, executor.ProcessMaxQuorumRetriesOnElrond())
+	assert.Equal(t, 1, len(alerts))
+	assert.Equal(t, core.AlertMaxQuorumRetriesReached, alerts[0].Type)
+	assert.Equal(t, "test/batch-37", alerts[0].Source)
 	executor.ResetRetriesCountOnElrond()
 	assert.Equal(t, uint64(0), executor.quorumRetriesOnElrond)
 }
@@ -1233,6 +1360,92 @@ func TestElrondToEthBridgeExecutor_IsQuorumReachedOnEthereum(t *testing.T) {
 		assert.True(t, wasCalled)
 		assert.True(t, isReached)
 	})
+	t.Run("quorum not reached should request the signatures only after the configured checks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.MaxQuorumRetriesOnEthereum = expectedMaxRetries
+		args.QuorumChecksBeforeSignaturesRequest = 2
+		expectedMsgHash := common.HexToHash("c99286352d865e33f1747761cbd440a7906b9bd8a5261cb6909e5ba18dd19b08")
+		numRequests := 0
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, nil
+			},
+			RequestSignaturesForMessageHashCalled: func(msgHash common.Hash) {
+				assert.Equal(t, expectedMsgHash, msgHash)
+				numRequests++
+			},
+		}
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			MyTurnAsLeaderCalled: func() bool {
+				return true
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.msgHash = expectedMsgHash
+
+		for i := 0; i < 3; i++ {
+			_ = executor.ProcessMaxQuorumRetriesOnEthereum()
+			isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+			assert.Nil(t, err)
+			assert.False(t, isReached)
+		}
+		assert.Equal(t, 2, numRequests)
+	})
+	t.Run("quorum not reached should not request the signatures if not leader", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.QuorumChecksBeforeSignaturesRequest = 1
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, nil
+			},
+			RequestSignaturesForMessageHashCalled: func(msgHash common.Hash) {
+				assert.Fail(t, "should have not requested the signatures")
+			},
+		}
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			MyTurnAsLeaderCalled: func() bool {
+				return false
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		_ = executor.ProcessMaxQuorumRetriesOnEthereum()
+
+		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isReached)
+	})
+	t.Run("disabled signatures requests should not request", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.QuorumChecksBeforeSignaturesRequest = 0
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, nil
+			},
+			RequestSignaturesForMessageHashCalled: func(msgHash common.Hash) {
+				assert.Fail(t, "should have not requested the signatures")
+			},
+		}
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			MyTurnAsLeaderCalled: func() bool {
+				return true
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		_ = executor.ProcessMaxQuorumRetriesOnEthereum()
+
+		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isReached)
+	})
 }
 
 func TestElrondToEthBridgeExecutor_RetriesCountOnEthereum(t *testing.T) {
@@ -1655,3 +1868,68 @@ func TestBridgeExecutor_ValidateBatch(t *testing.T) {
 	assert.True(t, result)
 	assert.True(t, validateBatchCalled)
 }
+
+func TestBridgeExecutor_IsBatchFingerprintAgreed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		agreed, err := executor.IsBatchFingerprintAgreed()
+
+		assert.False(t, agreed)
+		assert.Equal(t, ErrNilBatch, err)
+	})
+	t.Run("should return the checker's result for the stored batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		providedBatch := &clients.TransferBatch{
+			ID: 45,
+		}
+		args.FingerprintChecker = &bridgeTests.BatchFingerprintCheckerStub{
+			CheckAgreementCalled: func(batch *clients.TransferBatch) (bool, error) {
+				assert.True(t, providedBatch == batch) // pointer testing
+				return true, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		agreed, err := executor.IsBatchFingerprintAgreed()
+
+		assert.Nil(t, err)
+		assert.True(t, agreed)
+	})
+}
+
+func TestBridgeExecutor_ValidateBatchNotValidShouldAlert(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	args.BatchValidator = &testsCommon.BatchValidatorStub{
+		ValidateBatchCalled: func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			return false, nil
+		},
+	}
+	alerts := make([]core.Alert, 0)
+	args.AlertHandler = &testsCommon.AlertHandlerStub{
+		AlertCalled: func(alert core.Alert) {
+			alerts = append(alerts, alert)
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+	result, err := executor.ValidateBatch(context.Background(), &clients.TransferBatch{ID: 45})
+
+	assert.Nil(t, err)
+	assert.False(t, result)
+	expectedAlerts := []core.Alert{
+		{
+			Type:     core.AlertInvalidBatch,
+			Severity: core.AlertSeverityCritical,
+			Source:   "test/batch-45",
+			Message:  "batch ID 45 was not validated by the batch validator",
+		},
+	}
+	assert.Equal(t, expectedAlerts, alerts)
+}
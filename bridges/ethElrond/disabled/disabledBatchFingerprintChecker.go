@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/clients"
+
+type disabledBatchFingerprintChecker struct {
+}
+
+// NewDisabledBatchFingerprintChecker will return a disabled batch fingerprint checker instance
+func NewDisabledBatchFingerprintChecker() *disabledBatchFingerprintChecker {
+	return &disabledBatchFingerprintChecker{}
+}
+
+// CheckAgreement returns true
+func (disabled *disabledBatchFingerprintChecker) CheckAgreement(_ *clients.TransferBatch) (bool, error) {
+	return true, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchFingerprintChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,18 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchFingerprintChecker_CheckAgreement(t *testing.T) {
+	t.Parallel()
+
+	disabled := NewDisabledBatchFingerprintChecker()
+	assert.False(t, check.IfNil(disabled))
+	isAgreed, err := disabled.CheckAgreement(nil)
+	assert.True(t, isAgreed)
+	assert.Nil(t, err)
+}
@@ -40,3 +40,9 @@ var ErrNilSignaturesHolder = errors.New("nil signatures holder")
 
 // ErrNilBatchValidator signals that a nil batch validator was provided
 var ErrNilBatchValidator = errors.New("nil batch validator")
+
+// ErrNilBatchFingerprintChecker signals that a nil batch fingerprint checker was provided
+var ErrNilBatchFingerprintChecker = errors.New("nil batch fingerprint checker")
+
+// ErrNilAlertHandler signals that a nil alert handler was provided
+var ErrNilAlertHandler = errors.New("nil alert handler")
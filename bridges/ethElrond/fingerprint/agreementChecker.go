@@ -0,0 +1,221 @@
+package fingerprint
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+const (
+	minAgreeingPeers = 1
+	minFailedChecks  = 1
+)
+
+// ArgsAgreementChecker is the DTO used to create a new instance of agreementChecker
+type ArgsAgreementChecker struct {
+	Log              logger.Logger
+	BridgeName       string
+	Broadcaster      Broadcaster
+	AlertHandler     core.AlertHandler
+	SelfPublicKey    []byte
+	MinAgreeingPeers uint32
+	MaxFailedChecks  uint32
+}
+
+type agreementChecker struct {
+	log              logger.Logger
+	bridgeName       string
+	broadcaster      Broadcaster
+	alertHandler     core.AlertHandler
+	selfPublicKey    []byte
+	minAgreeingPeers int
+	maxFailedChecks  int
+
+	mut               sync.Mutex
+	peersFingerprints map[string]*core.BatchFingerprint
+	lastBroadcast     *core.BatchFingerprint
+	numFailedChecks   int
+}
+
+// NewAgreementChecker creates a new instance of agreementChecker able to tell if enough relayers built the same view
+// of a batch before this relayer signs or proposes it
+func NewAgreementChecker(args ArgsAgreementChecker) (*agreementChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &agreementChecker{
+		log:               args.Log,
+		bridgeName:        args.BridgeName,
+		broadcaster:       args.Broadcaster,
+		alertHandler:      args.AlertHandler,
+		selfPublicKey:     args.SelfPublicKey,
+		minAgreeingPeers:  int(args.MinAgreeingPeers),
+		maxFailedChecks:   int(args.MaxFailedChecks),
+		peersFingerprints: make(map[string]*core.BatchFingerprint),
+	}, nil
+}
+
+func checkArgs(args ArgsAgreementChecker) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if len(args.BridgeName) == 0 {
+		return ErrEmptyBridgeName
+	}
+	if check.IfNil(args.Broadcaster) {
+		return ErrNilBroadcaster
+	}
+	if check.IfNil(args.AlertHandler) {
+		return ErrNilAlertHandler
+	}
+	if len(args.SelfPublicKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if args.MinAgreeingPeers < minAgreeingPeers {
+		return fmt.Errorf("%w for MinAgreeingPeers, got: %d, minimum: %d",
+			ErrInvalidValue, args.MinAgreeingPeers, minAgreeingPeers)
+	}
+	if args.MaxFailedChecks < minFailedChecks {
+		return fmt.Errorf("%w for MaxFailedChecks, got: %d, minimum: %d",
+			ErrInvalidValue, args.MaxFailedChecks, minFailedChecks)
+	}
+
+	return nil
+}
+
+// ProcessBatchFingerprint will store the last batch fingerprint received from the relayer with the provided public key.
+// The fingerprints for the other half-bridge and the ones sent by this relayer are ignored. If the relayer reports for
+// the first time the batch this relayer is checking, the own fingerprint is sent again so that a relayer that joined
+// late (e.g. after a restart) also receives it
+func (checker *agreementChecker) ProcessBatchFingerprint(publicKey []byte, fingerprint *core.BatchFingerprint) {
+	if fingerprint == nil || fingerprint.BridgeName != checker.bridgeName {
+		return
+	}
+	if bytes.Equal(publicKey, checker.selfPublicKey) {
+		return
+	}
+
+	checker.mut.Lock()
+	previous := checker.peersFingerprints[string(publicKey)]
+	checker.peersFingerprints[string(publicKey)] = fingerprint
+	own := checker.lastBroadcast
+	checker.mut.Unlock()
+
+	isNewBatchForPeer := previous == nil || previous.BatchID != fingerprint.BatchID
+	if isNewBatchForPeer && own != nil && own.BatchID == fingerprint.BatchID {
+		checker.broadcaster.BroadcastBatchFingerprint(own)
+	}
+}
+
+// CheckAgreement will return true if at least the minimum number of peers reported the same fingerprint as the one
+// of the provided batch. The own fingerprint is broadcast only once for each batch and fingerprint, the stored peers
+// fingerprints for older batches are dropped. Each peer that reported a different fingerprint for the same batch
+// raises an alert containing the differing fields. An error is returned if the minimum number of peers already moved
+// to a newer batch or if the batch was not agreed in the maximum number of consecutive checks, so the caller can
+// fetch the batch again
+func (checker *agreementChecker) CheckAgreement(batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, nil
+	}
+
+	own := NewBatchFingerprint(checker.bridgeName, batch)
+
+	checker.mut.Lock()
+	shouldBroadcast := !isSameFingerprint(checker.lastBroadcast, own)
+	if shouldBroadcast {
+		checker.lastBroadcast = own
+		checker.numFailedChecks = 0
+	}
+
+	numAgreeing := 0
+	numAhead := 0
+	divergent := make(map[string]*core.BatchFingerprint)
+	for publicKey, fingerprint := range checker.peersFingerprints {
+		if fingerprint.BatchID < own.BatchID {
+			delete(checker.peersFingerprints, publicKey)
+			continue
+		}
+		if fingerprint.BatchID > own.BatchID {
+			numAhead++
+			continue
+		}
+		if bytes.Equal(fingerprint.Fingerprint, own.Fingerprint) {
+			numAgreeing++
+			continue
+		}
+
+		divergent[publicKey] = fingerprint
+	}
+
+	isAgreed := numAgreeing >= checker.minAgreeingPeers
+	numFailedChecks := 0
+	if !isAgreed {
+		checker.numFailedChecks++
+		numFailedChecks = checker.numFailedChecks
+	}
+	isBehind := numAhead >= checker.minAgreeingPeers
+	maxFailedChecksReached := numFailedChecks >= checker.maxFailedChecks
+	if isAgreed || isBehind || maxFailedChecksReached {
+		checker.numFailedChecks = 0
+	}
+	checker.mut.Unlock()
+
+	if shouldBroadcast {
+		checker.broadcaster.BroadcastBatchFingerprint(own)
+	}
+	for publicKey, fingerprint := range divergent {
+		checker.alertDivergence([]byte(publicKey), own, fingerprint)
+	}
+
+	checker.log.Debug("checked batch fingerprint agreement", "batch ID", own.BatchID,
+		"num agreeing peers", numAgreeing, "minimum", checker.minAgreeingPeers,
+		"num peers on newer batches", numAhead, "num failed checks", numFailedChecks)
+
+	if isAgreed {
+		return true, nil
+	}
+	if isBehind {
+		return false, fmt.Errorf("%w, batch ID: %d, num peers: %d", ErrPeersOnNewerBatch, own.BatchID, numAhead)
+	}
+	if maxFailedChecksReached {
+		return false, fmt.Errorf("%w, batch ID: %d, num checks: %d", ErrMaxFailedChecksReached, own.BatchID, numFailedChecks)
+	}
+
+	return false, nil
+}
+
+func isSameFingerprint(first *core.BatchFingerprint, second *core.BatchFingerprint) bool {
+	if first == nil || second == nil {
+		return false
+	}
+
+	return first.BatchID == second.BatchID && bytes.Equal(first.Fingerprint, second.Fingerprint)
+}
+
+func (checker *agreementChecker) alertDivergence(publicKey []byte, own *core.BatchFingerprint, other *core.BatchFingerprint) {
+	address := data.NewAddressFromBytes(publicKey).AddressAsBech32String()
+	fields := strings.Join(differingFields(own, other), ", ")
+	checker.log.Warn("batch fingerprint mismatch", "batch ID", own.BatchID, "relayer", address, "differing fields", fields)
+
+	checker.alertHandler.Alert(core.Alert{
+		Type:     core.AlertBatchFingerprintMismatch,
+		Severity: core.AlertSeverityCritical,
+		Source:   fmt.Sprintf("%s/%s/batch-%d", checker.bridgeName, address, own.BatchID),
+		Message: fmt.Sprintf("relayer %s reported a different fingerprint for batch %d, differing fields: %s",
+			address, own.BatchID, fields),
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *agreementChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
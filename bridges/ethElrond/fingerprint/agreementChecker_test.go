@@ -0,0 +1,264 @@
+package fingerprint
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBridgeName = "EthereumToElrond"
+
+var (
+	selfPublicKey = []byte("public key 0 ...................")
+	peer1         = []byte("public key 1 ...................")
+	peer2         = []byte("public key 2 ...................")
+)
+
+func createMockArgsAgreementChecker() ArgsAgreementChecker {
+	return ArgsAgreementChecker{
+		Log:              &testsCommon.LoggerStub{},
+		BridgeName:       testBridgeName,
+		Broadcaster:      &testsCommon.BroadcasterStub{},
+		AlertHandler:     &testsCommon.AlertHandlerStub{},
+		SelfPublicKey:    selfPublicKey,
+		MinAgreeingPeers: 2,
+		MaxFailedChecks:  10,
+	}
+}
+
+func checkAgreement(t *testing.T, checker *agreementChecker, batch *clients.TransferBatch) bool {
+	isAgreed, err := checker.CheckAgreement(batch)
+	require.Nil(t, err)
+
+	return isAgreed
+}
+
+func TestNewAgreementChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.Log = nil
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty bridge name should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.BridgeName = ""
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrEmptyBridgeName, err)
+	})
+	t.Run("nil broadcaster should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.Broadcaster = nil
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilBroadcaster, err)
+	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.AlertHandler = nil
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilAlertHandler, err)
+	})
+	t.Run("empty public key should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.SelfPublicKey = nil
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrEmptyPublicKey, err)
+	})
+	t.Run("invalid min agreeing peers should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.MinAgreeingPeers = 0
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MinAgreeingPeers"))
+	})
+	t.Run("invalid max failed checks should error", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.MaxFailedChecks = 0
+
+		checker, err := NewAgreementChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxFailedChecks"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		checker, err := NewAgreementChecker(createMockArgsAgreementChecker())
+		assert.False(t, check.IfNil(checker))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAgreementChecker_CheckAgreement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should return false", func(t *testing.T) {
+		checker, _ := NewAgreementChecker(createMockArgsAgreementChecker())
+		assert.False(t, checkAgreement(t, checker, nil))
+	})
+	t.Run("should broadcast the fingerprint once and wait for the peers", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		broadcast := make([]*core.BatchFingerprint, 0)
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastBatchFingerprintCalled: func(fingerprint *core.BatchFingerprint) {
+				broadcast = append(broadcast, fingerprint)
+			},
+		}
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				assert.Fail(t, "should have not raised an alert")
+			},
+		}
+		checker, _ := NewAgreementChecker(args)
+
+		batch := createTestBatch()
+		fp := NewBatchFingerprint(testBridgeName, batch)
+		assert.False(t, checkAgreement(t, checker, batch))
+		assert.False(t, checkAgreement(t, checker, batch))
+		require.Equal(t, 1, len(broadcast))
+		assert.Equal(t, fp, broadcast[0])
+
+		checker.ProcessBatchFingerprint(selfPublicKey, fp)
+		checker.ProcessBatchFingerprint(peer2, NewBatchFingerprint("ElrondToEthereum", batch))
+		assert.Equal(t, 1, len(broadcast))
+
+		// the first report of the batch from a peer triggers one more broadcast, for the relayers that joined late
+		checker.ProcessBatchFingerprint(peer1, fp)
+		checker.ProcessBatchFingerprint(peer1, fp)
+		assert.Equal(t, 2, len(broadcast))
+		assert.False(t, checkAgreement(t, checker, batch))
+
+		checker.ProcessBatchFingerprint(peer2, fp)
+		assert.True(t, checkAgreement(t, checker, batch))
+		assert.Equal(t, 3, len(broadcast))
+		for _, broadcastFingerprint := range broadcast {
+			assert.Equal(t, fp, broadcastFingerprint)
+		}
+	})
+	t.Run("changed fingerprint or new batch should be broadcast again", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		numBroadcasts := 0
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastBatchFingerprintCalled: func(fingerprint *core.BatchFingerprint) {
+				numBroadcasts++
+			},
+		}
+		checker, _ := NewAgreementChecker(args)
+
+		batch := createTestBatch()
+		_ = checkAgreement(t, checker, batch)
+		assert.Equal(t, 1, numBroadcasts)
+
+		batch.Deposits[0].ConvertedTokenBytes = []byte("another token")
+		_ = checkAgreement(t, checker, batch)
+		assert.Equal(t, 2, numBroadcasts)
+
+		batch.ID++
+		_ = checkAgreement(t, checker, batch)
+		assert.Equal(t, 3, numBroadcasts)
+	})
+	t.Run("fingerprints of older batches should be dropped", func(t *testing.T) {
+		checker, _ := NewAgreementChecker(createMockArgsAgreementChecker())
+
+		batch := createTestBatch()
+		olderBatch := createTestBatch()
+		olderBatch.ID = batch.ID - 1
+		newerBatch := createTestBatch()
+		newerBatch.ID = batch.ID + 1
+		checker.ProcessBatchFingerprint(peer1, NewBatchFingerprint(testBridgeName, olderBatch))
+		checker.ProcessBatchFingerprint(peer2, NewBatchFingerprint(testBridgeName, newerBatch))
+
+		assert.False(t, checkAgreement(t, checker, batch))
+		require.Equal(t, 1, len(checker.peersFingerprints))
+		assert.Equal(t, newerBatch.ID, checker.peersFingerprints[string(peer2)].BatchID)
+	})
+	t.Run("enough peers on newer batches should error", func(t *testing.T) {
+		checker, _ := NewAgreementChecker(createMockArgsAgreementChecker())
+
+		batch := createTestBatch()
+		newerBatch := createTestBatch()
+		newerBatch.ID = batch.ID + 1
+		checker.ProcessBatchFingerprint(peer1, NewBatchFingerprint(testBridgeName, newerBatch))
+		assert.False(t, checkAgreement(t, checker, batch))
+
+		checker.ProcessBatchFingerprint(peer2, NewBatchFingerprint(testBridgeName, newerBatch))
+		isAgreed, err := checker.CheckAgreement(batch)
+		assert.False(t, isAgreed)
+		assert.True(t, errors.Is(err, ErrPeersOnNewerBatch))
+	})
+	t.Run("max failed checks should error and restart the count", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		args.MaxFailedChecks = 3
+		checker, _ := NewAgreementChecker(args)
+
+		batch := createTestBatch()
+		for i := 0; i < 2; i++ {
+			assert.False(t, checkAgreement(t, checker, batch))
+		}
+		isAgreed, err := checker.CheckAgreement(batch)
+		assert.False(t, isAgreed)
+		assert.True(t, errors.Is(err, ErrMaxFailedChecksReached))
+		assert.False(t, checkAgreement(t, checker, batch))
+
+		// a changed batch restarts the count
+		assert.False(t, checkAgreement(t, checker, batch))
+		batch.ID++
+		assert.False(t, checkAgreement(t, checker, batch))
+		assert.False(t, checkAgreement(t, checker, batch))
+	})
+	t.Run("fingerprints for other batches should be ignored", func(t *testing.T) {
+		checker, _ := NewAgreementChecker(createMockArgsAgreementChecker())
+
+		otherBatch := createTestBatch()
+		otherBatch.ID = 6
+		checker.ProcessBatchFingerprint(peer1, NewBatchFingerprint(testBridgeName, otherBatch))
+		checker.ProcessBatchFingerprint(peer2, NewBatchFingerprint(testBridgeName, otherBatch))
+
+		assert.False(t, checkAgreement(t, checker, createTestBatch()))
+	})
+	t.Run("divergent fingerprint should raise an alert", func(t *testing.T) {
+		args := createMockArgsAgreementChecker()
+		alerts := make([]core.Alert, 0)
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				alerts = append(alerts, alert)
+			},
+		}
+		checker, _ := NewAgreementChecker(args)
+
+		batch := createTestBatch()
+		divergentBatch := createTestBatch()
+		divergentBatch.Deposits[0].ConvertedTokenBytes = []byte("another token")
+		checker.ProcessBatchFingerprint(peer1, NewBatchFingerprint(testBridgeName, batch))
+		checker.ProcessBatchFingerprint(peer2, NewBatchFingerprint(testBridgeName, divergentBatch))
+
+		assert.False(t, checkAgreement(t, checker, batch))
+		require.Equal(t, 1, len(alerts))
+		assert.Equal(t, core.AlertBatchFingerprintMismatch, alerts[0].Type)
+		assert.Equal(t, core.AlertSeverityCritical, alerts[0].Severity)
+		expectedSource := fmt.Sprintf("%s/%s/batch-%d", testBridgeName, data.NewAddressFromBytes(peer2).AddressAsBech32String(), batch.ID)
+		assert.Equal(t, expectedSource, alerts[0].Source)
+		assert.True(t, strings.Contains(alerts[0].Message, "differing fields: deposit 0 converted token"))
+	})
+}
@@ -0,0 +1,107 @@
+package fingerprint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+)
+
+const uint64Size = 8
+
+var hasher = sha256.NewSha256()
+
+// NewBatchFingerprint creates the fingerprint of the provided batch. The batch is canonicalised as an ordered list of
+// fields (the batch ID, the number of deposits and, for each deposit, its nonce, addresses, tokens, amount and whether
+// it was rejected by the tokens policy), each one being hashed separately so the relayers can tell which fields
+// differ. The other statuses are not part of the fingerprint as they are only known after the batch was executed
+func NewBatchFingerprint(bridgeName string, batch *clients.TransferBatch) *core.BatchFingerprint {
+	fields := make([]*core.BatchFingerprintField, 0)
+	fields = append(fields, newField("batch ID", uint64ToBytes(batch.ID)))
+	fields = append(fields, newField("num deposits", uint64ToBytes(uint64(len(batch.Deposits)))))
+	for idx, dt := range batch.Deposits {
+		prefix := fmt.Sprintf("deposit %d ", idx)
+		amount := make([]byte, 0)
+		if dt.Amount != nil {
+			amount = dt.Amount.Bytes()
+		}
+
+		fields = append(fields,
+			newField(prefix+"nonce", uint64ToBytes(dt.Nonce)),
+			newField(prefix+"from", dt.FromBytes),
+			newField(prefix+"to", dt.ToBytes),
+			newField(prefix+"token", dt.TokenBytes),
+			newField(prefix+"converted token", dt.ConvertedTokenBytes),
+			newField(prefix+"amount", amount),
+			newField(prefix+"rejected", rejectedFlag(batch, idx)),
+		)
+	}
+
+	return &core.BatchFingerprint{
+		Version:     core.MessagesVersion,
+		BridgeName:  bridgeName,
+		BatchID:     batch.ID,
+		Fingerprint: computeFingerprint(fields),
+		Fields:      fields,
+	}
+}
+
+func rejectedFlag(batch *clients.TransferBatch, idx int) []byte {
+	if idx < len(batch.Statuses) && batch.Statuses[idx] == clients.Rejected {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+func newField(name string, value []byte) *core.BatchFingerprintField {
+	return &core.BatchFingerprintField{
+		Name: name,
+		Hash: hasher.Compute(string(value)),
+	}
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+func computeFingerprint(fields []*core.BatchFingerprintField) []byte {
+	buff := make([]byte, 0, len(fields)*hasher.Size())
+	for _, field := range fields {
+		buff = append(buff, field.Hash...)
+	}
+
+	return hasher.Compute(string(buff))
+}
+
+// differingFields returns the names of the fields that have different hashes in the provided fingerprints. A field
+// missing from one of them is considered different
+func differingFields(own *core.BatchFingerprint, other *core.BatchFingerprint) []string {
+	otherHashes := make(map[string][]byte, len(other.Fields))
+	for _, field := range other.Fields {
+		otherHashes[field.Name] = field.Hash
+	}
+
+	result := make([]string, 0)
+	for _, field := range own.Fields {
+		otherHash, found := otherHashes[field.Name]
+		delete(otherHashes, field.Name)
+		if !found || !bytes.Equal(otherHash, field.Hash) {
+			result = append(result, field.Name)
+		}
+	}
+	for _, field := range other.Fields {
+		_, found := otherHashes[field.Name]
+		if found {
+			result = append(result, field.Name)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,94 @@
+package fingerprint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestBatch() *clients.TransferBatch {
+	return &clients.TransferBatch{
+		ID: 7,
+		Deposits: []*clients.DepositTransfer{
+			{
+				Nonce:               1,
+				ToBytes:             []byte("to 1"),
+				FromBytes:           []byte("from 1"),
+				TokenBytes:          []byte("token 1"),
+				ConvertedTokenBytes: []byte("converted token 1"),
+				Amount:              big.NewInt(100),
+			},
+			{
+				Nonce:               2,
+				ToBytes:             []byte("to 2"),
+				FromBytes:           []byte("from 2"),
+				TokenBytes:          []byte("token 2"),
+				ConvertedTokenBytes: []byte("converted token 2"),
+				Amount:              big.NewInt(200),
+			},
+		},
+		Statuses: []byte{clients.Executed, clients.Rejected},
+	}
+}
+
+func TestNewBatchFingerprint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same batch should have the same fingerprint", func(t *testing.T) {
+		batch := createTestBatch()
+		fp := NewBatchFingerprint("EthereumToElrond", batch)
+		assert.Equal(t, core.MessagesVersion, fp.Version)
+		assert.Equal(t, "EthereumToElrond", fp.BridgeName)
+		assert.Equal(t, uint64(7), fp.BatchID)
+		require.Equal(t, 16, len(fp.Fields))
+		assert.Equal(t, "deposit 1 converted token", fp.Fields[13].Name)
+
+		cloned := batch.Clone()
+		cloned.Statuses = []byte{0, clients.Rejected}
+		clonedFp := NewBatchFingerprint("EthereumToElrond", cloned)
+		assert.Equal(t, fp, clonedFp)
+	})
+	t.Run("different batches should have different fingerprints", func(t *testing.T) {
+		fp := NewBatchFingerprint("EthereumToElrond", createTestBatch())
+
+		batch := createTestBatch()
+		batch.Deposits[1].ConvertedTokenBytes = []byte("another token")
+		batch.Deposits[0].Amount = big.NewInt(101)
+		otherFp := NewBatchFingerprint("EthereumToElrond", batch)
+		assert.NotEqual(t, fp.Fingerprint, otherFp.Fingerprint)
+		assert.Equal(t, []string{"deposit 0 amount", "deposit 1 converted token"}, differingFields(fp, otherFp))
+	})
+	t.Run("rejected deposits should be reported as differing fields", func(t *testing.T) {
+		fp := NewBatchFingerprint("EthereumToElrond", createTestBatch())
+
+		batch := createTestBatch()
+		batch.Statuses = nil
+		otherFp := NewBatchFingerprint("EthereumToElrond", batch)
+		assert.NotEqual(t, fp.Fingerprint, otherFp.Fingerprint)
+		assert.Equal(t, []string{"deposit 1 rejected"}, differingFields(fp, otherFp))
+	})
+	t.Run("missing deposits should be reported as differing fields", func(t *testing.T) {
+		fp := NewBatchFingerprint("EthereumToElrond", createTestBatch())
+
+		batch := createTestBatch()
+		batch.Deposits = batch.Deposits[:1]
+		otherFp := NewBatchFingerprint("EthereumToElrond", batch)
+		assert.NotEqual(t, fp.Fingerprint, otherFp.Fingerprint)
+
+		expectedFields := []string{"num deposits", "deposit 1 nonce", "deposit 1 from", "deposit 1 to",
+			"deposit 1 token", "deposit 1 converted token", "deposit 1 amount", "deposit 1 rejected"}
+		assert.Equal(t, expectedFields, differingFields(fp, otherFp))
+		assert.Equal(t, expectedFields, differingFields(otherFp, fp))
+	})
+	t.Run("nil amount should not panic", func(t *testing.T) {
+		batch := createTestBatch()
+		batch.Deposits[0].Amount = nil
+
+		fp := NewBatchFingerprint("EthereumToElrond", batch)
+		assert.Equal(t, 16, len(fp.Fields))
+	})
+}
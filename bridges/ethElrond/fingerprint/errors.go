@@ -0,0 +1,27 @@
+package fingerprint
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrEmptyBridgeName signals that an empty bridge name was provided
+var ErrEmptyBridgeName = errors.New("empty bridge name")
+
+// ErrNilBroadcaster signals that a nil broadcaster was provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrNilAlertHandler signals that a nil alert handler was provided
+var ErrNilAlertHandler = errors.New("nil alert handler")
+
+// ErrEmptyPublicKey signals that an empty public key was provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrPeersOnNewerBatch signals that enough peers already reported the fingerprint of a newer batch
+var ErrPeersOnNewerBatch = errors.New("peers reported a newer batch")
+
+// ErrMaxFailedChecksReached signals that the batch fingerprint was not agreed in the maximum number of checks
+var ErrMaxFailedChecksReached = errors.New("maximum number of failed batch fingerprint checks reached")
@@ -0,0 +1,9 @@
+package fingerprint
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// Broadcaster defines the component able to send the batch fingerprints to the other relayers
+type Broadcaster interface {
+	BroadcastBatchFingerprint(fingerprint *core.BatchFingerprint)
+	IsInterfaceNil() bool
+}
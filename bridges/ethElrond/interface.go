@@ -28,6 +28,7 @@ type ElrondClient interface {
 	Sign(ctx context.Context, actionID uint64) (string, error)
 	WasSigned(ctx context.Context, actionID uint64) (bool, error)
 	PerformAction(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error)
+	WaitForTransactionOutcome(ctx context.Context, hash string) error
 	CheckClientAvailability(ctx context.Context) error
 	Close() error
 	IsInterfaceNil() bool
@@ -40,6 +41,7 @@ type EthereumClient interface {
 	GenerateMessageHash(batch *clients.TransferBatch) (common.Hash, error)
 
 	BroadcastSignatureForMessageHash(msgHash common.Hash)
+	RequestSignaturesForMessageHash(msgHash common.Hash)
 	ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error)
 	GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSize(ctx context.Context) (*big.Int, error)
@@ -54,6 +56,13 @@ type TopologyProvider interface {
 	IsInterfaceNil() bool
 }
 
+// BatchFingerprintChecker defines the operations for a component able to tell if enough relayers built the same view
+// of a batch
+type BatchFingerprintChecker interface {
+	CheckAgreement(batch *clients.TransferBatch) (bool, error)
+	IsInterfaceNil() bool
+}
+
 // SignaturesHolder defines the operations for a component that can hold and manage signatures
 type SignaturesHolder interface {
 	Signatures(messageHash []byte) [][]byte
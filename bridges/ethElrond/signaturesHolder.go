@@ -1,27 +1,103 @@
 package ethElrond
 
 import (
-	"bytes"
+	"context"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 )
 
+const (
+	minSignaturesTimeToLive = time.Second
+	minMessageHashes        = 1
+	minSignaturesPerHash    = 1
+	minHashesPerPublicKey   = 1
+)
+
+// ArgsSignaturesHolder is the DTO used in the signatures holder constructor
+type ArgsSignaturesHolder struct {
+	StatusHandler         core.StatusHandler
+	TimeToLive            time.Duration
+	MaxMessageHashes      int
+	MaxSignaturesPerHash  int
+	MaxHashesPerPublicKey int
+}
+
+type storedSignature struct {
+	signedMessage *core.SignedMessage
+	signature     []byte
+	receivedAt    time.Time
+}
+
+type messageHashSignatures struct {
+	lastUpdate time.Time
+	// signatures are indexed by the relayer's public key so one relayer can occupy only one slot for a message hash
+	signatures map[string]*storedSignature
+}
+
 type signaturesHolder struct {
-	mut            sync.RWMutex
-	signedMessages map[string]*core.SignedMessage
-	ethMessages    []*core.EthereumSignature
+	mut                   sync.RWMutex
+	statusHandler         core.StatusHandler
+	timeToLive            time.Duration
+	maxMessageHashes      int
+	maxSignaturesPerHash  int
+	maxHashesPerPublicKey int
+	messageHashes         map[string]*messageHashSignatures
+	numSignatures         int
+	getTimeHandler        func() time.Time
 }
 
 // NewSignatureHolder creates a new signatureHolder
-func NewSignatureHolder() *signaturesHolder {
-	return &signaturesHolder{
-		signedMessages: make(map[string]*core.SignedMessage),
-		ethMessages:    make([]*core.EthereumSignature, 0),
+func NewSignatureHolder(args ArgsSignaturesHolder) (*signaturesHolder, error) {
+	err := checkArgsSignaturesHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sh := &signaturesHolder{
+		statusHandler:         args.StatusHandler,
+		timeToLive:            args.TimeToLive,
+		maxMessageHashes:      args.MaxMessageHashes,
+		maxSignaturesPerHash:  args.MaxSignaturesPerHash,
+		maxHashesPerPublicKey: args.MaxHashesPerPublicKey,
+		messageHashes:         make(map[string]*messageHashSignatures),
+		getTimeHandler:        time.Now,
+	}
+	sh.updateMetrics()
+
+	return sh, nil
+}
+
+func checkArgsSignaturesHolder(args ArgsSignaturesHolder) error {
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.TimeToLive < minSignaturesTimeToLive {
+		return fmt.Errorf("%w for args.TimeToLive, got: %v, minimum: %v",
+			ErrInvalidDuration, args.TimeToLive, minSignaturesTimeToLive)
+	}
+	if args.MaxMessageHashes < minMessageHashes {
+		return fmt.Errorf("%w for args.MaxMessageHashes, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxMessageHashes, minMessageHashes)
 	}
+	if args.MaxSignaturesPerHash < minSignaturesPerHash {
+		return fmt.Errorf("%w for args.MaxSignaturesPerHash, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxSignaturesPerHash, minSignaturesPerHash)
+	}
+	if args.MaxHashesPerPublicKey < minHashesPerPublicKey {
+		return fmt.Errorf("%w for args.MaxHashesPerPublicKey, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxHashesPerPublicKey, minHashesPerPublicKey)
+	}
+
+	return nil
 }
 
-// ProcessNewMessage will store the new messages
+// ProcessNewMessage will store the new messages. A relayer signing more message hashes than allowed loses its oldest
+// signature so it can not evict the message hashes signed by the others
 func (sh *signaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
 	if msg == nil || ethMsg == nil {
 		return
@@ -30,8 +106,91 @@ func (sh *signaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *c
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages[msg.UniqueID()] = msg
-	sh.ethMessages = append(sh.ethMessages, ethMsg)
+	now := sh.getTimeHandler()
+	publicKey := string(msg.PublicKeyBytes)
+	hashSignatures, found := sh.messageHashes[string(ethMsg.MessageHash)]
+	isReplaced := found && hashSignatures.signatures[publicKey] != nil
+	if found && !isReplaced && len(hashSignatures.signatures) >= sh.maxSignaturesPerHash {
+		sh.statusHandler.AddIntMetric(core.MetricSignaturesHolderNumDroppedSignatures, 1)
+		return
+	}
+	if !isReplaced {
+		sh.limitHashesOfPublicKey(publicKey)
+		sh.numSignatures++
+	}
+	if !found {
+		if len(sh.messageHashes) >= sh.maxMessageHashes {
+			sh.evictOldestMessageHash()
+		}
+
+		hashSignatures = &messageHashSignatures{
+			signatures: make(map[string]*storedSignature),
+		}
+		sh.messageHashes[string(ethMsg.MessageHash)] = hashSignatures
+	}
+
+	hashSignatures.signatures[publicKey] = &storedSignature{
+		signedMessage: msg,
+		signature:     ethMsg.Signature,
+		receivedAt:    now,
+	}
+	hashSignatures.lastUpdate = now
+	sh.updateMetrics()
+}
+
+// limitHashesOfPublicKey removes the oldest signature of the provided public key if it already signed the maximum
+// number of message hashes
+func (sh *signaturesHolder) limitHashesOfPublicKey(publicKey string) {
+	numHashes := 0
+	oldestHash := ""
+	var oldestReceived time.Time
+	for messageHash, hashSignatures := range sh.messageHashes {
+		stored, found := hashSignatures.signatures[publicKey]
+		if !found {
+			continue
+		}
+
+		numHashes++
+		if len(oldestHash) == 0 || stored.receivedAt.Before(oldestReceived) {
+			oldestHash = messageHash
+			oldestReceived = stored.receivedAt
+		}
+	}
+	if numHashes < sh.maxHashesPerPublicKey {
+		return
+	}
+
+	hashSignatures := sh.messageHashes[oldestHash]
+	delete(hashSignatures.signatures, publicKey)
+	sh.numSignatures--
+	if len(hashSignatures.signatures) == 0 {
+		delete(sh.messageHashes, oldestHash)
+	}
+	sh.statusHandler.AddIntMetric(core.MetricSignaturesHolderNumDroppedSignatures, 1)
+}
+
+func (sh *signaturesHolder) evictOldestMessageHash() {
+	oldestHash := ""
+	var oldestUpdate time.Time
+	for messageHash, hashSignatures := range sh.messageHashes {
+		if len(oldestHash) == 0 || hashSignatures.lastUpdate.Before(oldestUpdate) {
+			oldestHash = messageHash
+			oldestUpdate = hashSignatures.lastUpdate
+		}
+	}
+
+	sh.removeMessageHash(oldestHash)
+}
+
+func (sh *signaturesHolder) removeMessageHash(messageHash string) {
+	hashSignatures, found := sh.messageHashes[messageHash]
+	if !found {
+		return
+	}
+
+	sh.numSignatures -= len(hashSignatures.signatures)
+	delete(sh.messageHashes, messageHash)
+	sh.statusHandler.AddIntMetric(core.MetricSignaturesHolderNumEvictedMessageHashes, 1)
 }
 
 // AllStoredSignatures will return the stored signatures
@@ -39,8 +198,15 @@ func (sh *signaturesHolder) AllStoredSignatures() []*core.SignedMessage {
 	sh.mut.RLock()
 	defer sh.mut.RUnlock()
 
-	result := make([]*core.SignedMessage, 0, len(sh.signedMessages))
-	for _, msg := range sh.signedMessages {
+	uniqueMessages := make(map[string]*core.SignedMessage)
+	for _, hashSignatures := range sh.messageHashes {
+		for _, stored := range hashSignatures.signatures {
+			uniqueMessages[stored.signedMessage.UniqueID()] = stored.signedMessage
+		}
+	}
+
+	result := make([]*core.SignedMessage, 0, len(uniqueMessages))
+	for _, msg := range uniqueMessages {
 		result = append(result, msg)
 	}
 
@@ -52,14 +218,17 @@ func (sh *signaturesHolder) Signatures(msgHash []byte) [][]byte {
 	sh.mut.RLock()
 	defer sh.mut.RUnlock()
 
+	hashSignatures, found := sh.messageHashes[string(msgHash)]
+	if !found {
+		return make([][]byte, 0)
+	}
+
 	uniqueEthSigs := make(map[string]struct{})
-	for _, ethMsg := range sh.ethMessages {
-		if bytes.Equal(ethMsg.MessageHash, msgHash) {
-			uniqueEthSigs[string(ethMsg.Signature)] = struct{}{}
-		}
+	for _, stored := range hashSignatures.signatures {
+		uniqueEthSigs[string(stored.signature)] = struct{}{}
 	}
 
-	result := make([][]byte, 0, len(sh.signedMessages))
+	result := make([][]byte, 0, len(uniqueEthSigs))
 	for sig := range uniqueEthSigs {
 		result = append(result, []byte(sig))
 	}
@@ -72,8 +241,31 @@ func (sh *signaturesHolder) ClearStoredSignatures() {
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages = make(map[string]*core.SignedMessage)
-	sh.ethMessages = make([]*core.EthereumSignature, 0)
+	sh.messageHashes = make(map[string]*messageHashSignatures)
+	sh.numSignatures = 0
+	sh.updateMetrics()
+}
+
+// Execute will remove the message hashes that did not receive any signature in the configured time to live.
+// It is called periodically by a polling handler
+func (sh *signaturesHolder) Execute(_ context.Context) error {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	now := sh.getTimeHandler()
+	for messageHash, hashSignatures := range sh.messageHashes {
+		if now.Sub(hashSignatures.lastUpdate) > sh.timeToLive {
+			sh.removeMessageHash(messageHash)
+		}
+	}
+	sh.updateMetrics()
+
+	return nil
+}
+
+func (sh *signaturesHolder) updateMetrics() {
+	sh.statusHandler.SetIntMetric(core.MetricSignaturesHolderNumMessageHashes, len(sh.messageHashes))
+	sh.statusHandler.SetIntMetric(core.MetricSignaturesHolderNumSignatures, sh.numSignatures)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
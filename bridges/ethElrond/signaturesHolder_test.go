@@ -2,11 +2,18 @@ package ethElrond
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +34,88 @@ func generateEthMessage(index uint64) *core.EthereumSignature {
 	}
 }
 
+func createMockArgsSignaturesHolder() ArgsSignaturesHolder {
+	return ArgsSignaturesHolder{
+		StatusHandler:         testsCommon.NewStatusHandlerMock("test"),
+		TimeToLive:            time.Minute,
+		MaxMessageHashes:      10,
+		MaxSignaturesPerHash:  10,
+		MaxHashesPerPublicKey: 10,
+	}
+}
+
+func createSignaturesHolder() *signaturesHolder {
+	sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+
+	return sh
+}
+
+func TestNewSignatureHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.StatusHandler = nil
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid time to live should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.TimeToLive = minSignaturesTimeToLive - time.Millisecond
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "args.TimeToLive"))
+	})
+	t.Run("invalid max message hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = minMessageHashes - 1
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxMessageHashes"))
+	})
+	t.Run("invalid max signatures per hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxSignaturesPerHash = minSignaturesPerHash - 1
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxSignaturesPerHash"))
+	})
+	t.Run("invalid max hashes per public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxHashesPerPublicKey = minHashesPerPublicKey - 1
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxHashesPerPublicKey"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sh, err := NewSignatureHolder(createMockArgsSignaturesHolder())
+		assert.False(t, check.IfNil(sh))
+		assert.Nil(t, err)
+	})
+}
+
 func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 	t.Parallel()
 
@@ -36,14 +125,12 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg := generateSignedMessage(0)
 		ethMsg := generateEthMessage(0)
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(nil, ethMsg)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.AllStoredSignatures()))
 
 		sh.ProcessNewMessage(msg, nil)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.AllStoredSignatures()))
 	})
 	t.Run("first message should add", func(t *testing.T) {
 		t.Parallel()
@@ -51,10 +138,10 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg := generateSignedMessage(0)
 		ethMsg := generateEthMessage(0)
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(msg, ethMsg)
 		assert.Equal(t, []*core.SignedMessage{msg}, sh.AllStoredSignatures())
-		assert.Equal(t, []*core.EthereumSignature{ethMsg}, sh.ethMessages)
+		assert.Equal(t, [][]byte{ethMsg.Signature}, sh.Signatures(ethMsg.MessageHash))
 	})
 	t.Run("two messages should add", func(t *testing.T) {
 		t.Parallel()
@@ -65,12 +152,158 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg1 := generateSignedMessage(1)
 		ethMsg1 := generateEthMessage(1)
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
-		compareEthSignatureMessageLists(t, []*core.EthereumSignature{ethMsg, ethMsg1}, sh.ethMessages)
+		compareBytesSlicesLists(t, [][]byte{ethMsg.Signature, ethMsg1.Signature}, sh.Signatures(ethMsg.MessageHash))
 		compareSignedMessageLists(t, []*core.SignedMessage{msg, msg1}, sh.AllStoredSignatures())
 	})
+	t.Run("same relayer should replace its signature", func(t *testing.T) {
+		t.Parallel()
+
+		msg := generateSignedMessage(0)
+		ethMsg := generateEthMessage(0)
+
+		msg1 := generateSignedMessage(1)
+		msg1.PublicKeyBytes = msg.PublicKeyBytes
+		ethMsg1 := generateEthMessage(1)
+
+		args := createMockArgsSignaturesHolder()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+		sh.ProcessNewMessage(msg, ethMsg)
+		sh.ProcessNewMessage(msg1, ethMsg1)
+		assert.Equal(t, [][]byte{ethMsg1.Signature}, sh.Signatures(ethMsg.MessageHash))
+		assert.Equal(t, []*core.SignedMessage{msg1}, sh.AllStoredSignatures())
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
+	})
+	t.Run("full message hash should drop the signatures of new relayers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxSignaturesPerHash = 2
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+		for i := uint64(0); i < 4; i++ {
+			sh.ProcessNewMessage(generateSignedMessage(i), generateEthMessage(i))
+		}
+
+		compareBytesSlicesLists(t, [][]byte{generateEthMessage(0).Signature, generateEthMessage(1).Signature},
+			sh.Signatures(generateEthMessage(0).MessageHash))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumDroppedSignatures))
+	})
+	t.Run("full holder should evict the oldest message hash", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 2
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+		currentTime := time.Now()
+		sh.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		ethMessages := make([]*core.EthereumSignature, 0)
+		for i := uint64(0); i < 3; i++ {
+			ethMsg := generateEthMessage(i)
+			ethMsg.MessageHash = []byte(fmt.Sprintf("message hash %d", i))
+			ethMessages = append(ethMessages, ethMsg)
+		}
+
+		sh.ProcessNewMessage(generateSignedMessage(0), ethMessages[0])
+		currentTime = currentTime.Add(time.Second)
+		sh.ProcessNewMessage(generateSignedMessage(1), ethMessages[1])
+		currentTime = currentTime.Add(time.Second)
+		// a new signature for the first hash makes the second one the oldest
+		ethMsg := generateEthMessage(2)
+		ethMsg.MessageHash = ethMessages[0].MessageHash
+		sh.ProcessNewMessage(generateSignedMessage(2), ethMsg)
+		currentTime = currentTime.Add(time.Second)
+		sh.ProcessNewMessage(generateSignedMessage(3), ethMessages[2])
+
+		assert.Equal(t, 2, len(sh.Signatures(ethMessages[0].MessageHash)))
+		assert.Equal(t, 0, len(sh.Signatures(ethMessages[1].MessageHash)))
+		assert.Equal(t, 1, len(sh.Signatures(ethMessages[2].MessageHash)))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumMessageHashes))
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumEvictedMessageHashes))
+	})
+	t.Run("relayer signing too many hashes should lose its oldest signature", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 3
+		args.MaxHashesPerPublicKey = 2
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+		currentTime := time.Now()
+		sh.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		collectedMsg := generateEthMessage(0)
+		sh.ProcessNewMessage(generateSignedMessage(0), collectedMsg)
+		for i := uint64(1); i <= 5; i++ {
+			currentTime = currentTime.Add(time.Second)
+			junkMsg := generateEthMessage(1)
+			junkMsg.MessageHash = []byte(fmt.Sprintf("junk hash %d", i))
+			sh.ProcessNewMessage(generateSignedMessage(1), junkMsg)
+		}
+
+		assert.Equal(t, [][]byte{collectedMsg.Signature}, sh.Signatures(collectedMsg.MessageHash))
+		assert.Equal(t, 0, len(sh.Signatures([]byte("junk hash 3"))))
+		assert.Equal(t, 1, len(sh.Signatures([]byte("junk hash 4"))))
+		assert.Equal(t, 1, len(sh.Signatures([]byte("junk hash 5"))))
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumMessageHashes))
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumDroppedSignatures))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumEvictedMessageHashes))
+	})
+}
+
+func TestSignatureHolder_Execute(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignaturesHolder()
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	sh, _ := NewSignatureHolder(args)
+	currentTime := time.Now()
+	sh.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	ethMsg0 := generateEthMessage(0)
+	ethMsg0.MessageHash = []byte("message hash 0")
+	ethMsg1 := generateEthMessage(1)
+	ethMsg1.MessageHash = []byte("message hash 1")
+
+	sh.ProcessNewMessage(generateSignedMessage(0), ethMsg0)
+	currentTime = currentTime.Add(args.TimeToLive / 2)
+	sh.ProcessNewMessage(generateSignedMessage(1), ethMsg1)
+
+	currentTime = currentTime.Add(args.TimeToLive/2 + time.Second)
+	err := sh.Execute(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 0, len(sh.Signatures(ethMsg0.MessageHash)))
+	assert.Equal(t, [][]byte{ethMsg1.Signature}, sh.Signatures(ethMsg1.MessageHash))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumMessageHashes))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumEvictedMessageHashes))
+
+	currentTime = currentTime.Add(args.TimeToLive)
+	err = sh.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(sh.AllStoredSignatures()))
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumMessageHashes))
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricSignaturesHolderNumSignatures))
 }
 
 func TestSignatureHolder_Signatures(t *testing.T) {
@@ -85,7 +318,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		msg1 := generateSignedMessage(1)
 		ethMsg1 := generateEthMessage(1)
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 
@@ -108,7 +341,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		ethMsg2 := generateEthMessage(2)
 		ethMsg2.Signature = ethMsg1.Signature
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 		sh.ProcessNewMessage(msg2, ethMsg2)
@@ -128,7 +361,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		msg2 := generateSignedMessage(2)
 		ethMsg2 := generateEthMessage(2)
 
-		sh := NewSignatureHolder()
+		sh := createSignaturesHolder()
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 		sh.ProcessNewMessage(msg2, ethMsg2)
@@ -20,6 +20,7 @@ const (
 	storeBatchFromElrond                             = "StoreBatchFromElrond"
 	wasTransferPerformedOnEthereum                   = "WasTransferPerformedOnEthereum"
 	signTransferOnEthereum                           = "SignTransferOnEthereum"
+	isBatchFingerprintAgreed                         = "IsBatchFingerprintAgreed"
 	ProcessMaxQuorumRetriesOnEthereum                = "ProcessMaxQuorumRetriesOnEthereum"
 	processQuorumReachedOnEthereum                   = "ProcessQuorumReachedOnEthereum"
 	performTransferOnEthereum                        = "PerformTransferOnEthereum"
@@ -115,6 +116,13 @@ func createMockBridge(args argsBridgeStub) (*bridgeTests.BridgeExecutorStub, *er
 
 		return args.wasTransferPerformedOnEthereumHandler(), errHandler.storeAndReturnError(nil)
 	}
+	stub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+		if args.failingStep == isBatchFingerprintAgreed {
+			return false, errHandler.storeAndReturnError(expectedErr)
+		}
+
+		return true, errHandler.storeAndReturnError(nil)
+	}
 	stub.SignTransferOnEthereumCalled = func() error {
 		if args.failingStep == signTransferOnEthereum {
 			return errHandler.storeAndReturnError(expectedErr)
@@ -248,6 +256,7 @@ func TestHappyCaseWhenLeaderSetStatusAlreadySigned(t *testing.T) {
 	assert.Equal(t, 1, executor.GetFunctionCounter(storeBatchFromElrond))
 	assert.Equal(t, 3, executor.GetFunctionCounter(wasTransferPerformedOnEthereum))
 	assert.Equal(t, 4, executor.GetFunctionCounter(getStoredBatch))
+	assert.Equal(t, 1, executor.GetFunctionCounter(isBatchFingerprintAgreed))
 	assert.Equal(t, 1, executor.GetFunctionCounter(signTransferOnEthereum))
 	assert.Equal(t, 3, executor.GetFunctionCounter(wasTransferPerformedOnEthereum))
 	assert.Equal(t, 1, executor.GetFunctionCounter(ProcessMaxQuorumRetriesOnEthereum))
@@ -275,6 +284,7 @@ func TestOneStepErrors_ShouldReturnToPendingBatch(t *testing.T) {
 	stepsThatCanError := []core.StepIdentifier{
 		getBatchFromElrond,
 		wasTransferPerformedOnEthereum,
+		isBatchFingerprintAgreed,
 		signTransferOnEthereum,
 		processQuorumReachedOnEthereum,
 		performTransferOnEthereum,
@@ -3,13 +3,16 @@ package elrondToEth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/elrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type getPendingStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -29,10 +32,12 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	batch, err := step.bridge.GetBatchFromElrond(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogDebug, "cannot fetch Elrond batch", "message", err)
+		step.setFetchErrorHint(err)
 		return step.Identifier()
 	}
 	if batch == nil {
 		step.bridge.PrintInfo(logger.LogDebug, "no new batch found on Elrond")
+		step.SetHint(core.StepHintNothingToDo)
 		return step.Identifier()
 	}
 
@@ -59,6 +64,7 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	wasPerformed, err := step.bridge.WasTransferPerformedOnEthereum(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error determining if transfer was performed or not", "error", err)
+		step.SetHint(core.StepHintClientError)
 		return step.Identifier()
 	}
 	if wasPerformed {
@@ -69,11 +75,29 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	return SigningProposedTransferOnEthereum
 }
 
+func (step *getPendingStep) setFetchErrorHint(err error) {
+	if errors.Is(err, elrond.ErrNoPendingBatchAvailable) {
+		step.SetHint(core.StepHintNothingToDo)
+		return
+	}
+
+	step.SetHint(core.StepHintClientError)
+}
+
 // Identifier returns the step's identifier
 func (step *getPendingStep) Identifier() core.StepIdentifier {
 	return GettingPendingBatchFromElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *getPendingStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		ResolvingSetStatusOnElrond,
+		SigningProposedTransferOnEthereum,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *getPendingStep) IsInterfaceNil() bool {
 	return step == nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/elrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,7 @@ func TestExecute_GetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintClientError, step.ConsumeHint())
 	})
 
 	t.Run("nil batch on GetBatchFromElrond", func(t *testing.T) {
@@ -51,6 +53,24 @@ func TestExecute_GetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintNothingToDo, step.ConsumeHint())
+	})
+
+	t.Run("no pending batch on GetBatchFromElrond", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorGetPending()
+		bridgeStub.GetBatchFromElrondCalled = func(ctx context.Context) (*clients.TransferBatch, error) {
+			return nil, elrond.ErrNoPendingBatchAvailable
+		}
+
+		step := getPendingStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := step.Identifier()
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintNothingToDo, step.ConsumeHint())
 	})
 
 	t.Run("error on StoreBatchFromElrond", func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 )
 
 type signProposedTransferStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -20,7 +21,20 @@ func (step *signProposedTransferStep) Execute(_ context.Context) core.StepIdenti
 		return GettingPendingBatchFromElrond
 	}
 
-	err := step.bridge.SignTransferOnEthereum()
+	isAgreed, err := step.bridge.IsBatchFingerprintAgreed()
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "error checking the batch fingerprint agreement",
+			"batch ID", storedBatch.ID, "error", err)
+		return GettingPendingBatchFromElrond
+	}
+	if !isAgreed {
+		step.bridge.PrintInfo(logger.LogDebug, "not enough relayers reported the same batch fingerprint",
+			"batch ID", storedBatch.ID)
+		step.SetHint(core.StepHintWaiting)
+		return step.Identifier()
+	}
+
+	err = step.bridge.SignTransferOnEthereum()
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing", "batch ID", storedBatch.ID, "error", err)
 		return GettingPendingBatchFromElrond
@@ -34,6 +48,14 @@ func (step *signProposedTransferStep) Identifier() core.StepIdentifier {
 	return SigningProposedTransferOnEthereum
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *signProposedTransferStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		WaitingForQuorumOnTransfer,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *signProposedTransferStep) IsInterfaceNil() bool {
 	return step == nil
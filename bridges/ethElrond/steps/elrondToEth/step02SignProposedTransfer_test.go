@@ -30,6 +30,45 @@ func TestExecute_SignProposedTransfer(t *testing.T) {
 		assert.Equal(t, initialStep, stepIdentifier)
 	})
 
+	t.Run("error on IsBatchFingerprintAgreed", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorSignProposedTransfer()
+		bridgeStub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+			return false, expectedError
+		}
+		bridgeStub.SignTransferOnEthereumCalled = func() error {
+			assert.Fail(t, "should have not signed")
+			return nil
+		}
+
+		step := signProposedTransferStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, initialStep, stepIdentifier)
+	})
+
+	t.Run("batch fingerprint not agreed", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorSignProposedTransfer()
+		bridgeStub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+			return false, nil
+		}
+		bridgeStub.SignTransferOnEthereumCalled = func() error {
+			assert.Fail(t, "should have not signed")
+			return nil
+		}
+
+		step := signProposedTransferStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintWaiting, step.ConsumeHint())
+	})
+
 	t.Run("nil batch on SignTransferOnEthereum", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorSignProposedTransfer()
@@ -66,6 +105,9 @@ func createStubExecutorSignProposedTransfer() *bridgeTests.BridgeExecutorStub {
 	stub.GetStoredBatchCalled = func() *clients.TransferBatch {
 		return testBatch
 	}
+	stub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+		return true, nil
+	}
 	stub.SignTransferOnEthereumCalled = func() error {
 		return nil
 	}
@@ -39,6 +39,15 @@ func (step *waitForQuorumOnTransferStep) Identifier() core.StepIdentifier {
 	return WaitingForQuorumOnTransfer
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *waitForQuorumOnTransferStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		WaitingForQuorumOnTransfer,
+		PerformingTransfer,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *waitForQuorumOnTransferStep) IsInterfaceNil() bool {
 	return step == nil
@@ -43,6 +43,15 @@ func (step *performTransferStep) Identifier() core.StepIdentifier {
 	return PerformingTransfer
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *performTransferStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		ResolvingSetStatusOnElrond,
+		WaitingTransferConfirmation,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *performTransferStep) IsInterfaceNil() bool {
 	return step == nil
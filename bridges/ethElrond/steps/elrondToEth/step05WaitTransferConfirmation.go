@@ -22,6 +22,13 @@ func (step *waitTransferConfirmationStep) Identifier() core.StepIdentifier {
 	return WaitingTransferConfirmation
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *waitTransferConfirmationStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		PerformingTransfer,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *waitTransferConfirmationStep) IsInterfaceNil() bool {
 	return step == nil
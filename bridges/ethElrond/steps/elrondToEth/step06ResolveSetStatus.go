@@ -36,7 +36,7 @@ func (step *resolveSetStatusStep) Execute(ctx context.Context) core.StepIdentifi
 		return GettingPendingBatchFromElrond
 	}
 
-	storedBatch.Statuses = statuses
+	storedBatch.ApplyExecutionStatuses(statuses)
 
 	step.bridge.ResolveNewDepositsStatuses(uint64(len(batch.Statuses)))
 
@@ -48,6 +48,14 @@ func (step *resolveSetStatusStep) Identifier() core.StepIdentifier {
 	return ResolvingSetStatusOnElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *resolveSetStatusStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		ProposingSetStatusOnElrond,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *resolveSetStatusStep) IsInterfaceNil() bool {
 	return step == nil
@@ -126,6 +126,30 @@ func TestExecute_ResolveSetStatus(t *testing.T) {
 		assert.Equal(t, expectedStep, stepIdentifier)
 		assert.True(t, clearWasCalled)
 	})
+	t.Run("deposits rejected by the tokens policy should keep their status", func(t *testing.T) {
+		t.Parallel()
+
+		storedBatch := &clients.TransferBatch{
+			ID:       1,
+			Deposits: []*clients.DepositTransfer{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}},
+			Statuses: []byte{0, clients.Rejected, 0},
+		}
+		bridgeStub := createStubExecutorResolveSetStatus()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return storedBatch
+		}
+		bridgeStub.WaitAndReturnFinalBatchStatusesCalled = func(ctx context.Context) []byte {
+			return []byte{clients.Executed, clients.Executed}
+		}
+
+		step := resolveSetStatusStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, core.StepIdentifier(ProposingSetStatusOnElrond), stepIdentifier)
+		assert.Equal(t, []byte{clients.Executed, clients.Rejected, clients.Executed}, storedBatch.Statuses)
+	})
 }
 
 func createStubExecutorResolveSetStatus() *bridgeTests.BridgeExecutorStub {
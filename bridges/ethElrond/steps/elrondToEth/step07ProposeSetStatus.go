@@ -2,13 +2,16 @@ package elrondToEth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type proposeSetStatusStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -42,6 +45,12 @@ func (step *proposeSetStatusStep) Execute(ctx context.Context) core.StepIdentifi
 	}
 
 	err = step.bridge.ProposeSetStatusOnElrond(ctx)
+	if errors.Is(err, clients.ErrTransactionOutOfGas) {
+		step.bridge.PrintInfo(logger.LogWarning, "proposing set status on Elrond ran out of gas, retrying",
+			"batch ID", batch.ID, "error", err)
+		step.SetHint(core.StepHintRetrySoon)
+		return step.Identifier()
+	}
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error proposing transfer on Elrond",
 			"batch ID", batch.ID, "error", err)
@@ -56,6 +65,15 @@ func (step *proposeSetStatusStep) Identifier() core.StepIdentifier {
 	return ProposingSetStatusOnElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *proposeSetStatusStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		ProposingSetStatusOnElrond,
+		SigningProposedSetStatusOnElrond,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *proposeSetStatusStep) IsInterfaceNil() bool {
 	return step == nil
@@ -2,6 +2,7 @@ package elrondToEth
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
@@ -72,6 +73,22 @@ func TestExecute_ProposeSetStatus(t *testing.T) {
 		assert.Equal(t, initialStep, stepIdentifier)
 	})
 
+	t.Run("out of gas on ProposeSetStatusOnElrond should retry", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorProposeSetStatus()
+		bridgeStub.ProposeSetStatusOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutOfGas)
+		}
+
+		step := proposeSetStatusStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintRetrySoon, step.ConsumeHint())
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		t.Run("if SetStatus was proposed it should go to SigningProposedSetStatusOnElrond", func(t *testing.T) {
@@ -2,9 +2,11 @@ package elrondToEth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
@@ -46,6 +48,11 @@ func (step *signProposedSetStatusStep) Execute(ctx context.Context) core.StepIde
 	}
 
 	err = step.bridge.SignActionOnElrond(ctx)
+	if errors.Is(err, clients.ErrTransactionRejectedByContract) {
+		step.bridge.PrintInfo(logger.LogWarning, "signing the proposed transfer was rejected by the contract, skipping",
+			"batch ID", storedBatch.ID, "error", err)
+		return WaitingForQuorumOnSetStatus
+	}
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing the proposed transfer",
 			"batch ID", storedBatch.ID, "error", err)
@@ -60,6 +67,14 @@ func (step *signProposedSetStatusStep) Identifier() core.StepIdentifier {
 	return SigningProposedSetStatusOnElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *signProposedSetStatusStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		WaitingForQuorumOnSetStatus,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *signProposedSetStatusStep) IsInterfaceNil() bool {
 	return step == nil
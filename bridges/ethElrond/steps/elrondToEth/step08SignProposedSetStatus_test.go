@@ -2,6 +2,7 @@ package elrondToEth
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
@@ -85,6 +86,20 @@ func TestExecute_SignProposedSetStatus(t *testing.T) {
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, initialStep, stepIdentifier)
 	})
+	t.Run("rejected by the contract on SignActionOnElrond should skip", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorSignProposedSetStatus()
+		bridgeStub.SignActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionRejectedByContract)
+		}
+
+		step := signProposedSetStatusStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, core.StepIdentifier(WaitingForQuorumOnSetStatus), stepIdentifier)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		t.Run("if proposed set status was signed, go to WaitingForQuorumOnSetStatus", func(t *testing.T) {
@@ -39,6 +39,15 @@ func (step *waitForQuorumOnSetStatusStep) Identifier() core.StepIdentifier {
 	return WaitingForQuorumOnSetStatus
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *waitForQuorumOnSetStatusStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		WaitingForQuorumOnSetStatus,
+		PerformingSetStatus,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *waitForQuorumOnSetStatusStep) IsInterfaceNil() bool {
 	return step == nil
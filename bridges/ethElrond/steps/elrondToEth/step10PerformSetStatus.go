@@ -2,13 +2,16 @@ package elrondToEth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type performSetStatusStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -33,6 +36,17 @@ func (step *performSetStatusStep) Execute(ctx context.Context) core.StepIdentifi
 	}
 
 	err = step.bridge.PerformActionOnElrond(ctx)
+	if errors.Is(err, clients.ErrTransactionOutOfGas) {
+		step.bridge.PrintInfo(logger.LogWarning, "performing set status ran out of gas, retrying",
+			"action ID", step.bridge.GetStoredActionID(), "error", err)
+		step.SetHint(core.StepHintRetrySoon)
+		return step.Identifier()
+	}
+	if errors.Is(err, clients.ErrTransactionRejectedByContract) {
+		step.bridge.PrintInfo(logger.LogWarning, "performing set status was rejected by the contract, checking if it was performed",
+			"action ID", step.bridge.GetStoredActionID(), "error", err)
+		return step.Identifier()
+	}
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error performing action ID",
 			"action ID", step.bridge.GetStoredActionID(), "error", err)
@@ -47,6 +61,14 @@ func (step *performSetStatusStep) Identifier() core.StepIdentifier {
 	return PerformingSetStatus
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *performSetStatusStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromElrond,
+		PerformingSetStatus,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *performSetStatusStep) IsInterfaceNil() bool {
 	return step == nil
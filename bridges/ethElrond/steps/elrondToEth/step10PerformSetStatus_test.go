@@ -2,8 +2,11 @@ package elrondToEth
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,6 +47,43 @@ func TestExecute_PerformSetStatus(t *testing.T) {
 		assert.Equal(t, initialStep, stepIdentifier)
 	})
 
+	t.Run("out of gas on PerformActionOnElrondCalled should retry", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorPerformSetStatus()
+		bridgeStub.MyTurnAsLeaderCalled = func() bool {
+			return true
+		}
+		bridgeStub.PerformActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutOfGas)
+		}
+
+		step := performSetStatusStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintRetrySoon, step.ConsumeHint())
+	})
+
+	t.Run("rejected by the contract on PerformActionOnElrondCalled should check again", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorPerformSetStatus()
+		bridgeStub.MyTurnAsLeaderCalled = func() bool {
+			return true
+		}
+		bridgeStub.PerformActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionRejectedByContract)
+		}
+
+		step := performSetStatusStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		t.Run("if transfer was performed we should go to initial step", func(t *testing.T) {
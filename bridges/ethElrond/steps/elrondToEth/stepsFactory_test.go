@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,3 +28,13 @@ func TestCreateSteps_ShouldWork(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, NumSteps, len(steps))
 }
+
+func TestCreateSteps_DeclaredTransitionsShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	steps, err := CreateSteps(bridgeTests.NewBridgeExecutorStub())
+	require.Nil(t, err)
+
+	err = stateMachine.ValidateTransitions(steps, GettingPendingBatchFromElrond)
+	assert.Nil(t, err)
+}
@@ -20,6 +20,7 @@ const (
 	getLastExecutedEthBatchIDFromElrond           = "GetLastExecutedEthBatchIDFromElrond"
 	verifyLastDepositNonceExecutedOnEthereumBatch = "VerifyLastDepositNonceExecutedOnEthereumBatch"
 	validateBatch                                 = "ValidateBatch"
+	isBatchFingerprintAgreed                      = "IsBatchFingerprintAgreed"
 	wasTransferProposedOnElrond                   = "WasTransferProposedOnElrond"
 	wasActionSignedOnElrond                       = "WasActionSignedOnElrond"
 	signActionOnElrond                            = "SignActionOnElrond"
@@ -97,6 +98,13 @@ func createMockBridge(args argsBridgeStub) (*bridgeTests.BridgeExecutorStub, *er
 
 		return errHandler.storeAndReturnError(nil)
 	}
+	stub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+		if args.failingStep == isBatchFingerprintAgreed {
+			return false, errHandler.storeAndReturnError(expectedErr)
+		}
+
+		return true, errHandler.storeAndReturnError(nil)
+	}
 	stub.WasTransferProposedOnElrondCalled = func(ctx context.Context) (bool, error) {
 		if args.failingStep == wasTransferProposedOnElrond {
 			return false, errHandler.storeAndReturnError(expectedErr)
@@ -196,6 +204,7 @@ func TestHappyCaseWhenLeader(t *testing.T) {
 	assert.Equal(t, 4, executor.GetFunctionCounter(getAndStoreBatchFromEthereum))
 	assert.Equal(t, 4, executor.GetFunctionCounter(verifyLastDepositNonceExecutedOnEthereumBatch))
 
+	assert.Equal(t, 4, executor.GetFunctionCounter(isBatchFingerprintAgreed))
 	assert.Equal(t, 4, executor.GetFunctionCounter(wasTransferProposedOnElrond))
 	assert.Equal(t, 4, executor.GetFunctionCounter(proposeTransferOnElrond))
 
@@ -242,6 +251,7 @@ func TestHappyCaseWhenLeaderAndActionIdNotPerformed(t *testing.T) {
 	assert.Equal(t, 4, executor.GetFunctionCounter(getAndStoreBatchFromEthereum))
 	assert.Equal(t, 4, executor.GetFunctionCounter(verifyLastDepositNonceExecutedOnEthereumBatch))
 
+	assert.Equal(t, 4, executor.GetFunctionCounter(isBatchFingerprintAgreed))
 	assert.Equal(t, 4, executor.GetFunctionCounter(wasTransferProposedOnElrond))
 	assert.Equal(t, 4, executor.GetFunctionCounter(proposeTransferOnElrond))
 
@@ -265,6 +275,7 @@ func TestOneStepErrors_ShouldReturnToPendingBatch(t *testing.T) {
 		getLastExecutedEthBatchIDFromElrond,
 		verifyLastDepositNonceExecutedOnEthereumBatch,
 		validateBatch,
+		isBatchFingerprintAgreed,
 		wasTransferProposedOnElrond,
 		proposeTransferOnElrond,
 		wasTransferProposedOnElrond,
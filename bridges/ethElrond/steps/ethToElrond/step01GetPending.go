@@ -3,13 +3,16 @@ package ethToElrond
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type getPendingStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -27,18 +30,21 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	lastEthBatchExecuted, err := step.bridge.GetLastExecutedEthBatchIDFromElrond(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error fetching last executed eth batch ID", "error", err)
+		step.SetHint(core.StepHintClientError)
 		return step.Identifier()
 	}
 
 	err = step.bridge.GetAndStoreBatchFromEthereum(ctx, lastEthBatchExecuted+1)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogDebug, "cannot fetch eth batch", "batch ID", lastEthBatchExecuted+1, "message", err)
+		step.setFetchErrorHint(err)
 		return step.Identifier()
 	}
 
 	batch := step.bridge.GetStoredBatch()
 	if batch == nil {
 		step.bridge.PrintInfo(logger.LogDebug, "no new batch found on eth", "last executed on Elrond", lastEthBatchExecuted)
+		step.SetHint(core.StepHintNothingToDo)
 		return step.Identifier()
 	}
 
@@ -65,11 +71,28 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	return ProposingTransferOnElrond
 }
 
+func (step *getPendingStep) setFetchErrorHint(err error) {
+	if errors.Is(err, ethElrond.ErrBatchNotFound) {
+		step.SetHint(core.StepHintNothingToDo)
+		return
+	}
+
+	step.SetHint(core.StepHintClientError)
+}
+
 // Identifier returns the step's identifier
 func (step *getPendingStep) Identifier() core.StepIdentifier {
 	return GettingPendingBatchFromEthereum
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *getPendingStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromEthereum,
+		ProposingTransferOnElrond,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *getPendingStep) IsInterfaceNil() bool {
 	return step == nil
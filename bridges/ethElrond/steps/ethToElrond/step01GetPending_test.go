@@ -3,8 +3,10 @@ package ethToElrond
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
@@ -35,6 +37,7 @@ func TestExecuteGetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintClientError, step.ConsumeHint())
 	})
 
 	t.Run("error on GetAndStoreBatchFromEthereum", func(t *testing.T) {
@@ -54,6 +57,28 @@ func TestExecuteGetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintClientError, step.ConsumeHint())
+	})
+
+	t.Run("batch not found on GetAndStoreBatchFromEthereum", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.GetLastExecutedEthBatchIDFromElrondCalled = func(ctx context.Context) (uint64, error) {
+			return 1122, nil
+		}
+		bridgeStub.GetAndStoreBatchFromEthereumCalled = func(ctx context.Context, nonce uint64) error {
+			return fmt.Errorf("%w, requested nonce: %d", ethElrond.ErrBatchNotFound, nonce)
+		}
+
+		step := getPendingStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := step.Identifier()
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintNothingToDo, step.ConsumeHint())
+		assert.Equal(t, core.StepHintNone, step.ConsumeHint())
 	})
 
 	t.Run("nil on GetStoredBatch", func(t *testing.T) {
@@ -75,6 +100,7 @@ func TestExecuteGetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintNothingToDo, step.ConsumeHint())
 	})
 
 	t.Run("error on ValidateBatch", func(t *testing.T) {
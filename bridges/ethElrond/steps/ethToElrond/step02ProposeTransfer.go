@@ -2,13 +2,16 @@ package ethToElrond
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type proposeTransferStep struct {
+	steps.HintHolder
 	bridge steps.Executor
 }
 
@@ -20,6 +23,19 @@ func (step *proposeTransferStep) Execute(ctx context.Context) core.StepIdentifie
 		return GettingPendingBatchFromEthereum
 	}
 
+	isAgreed, err := step.bridge.IsBatchFingerprintAgreed()
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "error checking the batch fingerprint agreement",
+			"batch ID", batch.ID, "error", err)
+		return GettingPendingBatchFromEthereum
+	}
+	if !isAgreed {
+		step.bridge.PrintInfo(logger.LogDebug, "not enough relayers reported the same batch fingerprint",
+			"batch ID", batch.ID)
+		step.SetHint(core.StepHintWaiting)
+		return step.Identifier()
+	}
+
 	wasTransferProposed, err := step.bridge.WasTransferProposedOnElrond(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error determining if the batch was proposed or not on Elrond",
@@ -33,10 +49,17 @@ func (step *proposeTransferStep) Execute(ctx context.Context) core.StepIdentifie
 
 	if !step.bridge.MyTurnAsLeader() {
 		step.bridge.PrintInfo(logger.LogDebug, "not my turn as leader in this round")
+		step.SetHint(core.StepHintWaiting)
 		return step.Identifier()
 	}
 
 	err = step.bridge.ProposeTransferOnElrond(ctx)
+	if errors.Is(err, clients.ErrTransactionOutOfGas) {
+		step.bridge.PrintInfo(logger.LogWarning, "proposing transfer on Elrond ran out of gas, retrying",
+			"batch ID", batch.ID, "error", err)
+		step.SetHint(core.StepHintRetrySoon)
+		return step.Identifier()
+	}
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error proposing transfer on Elrond",
 			"batch ID", batch.ID, "error", err)
@@ -51,6 +74,15 @@ func (step *proposeTransferStep) Identifier() core.StepIdentifier {
 	return ProposingTransferOnElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *proposeTransferStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromEthereum,
+		ProposingTransferOnElrond,
+		SigningProposedTransferOnElrond,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *proposeTransferStep) IsInterfaceNil() bool {
 	return step == nil
@@ -2,10 +2,12 @@ package ethToElrond
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +16,7 @@ func TestExecuteProposeTransfer(t *testing.T) {
 
 	t.Run("nil batch", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return nil
 		}
@@ -28,9 +30,51 @@ func TestExecuteProposeTransfer(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
+	t.Run("error on IsBatchFingerprintAgreed", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorProposeTransfer()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return testBatch
+		}
+		bridgeStub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+			return false, expectedError
+		}
+
+		step := proposeTransferStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := core.StepIdentifier(GettingPendingBatchFromEthereum)
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+	})
+
+	t.Run("batch fingerprint not agreed", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorProposeTransfer()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return testBatch
+		}
+		bridgeStub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+			return false, nil
+		}
+		bridgeStub.WasTransferProposedOnElrondCalled = func(ctx context.Context) (bool, error) {
+			assert.Fail(t, "should have not checked the proposal")
+			return false, nil
+		}
+
+		step := proposeTransferStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintWaiting, step.ConsumeHint())
+	})
+
 	t.Run("error on WasTransferProposedOnElrond", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return testBatch
 		}
@@ -49,7 +93,7 @@ func TestExecuteProposeTransfer(t *testing.T) {
 
 	t.Run("not leader", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return testBatch
 		}
@@ -67,11 +111,12 @@ func TestExecuteProposeTransfer(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, core.StepHintWaiting, step.ConsumeHint())
 	})
 
 	t.Run("error on ProposeTransferOnElrond", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return testBatch
 		}
@@ -94,9 +139,34 @@ func TestExecuteProposeTransfer(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
+	t.Run("out of gas on ProposeTransferOnElrond should retry", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorProposeTransfer()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return testBatch
+		}
+		bridgeStub.WasTransferProposedOnElrondCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		bridgeStub.MyTurnAsLeaderCalled = func() bool {
+			return true
+		}
+		bridgeStub.ProposeTransferOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutOfGas)
+		}
+
+		step := proposeTransferStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintRetrySoon, step.ConsumeHint())
+	})
+
 	t.Run("should work - transfer already proposed", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return testBatch
 		}
@@ -115,7 +185,7 @@ func TestExecuteProposeTransfer(t *testing.T) {
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
-		bridgeStub := createStubExecutor()
+		bridgeStub := createStubExecutorProposeTransfer()
 		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
 			return testBatch
 		}
@@ -140,3 +210,12 @@ func TestExecuteProposeTransfer(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 }
+
+func createStubExecutorProposeTransfer() *bridgeTests.BridgeExecutorStub {
+	stub := createStubExecutor()
+	stub.IsBatchFingerprintAgreedCalled = func() (bool, error) {
+		return true, nil
+	}
+
+	return stub
+}
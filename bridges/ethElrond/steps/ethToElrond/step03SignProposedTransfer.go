@@ -2,9 +2,11 @@ package ethToElrond
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
@@ -46,6 +48,11 @@ func (step *signProposedTransferStep) Execute(ctx context.Context) core.StepIden
 	}
 
 	err = step.bridge.SignActionOnElrond(ctx)
+	if errors.Is(err, clients.ErrTransactionRejectedByContract) {
+		step.bridge.PrintInfo(logger.LogWarning, "signing the proposed transfer was rejected by the contract, skipping",
+			"batch ID", batch.ID, "error", err)
+		return WaitingForQuorum
+	}
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing the proposed transfer",
 			"batch ID", batch.ID, "error", err)
@@ -60,6 +67,14 @@ func (step *signProposedTransferStep) Identifier() core.StepIdentifier {
 	return SigningProposedTransferOnElrond
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *signProposedTransferStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromEthereum,
+		WaitingForQuorum,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *signProposedTransferStep) IsInterfaceNil() bool {
 	return step == nil
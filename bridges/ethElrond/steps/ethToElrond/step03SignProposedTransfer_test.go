@@ -3,6 +3,7 @@ package ethToElrond
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
@@ -71,6 +72,31 @@ func TestExecuteSignProposedTransferStep(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
+	t.Run("rejected by the contract on SignProposedTransfer should skip", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return testBatch
+		}
+		bridgeStub.WasActionSignedOnElrondCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		bridgeStub.SignActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionRejectedByContract)
+		}
+		bridgeStub.GetAndStoreActionIDForProposeTransferOnElrondCalled = func(ctx context.Context) (uint64, error) {
+			return 2, nil
+		}
+
+		step := signProposedTransferStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := core.StepIdentifier(WaitingForQuorum)
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+	})
+
 	t.Run("get action ID errors", func(t *testing.T) {
 		t.Parallel()
 		expectedErr := errors.New("expected error")
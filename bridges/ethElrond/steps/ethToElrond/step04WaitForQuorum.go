@@ -39,6 +39,15 @@ func (step *waitForQuorumStep) Identifier() core.StepIdentifier {
 	return WaitingForQuorum
 }
 
+// AllowedTransitions returns the identifiers of the steps this step is allowed to transition to
+func (step *waitForQuorumStep) AllowedTransitions() []core.StepIdentifier {
+	return []core.StepIdentifier{
+		GettingPendingBatchFromEthereum,
+		WaitingForQuorum,
+		PerformingActionID,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (step *waitForQuorumStep) IsInterfaceNil() bool {
 	return step == nil
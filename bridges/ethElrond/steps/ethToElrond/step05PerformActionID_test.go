@@ -2,8 +2,10 @@ package ethToElrond
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,6 +86,49 @@ func TestExecutePerformActionIDStep(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
+	t.Run("out of gas on PerformActionID should retry", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.WasActionPerformedOnElrondCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		bridgeStub.MyTurnAsLeaderCalled = func() bool {
+			return true
+		}
+		bridgeStub.PerformActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionOutOfGas)
+		}
+
+		step := performActionIDStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+		assert.Equal(t, core.StepHintRetrySoon, step.ConsumeHint())
+	})
+
+	t.Run("rejected by the contract on PerformActionID should check again", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.WasActionPerformedOnElrondCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		bridgeStub.MyTurnAsLeaderCalled = func() bool {
+			return true
+		}
+		bridgeStub.PerformActionOnElrondCalled = func(ctx context.Context) error {
+			return fmt.Errorf("%w, hash: hash", clients.ErrTransactionRejectedByContract)
+		}
+
+		step := performActionIDStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, step.Identifier(), stepIdentifier)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutor()
@@ -0,0 +1,21 @@
+package steps
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// HintHolder can be embedded in steps that provide scheduling hints about their last execution
+type HintHolder struct {
+	hint core.StepHint
+}
+
+// SetHint sets the scheduling hint of the current execution
+func (holder *HintHolder) SetHint(hint core.StepHint) {
+	holder.hint = hint
+}
+
+// ConsumeHint returns the scheduling hint set during the last execution and resets it
+func (holder *HintHolder) ConsumeHint() core.StepHint {
+	hint := holder.hint
+	holder.hint = core.StepHintNone
+
+	return hint
+}
@@ -0,0 +1,49 @@
+package topology
+
+import "github.com/ElrondNetwork/elrond-go-core/core/check"
+
+// activePublicKeysProvider filters out the public keys of the relayers that announced they are shutting down
+// so that they are no longer selected as leaders
+type activePublicKeysProvider struct {
+	publicKeysProvider  PublicKeysProvider
+	leftRelayersChecker LeftRelayersChecker
+}
+
+// NewActivePublicKeysProvider creates a new public keys provider that skips the relayers that left
+func NewActivePublicKeysProvider(publicKeysProvider PublicKeysProvider, leftRelayersChecker LeftRelayersChecker) (*activePublicKeysProvider, error) {
+	if check.IfNil(publicKeysProvider) {
+		return nil, errNilPublicKeysProvider
+	}
+	if check.IfNil(leftRelayersChecker) {
+		return nil, errNilLeftRelayersChecker
+	}
+
+	return &activePublicKeysProvider{
+		publicKeysProvider:  publicKeysProvider,
+		leftRelayersChecker: leftRelayersChecker,
+	}, nil
+}
+
+// SortedPublicKeys returns the sorted public keys of the relayers that did not leave. If all relayers left, all
+// public keys are returned
+func (provider *activePublicKeysProvider) SortedPublicKeys() [][]byte {
+	sortedPublicKeys := provider.publicKeysProvider.SortedPublicKeys()
+	activePublicKeys := make([][]byte, 0, len(sortedPublicKeys))
+	for _, publicKey := range sortedPublicKeys {
+		if provider.leftRelayersChecker.HasLeft(publicKey) {
+			continue
+		}
+		activePublicKeys = append(activePublicKeys, publicKey)
+	}
+
+	if len(activePublicKeys) == 0 {
+		return sortedPublicKeys
+	}
+
+	return activePublicKeys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *activePublicKeysProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
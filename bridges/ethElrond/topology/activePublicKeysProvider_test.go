@@ -0,0 +1,71 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewActivePublicKeysProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public keys provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewActivePublicKeysProvider(nil, &testsCommon.BroadcasterStub{})
+		assert.True(t, check.IfNil(provider))
+		assert.Equal(t, errNilPublicKeysProvider, err)
+	})
+	t.Run("nil left relayers checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewActivePublicKeysProvider(&testsCommon.BroadcasterStub{}, nil)
+		assert.True(t, check.IfNil(provider))
+		assert.Equal(t, errNilLeftRelayersChecker, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewActivePublicKeysProvider(&testsCommon.BroadcasterStub{}, &testsCommon.BroadcasterStub{})
+		assert.False(t, check.IfNil(provider))
+		assert.Nil(t, err)
+	})
+}
+
+func TestActivePublicKeysProvider_SortedPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	publicKeys := [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}
+	publicKeysProvider := &testsCommon.BroadcasterStub{
+		SortedPublicKeysCalled: func() [][]byte {
+			return publicKeys
+		},
+	}
+
+	t.Run("should skip the relayers that left", func(t *testing.T) {
+		t.Parallel()
+
+		leftRelayersChecker := &testsCommon.BroadcasterStub{
+			HasLeftCalled: func(publicKey []byte) bool {
+				return string(publicKey) == "pk2"
+			},
+		}
+		provider, _ := NewActivePublicKeysProvider(publicKeysProvider, leftRelayersChecker)
+
+		assert.Equal(t, [][]byte{[]byte("pk1"), []byte("pk3")}, provider.SortedPublicKeys())
+	})
+	t.Run("all relayers left should return all public keys", func(t *testing.T) {
+		t.Parallel()
+
+		leftRelayersChecker := &testsCommon.BroadcasterStub{
+			HasLeftCalled: func(publicKey []byte) bool {
+				return true
+			},
+		}
+		provider, _ := NewActivePublicKeysProvider(publicKeysProvider, leftRelayersChecker)
+
+		assert.Equal(t, publicKeys, provider.SortedPublicKeys())
+	})
+}
@@ -11,4 +11,5 @@ var (
 	errEmptyAddress             = errors.New("empty address")
 	errNilLogger                = errors.New("nil logger")
 	errNilAddressConverter      = errors.New("nil address converter")
+	errNilLeftRelayersChecker   = errors.New("nil left relayers checker")
 )
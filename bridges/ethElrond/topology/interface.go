@@ -5,3 +5,9 @@ type PublicKeysProvider interface {
 	SortedPublicKeys() [][]byte
 	IsInterfaceNil() bool
 }
+
+// LeftRelayersChecker defines the behavior of a component able to tell if a relayer announced it is shutting down
+type LeftRelayersChecker interface {
+	HasLeft(publicKey []byte) bool
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,303 @@
+package balanceMonitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// EthereumWallet is the identifier of the relayer's Ethereum wallet
+	EthereumWallet = "Ethereum"
+
+	// ElrondWallet is the identifier of the relayer's Elrond wallet
+	ElrondWallet = "Elrond"
+)
+
+// FundsLevel defines the funds level of a relayer wallet
+type FundsLevel string
+
+const (
+	// FundsLevelUnknown is the level used when the number of remaining batches could not be estimated
+	FundsLevelUnknown FundsLevel = "unknown"
+
+	// FundsLevelOK is the level used when the wallet can cover more batches than the warn threshold
+	FundsLevelOK FundsLevel = "ok"
+
+	// FundsLevelWarn is the level used when the wallet can cover at most warn threshold batches
+	FundsLevelWarn FundsLevel = "warn"
+
+	// FundsLevelCritical is the level used when the wallet can cover at most critical threshold batches
+	FundsLevelCritical FundsLevel = "critical"
+)
+
+// ArgsBalanceMonitor is the argument DTO used in the balance monitor constructor
+type ArgsBalanceMonitor struct {
+	Log                        logger.Logger
+	StatusHandler              bridgeCore.StatusHandler
+	AlertHandler               bridgeCore.AlertHandler
+	EthereumChainInteractor    EthereumChainInteractor
+	EthereumAddress            common.Address
+	GasHandler                 GasHandler
+	EthereumGasLimitBase       uint64
+	EthereumGasLimitForEach    uint64
+	ElrondProxy                ElrondProxy
+	ElrondAddress              core.AddressHandler
+	ElrondGasMap               config.ElrondGasMapConfig
+	NumTransfersPerBatch       uint64
+	WarnThresholdInBatches     uint64
+	CriticalThresholdInBatches uint64
+}
+
+type balanceMonitor struct {
+	log                        logger.Logger
+	statusHandler              bridgeCore.StatusHandler
+	alertHandler               bridgeCore.AlertHandler
+	ethereumChainInteractor    EthereumChainInteractor
+	ethereumAddress            common.Address
+	gasHandler                 GasHandler
+	ethereumGasPerBatch        uint64
+	elrondProxy                ElrondProxy
+	elrondAddress              core.AddressHandler
+	elrondGasPerBatch          uint64
+	warnThresholdInBatches     uint64
+	criticalThresholdInBatches uint64
+
+	mutLevels  sync.Mutex
+	fundLevels map[string]FundsLevel
+}
+
+// NewBalanceMonitor creates a new balance monitor instance able to periodically check the relayer's wallets
+func NewBalanceMonitor(args ArgsBalanceMonitor) (*balanceMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bm := &balanceMonitor{
+		log:                        args.Log,
+		statusHandler:              args.StatusHandler,
+		alertHandler:               args.AlertHandler,
+		ethereumChainInteractor:    args.EthereumChainInteractor,
+		ethereumAddress:            args.EthereumAddress,
+		gasHandler:                 args.GasHandler,
+		ethereumGasPerBatch:        args.EthereumGasLimitBase + args.NumTransfersPerBatch*args.EthereumGasLimitForEach,
+		elrondProxy:                args.ElrondProxy,
+		elrondAddress:              args.ElrondAddress,
+		elrondGasPerBatch:          computeElrondGasPerBatch(args.ElrondGasMap, args.NumTransfersPerBatch),
+		warnThresholdInBatches:     args.WarnThresholdInBatches,
+		criticalThresholdInBatches: args.CriticalThresholdInBatches,
+		fundLevels: map[string]FundsLevel{
+			EthereumWallet: FundsLevelUnknown,
+			ElrondWallet:   FundsLevelUnknown,
+		},
+	}
+
+	return bm, nil
+}
+
+func checkArgs(args ArgsBalanceMonitor) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return clients.ErrNilStatusHandler
+	}
+	if check.IfNil(args.AlertHandler) {
+		return clients.ErrNilAlertHandler
+	}
+	if check.IfNil(args.EthereumChainInteractor) {
+		return ErrNilEthereumChainInteractor
+	}
+	if check.IfNil(args.GasHandler) {
+		return ErrNilGasHandler
+	}
+	if check.IfNil(args.ElrondProxy) {
+		return ErrNilElrondProxy
+	}
+	if check.IfNil(args.ElrondAddress) {
+		return ErrNilElrondAddress
+	}
+	if args.NumTransfersPerBatch == 0 {
+		return fmt.Errorf("%w for NumTransfersPerBatch, got %d", clients.ErrInvalidValue, args.NumTransfersPerBatch)
+	}
+	if args.WarnThresholdInBatches < args.CriticalThresholdInBatches {
+		return fmt.Errorf("%w for WarnThresholdInBatches: %d should not be lower than CriticalThresholdInBatches: %d",
+			clients.ErrInvalidValue, args.WarnThresholdInBatches, args.CriticalThresholdInBatches)
+	}
+
+	return nil
+}
+
+// computeElrondGasPerBatch returns the gas needed by a relayer that acts as leader on a batch. The most expensive
+// flow between proposing a transfer and proposing a set status is taken into account
+func computeElrondGasPerBatch(gasMap config.ElrondGasMapConfig, numTransfers uint64) uint64 {
+	performGas := gasMap.PerformActionBase + numTransfers*gasMap.PerformActionForEach
+	proposeTransferGas := gasMap.ProposeTransferBase + numTransfers*gasMap.ProposeTransferForEach
+	proposeStatusGas := gasMap.ProposeStatusBase + numTransfers*gasMap.ProposeStatusForEach
+
+	proposeGas := proposeTransferGas
+	if proposeStatusGas > proposeGas {
+		proposeGas = proposeStatusGas
+	}
+
+	return proposeGas + gasMap.Sign + performGas
+}
+
+// Execute will fetch the relayer's wallets balances and will update the metrics and funds levels
+func (bm *balanceMonitor) Execute(ctx context.Context) error {
+	errEthereum := bm.checkEthereumWallet(ctx)
+	if errEthereum != nil {
+		bm.log.Debug("balanceMonitor.checkEthereumWallet", "error", errEthereum)
+	}
+
+	errElrond := bm.checkElrondWallet(ctx)
+	if errElrond != nil {
+		bm.log.Debug("balanceMonitor.checkElrondWallet", "error", errElrond)
+	}
+
+	if errEthereum != nil {
+		return errEthereum
+	}
+
+	return errElrond
+}
+
+func (bm *balanceMonitor) checkEthereumWallet(ctx context.Context) error {
+	balance, err := bm.ethereumChainInteractor.BalanceAt(ctx, bm.ethereumAddress, nil)
+	if err != nil {
+		return err
+	}
+	bm.statusHandler.SetStringMetric(bridgeCore.MetricEthereumRelayerBalance, balance.String())
+
+	gasPrice, err := bm.gasHandler.GetCurrentGasPrice()
+	if err != nil {
+		return err
+	}
+
+	costPerBatch := big.NewInt(0).Mul(gasPrice, big.NewInt(0).SetUint64(bm.ethereumGasPerBatch))
+	bm.updateRemainingBatches(EthereumWallet, bridgeCore.MetricEthereumEstimatedRemainingBatches,
+		bridgeCore.MetricEthereumRelayerFundsLevel, balance, costPerBatch)
+
+	return nil
+}
+
+func (bm *balanceMonitor) checkElrondWallet(ctx context.Context) error {
+	account, err := bm.elrondProxy.GetAccount(ctx, bm.elrondAddress)
+	if err != nil {
+		return err
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidBalance, account.Balance)
+	}
+	bm.statusHandler.SetStringMetric(bridgeCore.MetricElrondRelayerBalance, balance.String())
+
+	networkConfig, err := bm.elrondProxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	costPerBatch := big.NewInt(0).SetUint64(networkConfig.MinGasPrice)
+	costPerBatch.Mul(costPerBatch, big.NewInt(0).SetUint64(bm.elrondGasPerBatch))
+	bm.updateRemainingBatches(ElrondWallet, bridgeCore.MetricElrondEstimatedRemainingBatches,
+		bridgeCore.MetricElrondRelayerFundsLevel, balance, costPerBatch)
+
+	return nil
+}
+
+func (bm *balanceMonitor) updateRemainingBatches(wallet string, remainingMetric string, levelMetric string, balance *big.Int, costPerBatch *big.Int) {
+	if costPerBatch.Sign() <= 0 {
+		bm.log.Debug("can not estimate the remaining batches as the cost per batch is 0", "wallet", wallet)
+		bm.statusHandler.SetStringMetric(levelMetric, string(FundsLevelUnknown))
+		return
+	}
+
+	remaining := big.NewInt(0).Div(balance, costPerBatch)
+	numRemaining := uint64(math.MaxInt32)
+	if remaining.IsUint64() && remaining.Uint64() < numRemaining {
+		numRemaining = remaining.Uint64()
+	}
+
+	bm.statusHandler.SetIntMetric(remainingMetric, int(numRemaining))
+	bm.setFundsLevel(wallet, levelMetric, bm.computeFundsLevel(numRemaining), balance, numRemaining)
+}
+
+func (bm *balanceMonitor) computeFundsLevel(numRemaining uint64) FundsLevel {
+	if numRemaining <= bm.criticalThresholdInBatches {
+		return FundsLevelCritical
+	}
+	if numRemaining <= bm.warnThresholdInBatches {
+		return FundsLevelWarn
+	}
+
+	return FundsLevelOK
+}
+
+func (bm *balanceMonitor) setFundsLevel(wallet string, levelMetric string, level FundsLevel, balance *big.Int, numRemaining uint64) {
+	bm.statusHandler.SetStringMetric(levelMetric, string(level))
+
+	bm.mutLevels.Lock()
+	oldLevel := bm.fundLevels[wallet]
+	bm.fundLevels[wallet] = level
+	bm.mutLevels.Unlock()
+
+	if oldLevel == level {
+		return
+	}
+
+	switch level {
+	case FundsLevelCritical:
+		bm.log.Error("relayer wallet funds reached the critical threshold", "wallet", wallet,
+			"balance", balance.String(), "estimated remaining batches", numRemaining,
+			"critical threshold", bm.criticalThresholdInBatches)
+		bm.alertLowFunds(wallet, bridgeCore.AlertSeverityCritical, balance, numRemaining)
+	case FundsLevelWarn:
+		bm.log.Warn("relayer wallet funds reached the warn threshold", "wallet", wallet,
+			"balance", balance.String(), "estimated remaining batches", numRemaining,
+			"warn threshold", bm.warnThresholdInBatches)
+		bm.alertLowFunds(wallet, bridgeCore.AlertSeverityWarning, balance, numRemaining)
+	default:
+		bm.log.Info("relayer wallet funds are sufficient", "wallet", wallet,
+			"balance", balance.String(), "estimated remaining batches", numRemaining)
+	}
+}
+
+func (bm *balanceMonitor) alertLowFunds(wallet string, severity bridgeCore.AlertSeverity, balance *big.Int, numRemaining uint64) {
+	bm.alertHandler.Alert(bridgeCore.Alert{
+		Type:     bridgeCore.AlertLowFunds,
+		Severity: severity,
+		Source:   wallet,
+		Message: fmt.Sprintf("relayer wallet balance %s covers an estimated number of %d remaining batches",
+			balance.String(), numRemaining),
+	})
+}
+
+// FundsLevel returns the last computed funds level for the provided wallet
+func (bm *balanceMonitor) FundsLevel(wallet string) FundsLevel {
+	bm.mutLevels.Lock()
+	defer bm.mutLevels.Unlock()
+
+	level, found := bm.fundLevels[wallet]
+	if !found {
+		return FundsLevelUnknown
+	}
+
+	return level
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bm *balanceMonitor) IsInterfaceNil() bool {
+	return bm == nil
+}
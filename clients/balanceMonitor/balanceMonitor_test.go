@@ -0,0 +1,345 @@
+package balanceMonitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const elrondMinGasPrice = 1000000000
+
+func createMockArgsBalanceMonitor() ArgsBalanceMonitor {
+	elrondAddress, _ := data.NewAddressFromBech32String("erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th")
+
+	return ArgsBalanceMonitor{
+		Log:                     logger.GetOrCreate("test"),
+		StatusHandler:           testsCommon.NewStatusHandlerMock("test"),
+		AlertHandler:            &testsCommon.AlertHandlerStub{},
+		EthereumChainInteractor: &bridgeTests.EthereumClientWrapperStub{},
+		EthereumAddress:         common.HexToAddress("0x132A150926691F08a693721503a38affeD18d524"),
+		GasHandler: &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return big.NewInt(10), nil
+			},
+		},
+		EthereumGasLimitBase:    100,
+		EthereumGasLimitForEach: 10,
+		ElrondProxy: &interactors.ElrondProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					MinGasPrice: elrondMinGasPrice,
+				}, nil
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{
+					Balance: "0",
+				}, nil
+			},
+		},
+		ElrondAddress: elrondAddress,
+		ElrondGasMap: config.ElrondGasMapConfig{
+			Sign:                   10,
+			ProposeTransferBase:    20,
+			ProposeTransferForEach: 2,
+			ProposeStatusBase:      30,
+			ProposeStatusForEach:   1,
+			PerformActionBase:      40,
+			PerformActionForEach:   4,
+		},
+		NumTransfersPerBatch:       10,
+		WarnThresholdInBatches:     50,
+		CriticalThresholdInBatches: 10,
+	}
+}
+
+func TestNewBalanceMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Log = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.StatusHandler = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, clients.ErrNilStatusHandler, err)
+	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.AlertHandler = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, clients.ErrNilAlertHandler, err)
+	})
+	t.Run("nil ethereum chain interactor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.EthereumChainInteractor = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, ErrNilEthereumChainInteractor, err)
+	})
+	t.Run("nil gas handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.GasHandler = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, ErrNilGasHandler, err)
+	})
+	t.Run("nil elrond proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.ElrondProxy = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, ErrNilElrondProxy, err)
+	})
+	t.Run("nil elrond address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.ElrondAddress = nil
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.Equal(t, ErrNilElrondAddress, err)
+	})
+	t.Run("invalid number of transfers per batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.NumTransfersPerBatch = 0
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "NumTransfersPerBatch"))
+	})
+	t.Run("warn threshold lower than critical threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.WarnThresholdInBatches = args.CriticalThresholdInBatches - 1
+
+		bm, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(bm))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "WarnThresholdInBatches"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+
+		bm, err := NewBalanceMonitor(args)
+		assert.False(t, check.IfNil(bm))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(200), bm.ethereumGasPerBatch)
+		// max(20+10*2, 30+10*1) + 10 + 40+10*4
+		assert.Equal(t, uint64(130), bm.elrondGasPerBatch)
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(ElrondWallet))
+	})
+}
+
+func TestBalanceMonitor_Execute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	ethCostPerBatch := int64(200 * 10)
+	elrondCostPerBatch := int64(130 * elrondMinGasPrice)
+
+	t.Run("ethereum balance errors should still check the elrond wallet", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.EthereumChainInteractor = &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		err := bm.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, FundsLevelCritical, bm.FundsLevel(ElrondWallet))
+		assert.Equal(t, "0", statusHandler.GetStringMetric(bridgeCore.MetricElrondRelayerBalance))
+	})
+	t.Run("gas price errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.GasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		err := bm.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(EthereumWallet))
+	})
+	t.Run("elrond get account errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.ElrondProxy = &interactors.ElrondProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		err := bm.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(ElrondWallet))
+	})
+	t.Run("elrond invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.ElrondProxy = &interactors.ElrondProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{
+					Balance: "not a number",
+				}, nil
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		err := bm.Execute(context.Background())
+		assert.True(t, errors.Is(err, ErrInvalidBalance))
+	})
+	t.Run("zero gas price should not estimate", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.GasHandler = &testsCommon.GasHandlerStub{}
+		args.EthereumChainInteractor = &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(1000), nil
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		err := bm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, FundsLevelUnknown, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, "1000", statusHandler.GetStringMetric(bridgeCore.MetricEthereumRelayerBalance))
+		assert.Equal(t, string(FundsLevelUnknown), statusHandler.GetStringMetric(bridgeCore.MetricEthereumRelayerFundsLevel))
+	})
+	t.Run("should compute levels and metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		alerts := make(map[string]bridgeCore.Alert)
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert bridgeCore.Alert) {
+				assert.Equal(t, bridgeCore.AlertLowFunds, alert.Type)
+				alerts[alert.Source] = alert
+			},
+		}
+
+		ethBalance := big.NewInt(0)
+		args.EthereumChainInteractor = &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				assert.Equal(t, args.EthereumAddress, account)
+				return big.NewInt(0).Set(ethBalance), nil
+			},
+		}
+		elrondBalance := big.NewInt(0)
+		args.ElrondProxy = &interactors.ElrondProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					MinGasPrice: elrondMinGasPrice,
+				}, nil
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				assert.Equal(t, args.ElrondAddress, address)
+				return &data.Account{
+					Balance: elrondBalance.String(),
+				}, nil
+			},
+		}
+		bm, _ := NewBalanceMonitor(args)
+
+		ethBalance.SetInt64(ethCostPerBatch*100 + 1)
+		elrondBalance.SetInt64(elrondCostPerBatch * 100)
+		err := bm.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, FundsLevelOK, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, FundsLevelOK, bm.FundsLevel(ElrondWallet))
+		assert.Empty(t, alerts)
+		assert.Equal(t, 100, statusHandler.GetIntMetric(bridgeCore.MetricEthereumEstimatedRemainingBatches))
+		assert.Equal(t, 100, statusHandler.GetIntMetric(bridgeCore.MetricElrondEstimatedRemainingBatches))
+		assert.Equal(t, ethBalance.String(), statusHandler.GetStringMetric(bridgeCore.MetricEthereumRelayerBalance))
+		assert.Equal(t, elrondBalance.String(), statusHandler.GetStringMetric(bridgeCore.MetricElrondRelayerBalance))
+
+		ethBalance.SetInt64(ethCostPerBatch * 50)
+		elrondBalance.SetInt64(elrondCostPerBatch*10 + 1)
+		err = bm.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, FundsLevelWarn, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, FundsLevelCritical, bm.FundsLevel(ElrondWallet))
+		assert.Equal(t, string(FundsLevelWarn), statusHandler.GetStringMetric(bridgeCore.MetricEthereumRelayerFundsLevel))
+		assert.Equal(t, string(FundsLevelCritical), statusHandler.GetStringMetric(bridgeCore.MetricElrondRelayerFundsLevel))
+		assert.Equal(t, 50, statusHandler.GetIntMetric(bridgeCore.MetricEthereumEstimatedRemainingBatches))
+		assert.Equal(t, 10, statusHandler.GetIntMetric(bridgeCore.MetricElrondEstimatedRemainingBatches))
+		require.Equal(t, 2, len(alerts))
+		assert.Equal(t, bridgeCore.AlertSeverityWarning, alerts[EthereumWallet].Severity)
+		assert.Equal(t, bridgeCore.AlertSeverityCritical, alerts[ElrondWallet].Severity)
+
+		ethBalance.SetInt64(ethCostPerBatch * 51)
+		alerts = make(map[string]bridgeCore.Alert)
+		err = bm.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, FundsLevelOK, bm.FundsLevel(EthereumWallet))
+		assert.Equal(t, FundsLevelCritical, bm.FundsLevel(ElrondWallet))
+		assert.Empty(t, alerts)
+	})
+}
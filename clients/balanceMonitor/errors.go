@@ -0,0 +1,18 @@
+package balanceMonitor
+
+import "errors"
+
+// ErrNilEthereumChainInteractor signals that a nil Ethereum chain interactor was provided
+var ErrNilEthereumChainInteractor = errors.New("nil Ethereum chain interactor")
+
+// ErrNilGasHandler signals that a nil gas handler was provided
+var ErrNilGasHandler = errors.New("nil gas handler")
+
+// ErrNilElrondProxy signals that a nil Elrond proxy was provided
+var ErrNilElrondProxy = errors.New("nil Elrond proxy")
+
+// ErrNilElrondAddress signals that a nil Elrond address was provided
+var ErrNilElrondAddress = errors.New("nil Elrond address")
+
+// ErrInvalidBalance signals that an invalid balance was received
+var ErrInvalidBalance = errors.New("invalid balance")
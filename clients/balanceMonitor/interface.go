@@ -0,0 +1,29 @@
+package balanceMonitor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumChainInteractor defines an Ethereum client able to fetch the balance of an account
+type EthereumChainInteractor interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// GasHandler defines the component able to fetch the current gas price
+type GasHandler interface {
+	GetCurrentGasPrice() (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// ElrondProxy defines the behavior of a proxy able to serve Elrond blockchain requests
+type ElrondProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
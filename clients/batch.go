@@ -63,6 +63,41 @@ func (tb *TransferBatch) ResolveNewDeposits(newNumDeposits int) {
 	log.Warn("recovered num statuses", "len statuses", oldLen, "new num deposits", newNumDeposits)
 }
 
+// AcceptedDeposits returns the deposits that were not rejected while the batch was created
+func (tb *TransferBatch) AcceptedDeposits() []*DepositTransfer {
+	accepted := make([]*DepositTransfer, 0, len(tb.Deposits))
+	for idx, dt := range tb.Deposits {
+		if idx < len(tb.Statuses) && tb.Statuses[idx] == Rejected {
+			continue
+		}
+
+		accepted = append(accepted, dt)
+	}
+
+	return accepted
+}
+
+// ApplyExecutionStatuses will set the provided statuses, reported for the accepted deposits, in the order of the
+// accepted deposits. The deposits rejected while the batch was created keep their Rejected status
+func (tb *TransferBatch) ApplyExecutionStatuses(statuses []byte) {
+	merged := make([]byte, 0, len(tb.Statuses))
+	statusIndex := 0
+	for _, status := range tb.Statuses {
+		if status == Rejected {
+			merged = append(merged, Rejected)
+			continue
+		}
+		if statusIndex >= len(statuses) {
+			break
+		}
+
+		merged = append(merged, statuses[statusIndex])
+		statusIndex++
+	}
+
+	tb.Statuses = append(merged, statuses[statusIndex:]...)
+}
+
 // DepositTransfer is the deposit transfer structure agnostic of any chain implementation
 type DepositTransfer struct {
 	Nonce               uint64   `json:"nonce"`
@@ -0,0 +1,94 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// ArgsAmountBoundsValidator is the DTO used for creating a new amount bounds validator. The maximum deposit amount
+// is expressed in the source chain denomination, an empty value meaning no limit. A 0 value for the maximum number
+// of deposits means no limit
+type ArgsAmountBoundsValidator struct {
+	Log                 logger.Logger
+	MaxDepositsPerBatch int
+	MaxDepositAmount    string
+}
+
+type amountBoundsValidator struct {
+	log                 logger.Logger
+	maxDepositsPerBatch int
+	maxDepositAmount    *big.Int
+}
+
+// NewAmountBoundsValidator creates a batch validator that checks the batch size and the deposit amounts against
+// sanity bounds
+func NewAmountBoundsValidator(args ArgsAmountBoundsValidator) (*amountBoundsValidator, error) {
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+	if args.MaxDepositsPerBatch < 0 {
+		return nil, fmt.Errorf("%w for MaxDepositsPerBatch: %d", clients.ErrInvalidValue, args.MaxDepositsPerBatch)
+	}
+
+	validator := &amountBoundsValidator{
+		log:                 args.Log,
+		maxDepositsPerBatch: args.MaxDepositsPerBatch,
+	}
+	if len(args.MaxDepositAmount) > 0 {
+		maxDepositAmount, ok := big.NewInt(0).SetString(args.MaxDepositAmount, 10)
+		if !ok || maxDepositAmount.Sign() <= 0 {
+			return nil, fmt.Errorf("%w for MaxDepositAmount: %s", clients.ErrInvalidValue, args.MaxDepositAmount)
+		}
+		validator.maxDepositAmount = maxDepositAmount
+	}
+
+	return validator, nil
+}
+
+// ValidateBatch returns true if the batch is not empty, does not exceed the maximum number of deposits and all its
+// accepted deposits have positive amounts not exceeding the maximum deposit amount
+func (validator *amountBoundsValidator) ValidateBatch(_ context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, clients.ErrNilBatch
+	}
+
+	reason := validator.checkBatch(batch)
+	if len(reason) > 0 {
+		validator.log.Warn("batch out of the sanity bounds", "batch ID", batch.ID, "reason", reason)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (validator *amountBoundsValidator) checkBatch(batch *clients.TransferBatch) string {
+	numDeposits := len(batch.Deposits)
+	if numDeposits == 0 {
+		return "empty batch"
+	}
+	if validator.maxDepositsPerBatch > 0 && numDeposits > validator.maxDepositsPerBatch {
+		return fmt.Sprintf("%d deposits, maximum: %d", numDeposits, validator.maxDepositsPerBatch)
+	}
+
+	for _, deposit := range batch.AcceptedDeposits() {
+		if deposit.Amount == nil || deposit.Amount.Sign() <= 0 {
+			return fmt.Sprintf("deposit nonce %d has a non-positive amount %v", deposit.Nonce, deposit.Amount)
+		}
+		if validator.maxDepositAmount != nil && deposit.Amount.Cmp(validator.maxDepositAmount) > 0 {
+			return fmt.Sprintf("deposit nonce %d amount %s exceeds the maximum %s",
+				deposit.Nonce, deposit.Amount.String(), validator.maxDepositAmount.String())
+		}
+	}
+
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *amountBoundsValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
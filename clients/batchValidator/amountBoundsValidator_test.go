@@ -0,0 +1,115 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsAmountBoundsValidator() ArgsAmountBoundsValidator {
+	return ArgsAmountBoundsValidator{
+		Log:                 logger.GetOrCreate("test"),
+		MaxDepositsPerBatch: 2,
+		MaxDepositAmount:    "1000",
+	}
+}
+
+func TestNewAmountBoundsValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsAmountBoundsValidator()
+		args.Log = nil
+
+		validator, err := NewAmountBoundsValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("negative maximum number of deposits should error", func(t *testing.T) {
+		args := createMockArgsAmountBoundsValidator()
+		args.MaxDepositsPerBatch = -1
+
+		validator, err := NewAmountBoundsValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("invalid maximum deposit amount should error", func(t *testing.T) {
+		for _, value := range []string{"abc", "0", "-5"} {
+			args := createMockArgsAmountBoundsValidator()
+			args.MaxDepositAmount = value
+
+			validator, err := NewAmountBoundsValidator(args)
+			assert.True(t, check.IfNil(validator))
+			assert.True(t, errors.Is(err, clients.ErrInvalidValue), value)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		validator, err := NewAmountBoundsValidator(createMockArgsAmountBoundsValidator())
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), validator.maxDepositAmount)
+	})
+}
+
+func TestAmountBoundsValidator_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	createBatch := func(amounts ...*big.Int) *clients.TransferBatch {
+		batch := &clients.TransferBatch{
+			ID:       1,
+			Statuses: make([]byte, len(amounts)),
+		}
+		for i, amount := range amounts {
+			batch.Deposits = append(batch.Deposits, &clients.DepositTransfer{
+				Nonce:  uint64(i),
+				Amount: amount,
+			})
+		}
+
+		return batch
+	}
+	validator, _ := NewAmountBoundsValidator(createMockArgsAmountBoundsValidator())
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		isValid, err := validator.ValidateBatch(context.Background(), nil)
+		assert.False(t, isValid)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("out of bounds batches should be invalid", func(t *testing.T) {
+		batches := map[string]*clients.TransferBatch{
+			"empty batch":       createBatch(),
+			"too many deposits": createBatch(big.NewInt(1), big.NewInt(1), big.NewInt(1)),
+			"nil amount":        createBatch(big.NewInt(1), nil),
+			"zero amount":       createBatch(big.NewInt(0)),
+			"amount too large":  createBatch(big.NewInt(1001)),
+		}
+		for name, batch := range batches {
+			isValid, err := validator.ValidateBatch(context.Background(), batch)
+			assert.False(t, isValid, name)
+			assert.Nil(t, err, name)
+		}
+	})
+	t.Run("rejected deposits should not be checked", func(t *testing.T) {
+		batch := createBatch(big.NewInt(1000), big.NewInt(1001))
+		batch.Statuses[1] = clients.Rejected
+
+		isValid, err := validator.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+	t.Run("no limits", func(t *testing.T) {
+		unboundedValidator, _ := NewAmountBoundsValidator(ArgsAmountBoundsValidator{
+			Log: logger.GetOrCreate("test"),
+		})
+
+		isValid, err := unboundedValidator.ValidateBatch(context.Background(), createBatch(big.NewInt(1), big.NewInt(1), big.NewInt(1000000)))
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+}
@@ -3,34 +3,73 @@ package batchValidatorManagement
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 const minRequestTime = time.Millisecond
 const logPath = "BatchValidator"
 
-// ArgsBatchValidator is the DTO used for the creating a new batch validator instance
+// ArgsBatchValidator is the DTO used for the creating a new batch validator instance.
+// A request is retried MaxRetries times, waiting RetryDelay doubled on each attempt plus a random jitter of up to
+// RetryDelay. The verdicts are cached for CacheTTL (0 disables the cache), keyed by the hash of the request body.
+// After CircuitBreakerThreshold consecutive failed validations (0 disables the circuit breaker) no request is made
+// for CircuitBreakerOpenTime. When ResponsePublicKey (hex encoded ed25519 public key) is provided, the responses
+// must be signed with the matching private key
 type ArgsBatchValidator struct {
-	SourceChain      chain.Chain
-	DestinationChain chain.Chain
-	RequestURL       string
-	RequestTime      time.Duration
+	SourceChain             chain.Chain
+	DestinationChain        chain.Chain
+	RequestURL              string
+	RequestTime             time.Duration
+	MaxRetries              int
+	RetryDelay              time.Duration
+	CacheTTL                time.Duration
+	CircuitBreakerThreshold int
+	CircuitBreakerOpenTime  time.Duration
+	ResponsePublicKey       string
+}
+
+type cachedVerdict struct {
+	isValid  bool
+	cachedAt time.Time
 }
 
 type batchValidator struct {
-	requestURL  string
-	requestTime time.Duration
-	log         logger.Logger
-	httpClient  HTTPClient
+	log            logger.Logger
+	httpClient     HTTPClient
+	singleSigner   crypto.SingleSigner
+	publicKey      crypto.PublicKey
+	getTimeHandler func() time.Time
+
+	mut                     sync.RWMutex
+	requestURL              string
+	requestTime             time.Duration
+	maxRetries              int
+	retryDelay              time.Duration
+	cacheTTL                time.Duration
+	circuitBreakerThreshold int
+	circuitBreakerOpenTime  time.Duration
+
+	mutState             sync.Mutex
+	cache                map[string]cachedVerdict
+	numConsecutiveErrors int
+	circuitOpenedAt      time.Time
 }
 
 // NewBatchValidator returns a new batch validator instance
@@ -40,11 +79,19 @@ func NewBatchValidator(args ArgsBatchValidator) (*batchValidator, error) {
 		return nil, err
 	}
 
+	publicKey, err := createPublicKey(args.ResponsePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
 	bv := &batchValidator{
-		requestURL:  fmt.Sprintf("%s/%s/%s", args.RequestURL, args.SourceChain.ToLower(), args.DestinationChain.ToLower()),
-		requestTime: args.RequestTime,
-		httpClient:  http.DefaultClient,
+		httpClient:     http.DefaultClient,
+		singleSigner:   &singlesig.Ed25519Signer{},
+		publicKey:      publicKey,
+		getTimeHandler: time.Now,
+		cache:          make(map[string]cachedVerdict),
 	}
+	bv.applySettings(args)
 	bv.log = logger.GetOrCreate(logPath)
 	return bv, nil
 }
@@ -63,6 +110,78 @@ func checkArgs(args ArgsBatchValidator) error {
 	if args.RequestTime < minRequestTime {
 		return fmt.Errorf("%w in checkArgs for value RequestTime", clients.ErrInvalidValue)
 	}
+	if args.MaxRetries < 0 {
+		return fmt.Errorf("%w in checkArgs for value MaxRetries", clients.ErrInvalidValue)
+	}
+	if args.MaxRetries > 0 && args.RetryDelay < minRequestTime {
+		return fmt.Errorf("%w in checkArgs for value RetryDelay", clients.ErrInvalidValue)
+	}
+	if args.CacheTTL < 0 {
+		return fmt.Errorf("%w in checkArgs for value CacheTTL", clients.ErrInvalidValue)
+	}
+	if args.CircuitBreakerThreshold < 0 {
+		return fmt.Errorf("%w in checkArgs for value CircuitBreakerThreshold", clients.ErrInvalidValue)
+	}
+	if args.CircuitBreakerThreshold > 0 && args.CircuitBreakerOpenTime < minRequestTime {
+		return fmt.Errorf("%w in checkArgs for value CircuitBreakerOpenTime", clients.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func createPublicKey(responsePublicKey string) (crypto.PublicKey, error) {
+	if len(responsePublicKey) == 0 {
+		return nil, nil
+	}
+
+	publicKeyBytes, err := hex.DecodeString(responsePublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w in checkArgs for value ResponsePublicKey: %s", clients.ErrInvalidValue, err.Error())
+	}
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	publicKey, err := keyGen.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w in checkArgs for value ResponsePublicKey: %s", clients.ErrInvalidValue, err.Error())
+	}
+
+	return publicKey, nil
+}
+
+func createRequestURL(args ArgsBatchValidator) string {
+	return fmt.Sprintf("%s/%s/%s", args.RequestURL, args.SourceChain.ToLower(), args.DestinationChain.ToLower())
+}
+
+func (bv *batchValidator) applySettings(args ArgsBatchValidator) {
+	bv.mut.Lock()
+	bv.requestURL = createRequestURL(args)
+	bv.requestTime = args.RequestTime
+	bv.maxRetries = args.MaxRetries
+	bv.retryDelay = args.RetryDelay
+	bv.cacheTTL = args.CacheTTL
+	bv.circuitBreakerThreshold = args.CircuitBreakerThreshold
+	bv.circuitBreakerOpenTime = args.CircuitBreakerOpenTime
+	bv.mut.Unlock()
+}
+
+// Reload validates and applies new settings on the running batch validator. The response public key can not be
+// changed while running. The cached verdicts are dropped and the circuit breaker is closed
+func (bv *batchValidator) Reload(args ArgsBatchValidator) error {
+	err := checkArgs(args)
+	if err != nil {
+		return err
+	}
+
+	bv.applySettings(args)
+
+	bv.mutState.Lock()
+	bv.cache = make(map[string]cachedVerdict)
+	bv.numConsecutiveErrors = 0
+	bv.circuitOpenedAt = time.Time{}
+	bv.mutState.Unlock()
+
+	bv.log.Info("batch validator settings reloaded", "request time", args.RequestTime, "max retries", args.MaxRetries,
+		"cache TTL", args.CacheTTL, "circuit breaker threshold", args.CircuitBreakerThreshold)
 
 	return nil
 }
@@ -74,69 +193,257 @@ func (bv *batchValidator) ValidateBatch(ctx context.Context, batch *clients.Tran
 		return false, fmt.Errorf("%w during request marshal", err)
 	}
 
-	responseAsBytes, err := bv.doRequest(ctx, body)
+	batchHash := sha256.Sum256(body)
+	isValid, found := bv.getCachedVerdict(batchHash[:])
+	if found {
+		bv.log.Debug("batch validator: using the cached verdict", "batch ID", batch.ID, "is valid", isValid)
+		return isValid, nil
+	}
+
+	err = bv.checkCircuitBreaker()
+	if err != nil {
+		return false, err
+	}
+
+	isValid, err = bv.validateWithRetries(ctx, body, batchHash[:])
+	bv.updateState(batchHash[:], isValid, err)
 	if err != nil {
-		return false, fmt.Errorf("%w while executing request", err)
+		return false, err
+	}
+
+	return isValid, nil
+}
+
+func (bv *batchValidator) getCachedVerdict(batchHash []byte) (bool, bool) {
+	bv.mut.RLock()
+	cacheTTL := bv.cacheTTL
+	bv.mut.RUnlock()
+
+	bv.mutState.Lock()
+	defer bv.mutState.Unlock()
+
+	verdict, found := bv.cache[string(batchHash)]
+	if !found {
+		return false, false
+	}
+	if bv.getTimeHandler().Sub(verdict.cachedAt) >= cacheTTL {
+		delete(bv.cache, string(batchHash))
+		return false, false
+	}
+
+	return verdict.isValid, true
+}
+
+func (bv *batchValidator) checkCircuitBreaker() error {
+	bv.mut.RLock()
+	threshold := bv.circuitBreakerThreshold
+	openTime := bv.circuitBreakerOpenTime
+	bv.mut.RUnlock()
+
+	if threshold == 0 {
+		return nil
+	}
+
+	bv.mutState.Lock()
+	defer bv.mutState.Unlock()
+
+	if bv.numConsecutiveErrors < threshold {
+		return nil
+	}
+
+	openedFor := bv.getTimeHandler().Sub(bv.circuitOpenedAt)
+	if openedFor < openTime {
+		return fmt.Errorf("%w, %d consecutive failures, retrying in %v", errCircuitBreakerOpen,
+			bv.numConsecutiveErrors, openTime-openedFor)
+	}
+
+	// half-open: a single request is let through, a new failure will open the circuit again
+	bv.circuitOpenedAt = bv.getTimeHandler()
+	bv.log.Info("batch validator: circuit breaker is half-open, trying a new request")
+
+	return nil
+}
+
+func (bv *batchValidator) updateState(batchHash []byte, isValid bool, err error) {
+	bv.mut.RLock()
+	cacheTTL := bv.cacheTTL
+	threshold := bv.circuitBreakerThreshold
+	bv.mut.RUnlock()
+
+	bv.mutState.Lock()
+	defer bv.mutState.Unlock()
+
+	if err != nil {
+		bv.numConsecutiveErrors++
+		if threshold > 0 && bv.numConsecutiveErrors == threshold {
+			bv.circuitOpenedAt = bv.getTimeHandler()
+			bv.log.Warn("batch validator: circuit breaker opened", "consecutive failures", bv.numConsecutiveErrors)
+		}
+		return
+	}
+
+	if threshold > 0 && bv.numConsecutiveErrors >= threshold {
+		bv.log.Info("batch validator: circuit breaker closed")
+	}
+	bv.numConsecutiveErrors = 0
+
+	if cacheTTL == 0 {
+		return
+	}
+	now := bv.getTimeHandler()
+	for key, verdict := range bv.cache {
+		if now.Sub(verdict.cachedAt) >= cacheTTL {
+			delete(bv.cache, key)
+		}
+	}
+	bv.cache[string(batchHash)] = cachedVerdict{
+		isValid:  isValid,
+		cachedAt: now,
+	}
+}
+
+func (bv *batchValidator) validateWithRetries(ctx context.Context, body []byte, batchHash []byte) (bool, error) {
+	bv.mut.RLock()
+	maxRetries := bv.maxRetries
+	retryDelay := bv.retryDelay
+	bv.mut.RUnlock()
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := computeRetryDelay(retryDelay, attempt)
+			bv.log.Debug("batch validator: retrying the request", "attempt", attempt, "delay", delay, "error", lastErr)
+
+			select {
+			case <-ctx.Done():
+				return false, fmt.Errorf("%w while waiting to retry the request, last error: %s", ctx.Err(), lastErr.Error())
+			case <-time.After(delay):
+			}
+		}
+
+		isValid, isRetryable, err := bv.validate(ctx, body, batchHash)
+		if err == nil {
+			return isValid, nil
+		}
+		if !isRetryable || ctx.Err() != nil {
+			return false, err
+		}
+		lastErr = err
+	}
+
+	return false, lastErr
+}
+
+// computeRetryDelay returns the retry delay doubled for each attempt, plus a random jitter of up to the retry delay
+func computeRetryDelay(retryDelay time.Duration, attempt int) time.Duration {
+	delay := retryDelay << uint(attempt-1)
+	jitter := time.Duration(rand.Int63n(int64(retryDelay) + 1))
+
+	return delay + jitter
+}
+
+func (bv *batchValidator) validate(ctx context.Context, body []byte, batchHash []byte) (bool, bool, error) {
+	responseAsBytes, isRetryable, err := bv.doRequest(ctx, body)
+	if err != nil {
+		return false, isRetryable, fmt.Errorf("%w while executing request", err)
 	}
 	if len(responseAsBytes) == 0 {
-		return false, errors.New("empty response")
+		return false, true, errors.New("empty response")
 	}
 
 	response := &microserviceResponse{}
 	err = json.Unmarshal(responseAsBytes, response)
 	if err != nil {
-		return false, fmt.Errorf("%w during response unmarshal", err)
+		return false, true, fmt.Errorf("%w during response unmarshal", err)
+	}
+
+	err = bv.checkResponseSignature(response, batchHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	return response.Valid, false, nil
+}
+
+// checkResponseSignature verifies, if a public key is configured, that the response contains the signature over
+// the hex encoded hash of the request body and the verdict, as in "<hash>:true"
+func (bv *batchValidator) checkResponseSignature(response *microserviceResponse, batchHash []byte) error {
+	if bv.publicKey == nil {
+		return nil
+	}
+	if len(response.Signature) == 0 {
+		return errMissingResponseSignature
+	}
+
+	signature, err := hex.DecodeString(response.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidResponseSignature, err.Error())
+	}
+
+	err = bv.singleSigner.Verify(bv.publicKey, createSignedMessage(batchHash, response.Valid), signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidResponseSignature, err.Error())
 	}
 
-	return response.Valid, nil
+	return nil
+}
+
+func createSignedMessage(batchHash []byte, isValid bool) []byte {
+	return []byte(fmt.Sprintf("%s:%t", hex.EncodeToString(batchHash), isValid))
 }
 
-func (bv *batchValidator) doRequest(ctx context.Context, batch []byte) ([]byte, error) {
-	requestContext, cancel := context.WithTimeout(ctx, bv.requestTime)
+func (bv *batchValidator) doRequest(ctx context.Context, batch []byte) ([]byte, bool, error) {
+	bv.mut.RLock()
+	requestURL := bv.requestURL
+	requestTime := bv.requestTime
+	bv.mut.RUnlock()
+
+	requestContext, cancel := context.WithTimeout(ctx, requestTime)
 	defer cancel()
 
-	responseAsBytes, err := bv.doRequestReturningBytes(batch, requestContext)
+	responseAsBytes, isRetryable, err := bv.doRequestReturningBytes(requestURL, batch, requestContext)
 	if err != nil {
-		return nil, err
+		return nil, isRetryable, err
 	}
 
-	return responseAsBytes, nil
+	return responseAsBytes, false, nil
 }
 
-func (bv *batchValidator) doRequestReturningBytes(batch []byte, ctx context.Context) ([]byte, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, bv.requestURL, bytes.NewBuffer(batch))
-	request.Header.Set("Content-Type", "application/json")
+func (bv *batchValidator) doRequestReturningBytes(requestURL string, batch []byte, ctx context.Context) ([]byte, bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewBuffer(batch))
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	request.Header.Set("Content-Type", "application/json")
 
 	response, err := bv.httpClient.Do(request)
 	if err != nil {
-		return nil, err
+		return nil, true, err
 	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
 	if response.StatusCode == http.StatusBadRequest && response.Body != http.NoBody {
 		data, _ := ioutil.ReadAll(response.Body)
 		badResponse := &microserviceBadRequestBody{}
 		err = json.Unmarshal(data, badResponse)
 		if err != nil {
-			return nil, fmt.Errorf("%w during bad response unmarshal", err)
+			return nil, false, fmt.Errorf("%w during bad response unmarshal", err)
 		}
-		return nil, fmt.Errorf("got status %s: %s", response.Status, badResponse.Message)
+		return nil, false, fmt.Errorf("got status %s: %s", response.Status, badResponse.Message)
 	}
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("got status %s", response.Status)
+		isRetryable := response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests
+		return nil, isRetryable, fmt.Errorf("got status %s", response.Status)
 	}
 
-	defer func() {
-		_ = response.Body.Close()
-	}()
-
 	body, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, true, err
 	}
 
-	return body, nil
+	return body, false, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
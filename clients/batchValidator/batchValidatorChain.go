@@ -0,0 +1,178 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	// CompositionAnd is the composition that requires all the validators to approve the batch
+	CompositionAnd = "and"
+
+	// CompositionOr is the composition that requires at least one validator to approve the batch
+	CompositionOr = "or"
+
+	verdictValid   = "valid"
+	verdictInvalid = "invalid"
+	verdictError   = "error"
+)
+
+// NamedBatchValidator holds a batch validator along with the name used in the logs and metrics
+type NamedBatchValidator struct {
+	Name      string
+	Validator clients.BatchValidator
+}
+
+// ArgsBatchValidatorChain is the DTO used for creating a new batch validator chain
+type ArgsBatchValidatorChain struct {
+	Log           logger.Logger
+	StatusHandler core.StatusHandler
+	Composition   string
+	Validators    []NamedBatchValidator
+}
+
+type batchValidatorChain struct {
+	log           logger.Logger
+	statusHandler core.StatusHandler
+	composition   string
+	validators    []NamedBatchValidator
+}
+
+// NewBatchValidatorChain creates a batch validator composing the verdicts of the provided validators. An empty
+// composition defaults to CompositionAnd
+func NewBatchValidatorChain(args ArgsBatchValidatorChain) (*batchValidatorChain, error) {
+	err := checkArgsChain(args)
+	if err != nil {
+		return nil, err
+	}
+
+	composition := args.Composition
+	if len(composition) == 0 {
+		composition = CompositionAnd
+	}
+
+	return &batchValidatorChain{
+		log:           args.Log,
+		statusHandler: args.StatusHandler,
+		composition:   composition,
+		validators:    args.Validators,
+	}, nil
+}
+
+func checkArgsChain(args ArgsBatchValidatorChain) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return clients.ErrNilStatusHandler
+	}
+	switch args.Composition {
+	case "", CompositionAnd, CompositionOr:
+	default:
+		return fmt.Errorf("%w: %q", errInvalidComposition, args.Composition)
+	}
+	if len(args.Validators) == 0 {
+		return errNoBatchValidators
+	}
+
+	names := make(map[string]struct{})
+	for i, namedValidator := range args.Validators {
+		if len(namedValidator.Name) == 0 {
+			return fmt.Errorf("%w at index %d", errEmptyBatchValidatorName, i)
+		}
+		if check.IfNil(namedValidator.Validator) {
+			return fmt.Errorf("%w: %s", errNilBatchValidator, namedValidator.Name)
+		}
+		_, exists := names[namedValidator.Name]
+		if exists {
+			return fmt.Errorf("%w: %s", errDuplicatedValidatorName, namedValidator.Name)
+		}
+		names[namedValidator.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBatch asks every validator of the chain for its verdict and composes the verdicts. The errors are returned
+// only if the composed verdict depends on the validators that errored
+func (chain *batchValidatorChain) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, clients.ErrNilBatch
+	}
+
+	numValid, numInvalid := 0, 0
+	var lastErr error
+	verdicts := make([]string, 0, len(chain.validators))
+	for _, namedValidator := range chain.validators {
+		verdict := verdictValid
+		isValid, err := namedValidator.Validator.ValidateBatch(ctx, batch)
+		switch {
+		case err != nil:
+			verdict = verdictError
+			lastErr = fmt.Errorf("%w in batch validator %s", err, namedValidator.Name)
+			chain.log.Warn("batch validator errored", "validator", namedValidator.Name, "batch ID", batch.ID, "error", err)
+		case isValid:
+			numValid++
+			chain.log.Debug("batch approved", "validator", namedValidator.Name, "batch ID", batch.ID)
+		default:
+			verdict = verdictInvalid
+			numInvalid++
+			chain.log.Warn("batch rejected", "validator", namedValidator.Name, "batch ID", batch.ID)
+		}
+
+		verdicts = append(verdicts, fmt.Sprintf("%s: %s", namedValidator.Name, verdict))
+	}
+
+	chain.statusHandler.SetStringMetric(core.MetricBatchValidatorsLastVerdicts,
+		fmt.Sprintf("batch ID %d, %s", batch.ID, strings.Join(verdicts, ", ")))
+	chain.statusHandler.AddIntMetric(core.MetricNumBatchValidatorsRejections, numInvalid)
+
+	return chain.compose(numValid, numInvalid, lastErr)
+}
+
+func (chain *batchValidatorChain) compose(numValid int, numInvalid int, lastErr error) (bool, error) {
+	if chain.composition == CompositionOr {
+		if numValid > 0 {
+			return true, nil
+		}
+
+		return false, lastErr
+	}
+
+	if numInvalid > 0 {
+		return false, nil
+	}
+	if lastErr != nil {
+		return false, lastErr
+	}
+
+	return true, nil
+}
+
+// Reload applies the new settings on the validators of the chain that can be reloaded
+func (chain *batchValidatorChain) Reload(args ArgsBatchValidator) error {
+	for _, namedValidator := range chain.validators {
+		reloadableValidator, ok := namedValidator.Validator.(ReloadableBatchValidator)
+		if !ok {
+			continue
+		}
+
+		err := reloadableValidator.Reload(args)
+		if err != nil {
+			return fmt.Errorf("%w for batch validator %s", err, namedValidator.Name)
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chain *batchValidatorChain) IsInterfaceNil() bool {
+	return chain == nil
+}
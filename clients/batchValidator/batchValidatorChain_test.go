@@ -0,0 +1,189 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidatorStub(isValid bool, err error) *testsCommon.BatchValidatorStub {
+	return &testsCommon.BatchValidatorStub{
+		ValidateBatchCalled: func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			return isValid, err
+		},
+	}
+}
+
+func createMockArgsBatchValidatorChain() (ArgsBatchValidatorChain, *testsCommon.StatusHandlerMock) {
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+
+	return ArgsBatchValidatorChain{
+		Log:           logger.GetOrCreate("test"),
+		StatusHandler: statusHandler,
+		Composition:   CompositionAnd,
+		Validators: []NamedBatchValidator{
+			{
+				Name:      "first",
+				Validator: createValidatorStub(true, nil),
+			},
+			{
+				Name:      "second",
+				Validator: createValidatorStub(true, nil),
+			},
+		},
+	}, statusHandler
+}
+
+func TestNewBatchValidatorChain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Log = nil
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.StatusHandler = nil
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.Equal(t, clients.ErrNilStatusHandler, err)
+	})
+	t.Run("invalid composition should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Composition = "xor"
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.True(t, errors.Is(err, errInvalidComposition))
+	})
+	t.Run("no validators should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Validators = nil
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.Equal(t, errNoBatchValidators, err)
+	})
+	t.Run("empty validator name should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Validators[1].Name = ""
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.True(t, errors.Is(err, errEmptyBatchValidatorName))
+	})
+	t.Run("nil validator should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Validators[1].Validator = nil
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.True(t, errors.Is(err, errNilBatchValidator))
+	})
+	t.Run("duplicated validator name should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Validators[1].Name = args.Validators[0].Name
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.True(t, check.IfNil(chain))
+		assert.True(t, errors.Is(err, errDuplicatedValidatorName))
+	})
+	t.Run("empty composition should default to and", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		args.Composition = ""
+
+		chain, err := NewBatchValidatorChain(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(chain))
+		assert.Equal(t, CompositionAnd, chain.composition)
+	})
+}
+
+func TestBatchValidatorChain_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	batch := &clients.TransferBatch{ID: 37}
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		args, _ := createMockArgsBatchValidatorChain()
+		chain, _ := NewBatchValidatorChain(args)
+
+		isValid, err := chain.ValidateBatch(context.Background(), nil)
+		assert.False(t, isValid)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+
+	tests := []struct {
+		name          string
+		composition   string
+		first         *testsCommon.BatchValidatorStub
+		second        *testsCommon.BatchValidatorStub
+		expectedValid bool
+		expectedErr   error
+		verdicts      string
+		rejections    int
+	}{
+		{"and - all valid", CompositionAnd, createValidatorStub(true, nil), createValidatorStub(true, nil), true, nil, "first: valid, second: valid", 0},
+		{"and - one invalid", CompositionAnd, createValidatorStub(true, nil), createValidatorStub(false, nil), false, nil, "first: valid, second: invalid", 1},
+		{"and - one errored", CompositionAnd, createValidatorStub(false, expectedErr), createValidatorStub(true, nil), false, expectedErr, "first: error, second: valid", 0},
+		{"and - invalid wins over errored", CompositionAnd, createValidatorStub(false, expectedErr), createValidatorStub(false, nil), false, nil, "first: error, second: invalid", 1},
+		{"or - one valid", CompositionOr, createValidatorStub(false, nil), createValidatorStub(true, nil), true, nil, "first: invalid, second: valid", 1},
+		{"or - valid wins over errored", CompositionOr, createValidatorStub(false, expectedErr), createValidatorStub(true, nil), true, nil, "first: error, second: valid", 0},
+		{"or - all invalid", CompositionOr, createValidatorStub(false, nil), createValidatorStub(false, nil), false, nil, "first: invalid, second: invalid", 2},
+		{"or - invalid and errored", CompositionOr, createValidatorStub(false, nil), createValidatorStub(false, expectedErr), false, expectedErr, "first: invalid, second: error", 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			args, statusHandler := createMockArgsBatchValidatorChain()
+			args.Composition = tt.composition
+			args.Validators[0].Validator = tt.first
+			args.Validators[1].Validator = tt.second
+			chain, err := NewBatchValidatorChain(args)
+			require.Nil(t, err)
+
+			isValid, err := chain.ValidateBatch(context.Background(), batch)
+			assert.Equal(t, tt.expectedValid, isValid)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, "batch ID 37, "+tt.verdicts, statusHandler.GetStringMetric(core.MetricBatchValidatorsLastVerdicts))
+			assert.Equal(t, tt.rejections, statusHandler.GetIntMetric(core.MetricNumBatchValidatorsRejections))
+		})
+	}
+}
+
+func TestBatchValidatorChain_Reload(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsBatchValidatorChain()
+	httpValidator, err := NewBatchValidator(createMockArgsBatchValidator())
+	require.Nil(t, err)
+	args.Validators[1].Validator = httpValidator
+	chain, _ := NewBatchValidatorChain(args)
+
+	argsReload := createMockArgsBatchValidator()
+	argsReload.RequestURL = "http://127.0.0.1:8080"
+	argsReload.RequestTime = time.Second * 5
+	err = chain.Reload(argsReload)
+	assert.Nil(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080/ethereum/elrond", httpValidator.requestURL)
+	assert.Equal(t, time.Second*5, httpValidator.requestTime)
+
+	argsReload.RequestTime = 0
+	err = chain.Reload(argsReload)
+	assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+}
@@ -1,7 +1,10 @@
 package batchValidatorManagement
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +13,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +21,9 @@ import (
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -285,3 +292,448 @@ func TestBatchValidator_ValidateBatch(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBatchValidator_Reload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request time should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchValidator()
+		bv, _ := NewBatchValidator(args)
+
+		args.RequestTime = 0
+		err := bv.Reload(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("should send the requests on the new URL", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchValidator()
+		bv, _ := NewBatchValidator(args)
+
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				expectedURL := fmt.Sprintf("/new/%s/%s", args.SourceChain.ToLower(), args.DestinationChain.ToLower())
+				require.Equal(t, expectedURL, request.URL.String())
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		}
+
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args.RequestURL = server.URL + "/new"
+		err := bv.Reload(args)
+		require.Nil(t, err)
+
+		isValid, err := bv.ValidateBatch(context.Background(), &clients.TransferBatch{})
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+}
+
+func createValidResponseHandler(numCalls *int32, isValid bool) *testsCommon.HTTPHandlerStub {
+	return &testsCommon.HTTPHandlerStub{
+		ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+			atomic.AddInt32(numCalls, 1)
+
+			writer.WriteHeader(http.StatusOK)
+			respBytes, _ := json.Marshal(&microserviceResponse{Valid: isValid})
+			_, _ = writer.Write(respBytes)
+		},
+	}
+}
+
+func TestNewBatchValidator_ResilienceSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative max retries", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = -1
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value MaxRetries"))
+	})
+	t.Run("retries without retry delay", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = 1
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RetryDelay"))
+	})
+	t.Run("negative cache TTL", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.CacheTTL = -time.Second
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value CacheTTL"))
+	})
+	t.Run("negative circuit breaker threshold", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.CircuitBreakerThreshold = -1
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value CircuitBreakerThreshold"))
+	})
+	t.Run("circuit breaker without open time", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.CircuitBreakerThreshold = 1
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value CircuitBreakerOpenTime"))
+	})
+	t.Run("invalid response public key", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.ResponsePublicKey = "not hex"
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+
+		args.ResponsePublicKey = "aabb"
+		bv, err = NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value ResponsePublicKey"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		args.CacheTTL = time.Second
+		args.CircuitBreakerThreshold = 3
+		args.CircuitBreakerOpenTime = time.Second
+		args.ResponsePublicKey = hex.EncodeToString(bytes.Repeat([]byte{1}, 32))
+
+		bv, err := NewBatchValidator(args)
+		assert.False(t, check.IfNil(bv))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchValidator_ValidateBatchRetries(t *testing.T) {
+	t.Parallel()
+
+	batch := &clients.TransferBatch{ID: 1}
+	t.Run("should retry on server errors", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				if atomic.AddInt32(&numCalls, 1) < 3 {
+					writer.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		}
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("should return the last error after all the retries", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				atomic.AddInt32(&numCalls, 1)
+				writer.WriteHeader(http.StatusInternalServerError)
+			},
+		}
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Equal(t, "got status 500 Internal Server Error while executing request", err.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("should not retry on client errors", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				atomic.AddInt32(&numCalls, 1)
+				writer.WriteHeader(http.StatusForbidden)
+			},
+		}
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	})
+}
+
+func TestComputeRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	retryDelay := time.Millisecond * 100
+	for attempt := 1; attempt <= 4; attempt++ {
+		minDelay := retryDelay << uint(attempt-1)
+		for i := 0; i < 10; i++ {
+			delay := computeRetryDelay(retryDelay, attempt)
+			assert.True(t, delay >= minDelay)
+			assert.True(t, delay <= minDelay+retryDelay)
+		}
+	}
+}
+
+func TestBatchValidator_ValidateBatchCache(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	server := httptest.NewServer(createValidResponseHandler(&numCalls, false))
+	defer server.Close()
+
+	args := createMockArgsBatchValidator()
+	args.RequestURL = server.URL
+	args.CacheTTL = time.Minute
+	bv, _ := NewBatchValidator(args)
+	currentTime := time.Unix(1000, 0)
+	bv.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	batch := &clients.TransferBatch{ID: 1}
+	for i := 0; i < 3; i++ {
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+
+	// a different batch is not served from the cache
+	_, _ = bv.ValidateBatch(context.Background(), &clients.TransferBatch{ID: 2})
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+
+	currentTime = currentTime.Add(time.Minute)
+	_, _ = bv.ValidateBatch(context.Background(), batch)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+
+	err := bv.Reload(args)
+	require.Nil(t, err)
+	_, _ = bv.ValidateBatch(context.Background(), batch)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&numCalls))
+}
+
+func TestBatchValidator_ValidateBatchCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	shouldFail := int32(1)
+	responseHandler := &testsCommon.HTTPHandlerStub{
+		ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+			atomic.AddInt32(&numCalls, 1)
+			if atomic.LoadInt32(&shouldFail) == 1 {
+				writer.WriteHeader(http.StatusBadGateway)
+				return
+			}
+
+			writer.WriteHeader(http.StatusOK)
+			respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+			_, _ = writer.Write(respBytes)
+		},
+	}
+	server := httptest.NewServer(responseHandler)
+	defer server.Close()
+
+	args := createMockArgsBatchValidator()
+	args.RequestURL = server.URL
+	args.CircuitBreakerThreshold = 2
+	args.CircuitBreakerOpenTime = time.Minute
+	bv, _ := NewBatchValidator(args)
+	currentTime := time.Unix(1000, 0)
+	bv.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	batch := &clients.TransferBatch{ID: 1}
+	for i := 0; i < 2; i++ {
+		_, err := bv.ValidateBatch(context.Background(), batch)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+
+	_, err := bv.ValidateBatch(context.Background(), batch)
+	assert.True(t, errors.Is(err, errCircuitBreakerOpen))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+
+	// half-open: the failed request opens the circuit again
+	currentTime = currentTime.Add(time.Minute)
+	_, err = bv.ValidateBatch(context.Background(), batch)
+	assert.False(t, errors.Is(err, errCircuitBreakerOpen))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+
+	_, err = bv.ValidateBatch(context.Background(), batch)
+	assert.True(t, errors.Is(err, errCircuitBreakerOpen))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+
+	// half-open: the successful request closes the circuit
+	currentTime = currentTime.Add(time.Minute)
+	atomic.StoreInt32(&shouldFail, 0)
+	isValid, err := bv.ValidateBatch(context.Background(), batch)
+	assert.True(t, isValid)
+	assert.Nil(t, err)
+
+	atomic.StoreInt32(&shouldFail, 1)
+	_, err = bv.ValidateBatch(context.Background(), batch)
+	assert.False(t, errors.Is(err, errCircuitBreakerOpen))
+	assert.Equal(t, int32(5), atomic.LoadInt32(&numCalls))
+}
+
+func TestBatchValidator_ValidateBatchSignedResponses(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, publicKey := keyGen.GeneratePair()
+	publicKeyBytes, _ := publicKey.ToByteArray()
+	signer := &singlesig.Ed25519Signer{}
+	batch := &clients.TransferBatch{ID: 1}
+
+	createSignedResponseHandler := func(signedVerdict bool, sentVerdict bool, signedBody func(body []byte) []byte) *testsCommon.HTTPHandlerStub {
+		return &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				body, _ := ioutil.ReadAll(request.Body)
+				batchHash := sha256.Sum256(signedBody(body))
+				signature, _ := signer.Sign(privateKey, createSignedMessage(batchHash[:], signedVerdict))
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{
+					Valid:     sentVerdict,
+					Signature: hex.EncodeToString(signature),
+				})
+				_, _ = writer.Write(respBytes)
+			},
+		}
+	}
+	sameBody := func(body []byte) []byte {
+		return body
+	}
+
+	t.Run("missing signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		server := httptest.NewServer(createValidResponseHandler(&numCalls, true))
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.ResponsePublicKey = hex.EncodeToString(publicKeyBytes)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Equal(t, errMissingResponseSignature, err)
+	})
+	t.Run("tampered verdict should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(createSignedResponseHandler(false, true, sameBody))
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.ResponsePublicKey = hex.EncodeToString(publicKeyBytes)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errInvalidResponseSignature))
+	})
+	t.Run("signature for another batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherBody := func(body []byte) []byte {
+			return append(body, 'x')
+		}
+		server := httptest.NewServer(createSignedResponseHandler(true, true, otherBody))
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.ResponsePublicKey = hex.EncodeToString(publicKeyBytes)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errInvalidResponseSignature))
+	})
+	t.Run("signature from another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(createSignedResponseHandler(true, true, sameBody))
+		defer server.Close()
+
+		_, otherPublicKey := keyGen.GeneratePair()
+		otherPublicKeyBytes, _ := otherPublicKey.ToByteArray()
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.ResponsePublicKey = hex.EncodeToString(otherPublicKeyBytes)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errInvalidResponseSignature))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(createSignedResponseHandler(true, true, sameBody))
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.ResponsePublicKey = hex.EncodeToString(publicKeyBytes)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+}
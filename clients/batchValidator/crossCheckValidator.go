@@ -0,0 +1,103 @@
+package batchValidatorManagement
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// ArgsCrossCheckValidator is the DTO used for creating a new cross-check validator
+type ArgsCrossCheckValidator struct {
+	Log          logger.Logger
+	BatchFetcher BatchFetcher
+}
+
+type crossCheckValidator struct {
+	log          logger.Logger
+	batchFetcher BatchFetcher
+}
+
+// NewCrossCheckValidator creates a batch validator that fetches the same batch from an independent source and
+// compares it field by field with the provided batch
+func NewCrossCheckValidator(args ArgsCrossCheckValidator) (*crossCheckValidator, error) {
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+	if check.IfNil(args.BatchFetcher) {
+		return nil, errNilBatchFetcher
+	}
+
+	return &crossCheckValidator{
+		log:          args.Log,
+		batchFetcher: args.BatchFetcher,
+	}, nil
+}
+
+// ValidateBatch returns true if the batch fetched from the independent source has the same contents. The converted
+// tokens and the statuses are computed locally so they are not compared
+func (validator *crossCheckValidator) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, clients.ErrNilBatch
+	}
+
+	fetchedBatch, err := validator.batchFetcher.GetBatch(ctx, batch.ID)
+	if err != nil {
+		return false, fmt.Errorf("%w while fetching the batch from the independent source", err)
+	}
+
+	differences := compareBatches(batch, fetchedBatch)
+	for _, difference := range differences {
+		validator.log.Warn("batch differs on the independent source", "batch ID", batch.ID, "difference", difference)
+	}
+
+	return len(differences) == 0, nil
+}
+
+func compareBatches(batch *clients.TransferBatch, fetchedBatch *clients.TransferBatch) []string {
+	differences := make([]string, 0)
+	if batch.ID != fetchedBatch.ID {
+		differences = append(differences, fmt.Sprintf("batch ID: %d != %d", batch.ID, fetchedBatch.ID))
+	}
+	if len(batch.Deposits) != len(fetchedBatch.Deposits) {
+		return append(differences, fmt.Sprintf("number of deposits: %d != %d", len(batch.Deposits), len(fetchedBatch.Deposits)))
+	}
+
+	for i, deposit := range batch.Deposits {
+		fetchedDeposit := fetchedBatch.Deposits[i]
+		if deposit.Nonce != fetchedDeposit.Nonce {
+			differences = append(differences, fmt.Sprintf("deposit %d nonce: %d != %d", i, deposit.Nonce, fetchedDeposit.Nonce))
+		}
+		if !bytes.Equal(deposit.FromBytes, fetchedDeposit.FromBytes) {
+			differences = append(differences, fmt.Sprintf("deposit %d from: %s != %s", i, deposit.DisplayableFrom, fetchedDeposit.DisplayableFrom))
+		}
+		if !bytes.Equal(deposit.ToBytes, fetchedDeposit.ToBytes) {
+			differences = append(differences, fmt.Sprintf("deposit %d to: %s != %s", i, deposit.DisplayableTo, fetchedDeposit.DisplayableTo))
+		}
+		if !bytes.Equal(deposit.TokenBytes, fetchedDeposit.TokenBytes) {
+			differences = append(differences, fmt.Sprintf("deposit %d token: %s != %s", i, deposit.DisplayableToken, fetchedDeposit.DisplayableToken))
+		}
+		if !equalAmounts(deposit.Amount, fetchedDeposit.Amount) {
+			differences = append(differences, fmt.Sprintf("deposit %d amount: %v != %v", i, deposit.Amount, fetchedDeposit.Amount))
+		}
+	}
+
+	return differences
+}
+
+func equalAmounts(first *big.Int, second *big.Int) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return first.Cmp(second) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *crossCheckValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
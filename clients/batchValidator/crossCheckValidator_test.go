@@ -0,0 +1,132 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockCrossCheckBatch() *clients.TransferBatch {
+	return &clients.TransferBatch{
+		ID: 112,
+		Deposits: []*clients.DepositTransfer{
+			{
+				Nonce:               1,
+				FromBytes:           []byte("from1"),
+				ToBytes:             []byte("to1"),
+				TokenBytes:          []byte("token1"),
+				ConvertedTokenBytes: []byte("converted1"),
+				Amount:              big.NewInt(1000),
+			},
+			{
+				Nonce:               2,
+				FromBytes:           []byte("from2"),
+				ToBytes:             []byte("to2"),
+				TokenBytes:          []byte("token2"),
+				ConvertedTokenBytes: []byte("converted2"),
+				Amount:              big.NewInt(2000),
+			},
+		},
+		Statuses: []byte{0, clients.Rejected},
+	}
+}
+
+func TestNewCrossCheckValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		validator, err := NewCrossCheckValidator(ArgsCrossCheckValidator{
+			BatchFetcher: &testsCommon.BatchFetcherStub{},
+		})
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil batch fetcher should error", func(t *testing.T) {
+		validator, err := NewCrossCheckValidator(ArgsCrossCheckValidator{
+			Log: logger.GetOrCreate("test"),
+		})
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, errNilBatchFetcher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		validator, err := NewCrossCheckValidator(ArgsCrossCheckValidator{
+			Log:          logger.GetOrCreate("test"),
+			BatchFetcher: &testsCommon.BatchFetcherStub{},
+		})
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCrossCheckValidator_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	createValidator := func(fetchedBatch *clients.TransferBatch, fetchErr error) *crossCheckValidator {
+		validator, _ := NewCrossCheckValidator(ArgsCrossCheckValidator{
+			Log: logger.GetOrCreate("test"),
+			BatchFetcher: &testsCommon.BatchFetcherStub{
+				GetBatchCalled: func(ctx context.Context, batchID uint64) (*clients.TransferBatch, error) {
+					assert.Equal(t, uint64(112), batchID)
+					return fetchedBatch, fetchErr
+				},
+			},
+		})
+
+		return validator
+	}
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		validator := createValidator(nil, nil)
+
+		isValid, err := validator.ValidateBatch(context.Background(), nil)
+		assert.False(t, isValid)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("fetch error should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		validator := createValidator(nil, expectedErr)
+
+		isValid, err := validator.ValidateBatch(context.Background(), createMockCrossCheckBatch())
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("same contents should be valid", func(t *testing.T) {
+		fetchedBatch := createMockCrossCheckBatch()
+		// the converted tokens and statuses are computed locally
+		fetchedBatch.Deposits[0].ConvertedTokenBytes = nil
+		fetchedBatch.Statuses = make([]byte, 2)
+		validator := createValidator(fetchedBatch, nil)
+
+		isValid, err := validator.ValidateBatch(context.Background(), createMockCrossCheckBatch())
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+	t.Run("different contents should be invalid", func(t *testing.T) {
+		changes := map[string]func(batch *clients.TransferBatch){
+			"batch ID":           func(batch *clients.TransferBatch) { batch.ID++ },
+			"number of deposits": func(batch *clients.TransferBatch) { batch.Deposits = batch.Deposits[:1] },
+			"nonce":              func(batch *clients.TransferBatch) { batch.Deposits[1].Nonce++ },
+			"from":               func(batch *clients.TransferBatch) { batch.Deposits[1].FromBytes = []byte("from3") },
+			"to":                 func(batch *clients.TransferBatch) { batch.Deposits[1].ToBytes = []byte("to3") },
+			"token":              func(batch *clients.TransferBatch) { batch.Deposits[1].TokenBytes = []byte("token3") },
+			"amount":             func(batch *clients.TransferBatch) { batch.Deposits[1].Amount = big.NewInt(2001) },
+			"nil amount":         func(batch *clients.TransferBatch) { batch.Deposits[1].Amount = nil },
+		}
+		for field, change := range changes {
+			fetchedBatch := createMockCrossCheckBatch()
+			change(fetchedBatch)
+			validator := createValidator(fetchedBatch, nil)
+
+			isValid, err := validator.ValidateBatch(context.Background(), createMockCrossCheckBatch())
+			assert.False(t, isValid, field)
+			assert.Nil(t, err, field)
+		}
+	})
+}
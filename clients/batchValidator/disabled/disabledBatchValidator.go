@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	batchValidatorManagement "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator"
 )
 
 type disabledBatchValidator struct{}
@@ -18,6 +19,11 @@ func (dbv *disabledBatchValidator) ValidateBatch(_ context.Context, _ *clients.T
 	return true, nil
 }
 
+// Reload does nothing as there are no settings to be applied
+func (dbv *disabledBatchValidator) Reload(_ batchValidatorManagement.ArgsBatchValidator) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dbv *disabledBatchValidator) IsInterfaceNil() bool {
 	return dbv == nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	batchValidatorManagement "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,4 +17,5 @@ func TestNewDisabledBatchValidator(t *testing.T) {
 	isValid, err := dbv.ValidateBatch(context.Background(), nil)
 	assert.True(t, isValid)
 	assert.Nil(t, err)
+	assert.Nil(t, dbv.Reload(batchValidatorManagement.ArgsBatchValidator{}))
 }
@@ -0,0 +1,15 @@
+package batchValidatorManagement
+
+import "errors"
+
+var (
+	errNoBatchValidators        = errors.New("no batch validators")
+	errNilBatchValidator        = errors.New("nil batch validator")
+	errEmptyBatchValidatorName  = errors.New("empty batch validator name")
+	errDuplicatedValidatorName  = errors.New("duplicated batch validator name")
+	errInvalidComposition       = errors.New("invalid batch validators composition")
+	errNilBatchFetcher          = errors.New("nil batch fetcher")
+	errCircuitBreakerOpen       = errors.New("batch validator circuit breaker is open")
+	errMissingResponseSignature = errors.New("missing batch validator response signature")
+	errInvalidResponseSignature = errors.New("invalid batch validator response signature")
+)
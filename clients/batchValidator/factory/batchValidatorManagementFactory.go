@@ -1,15 +1,16 @@
 package factory
 
 import (
-	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	batchValidatorManagement "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator/disabled"
 )
 
-// CreateBatchValidator generates an implementation of BatchValidator
-func CreateBatchValidator(args batchValidatorManagement.ArgsBatchValidator, enabled bool) (clients.BatchValidator, error) {
-	if enabled {
-		return batchValidatorManagement.NewBatchValidator(args)
+// CreateBatchValidator generates an implementation of ReloadableBatchValidator composing the provided validators.
+// A disabled batch validator is returned if no validator is provided
+func CreateBatchValidator(args batchValidatorManagement.ArgsBatchValidatorChain) (batchValidatorManagement.ReloadableBatchValidator, error) {
+	if len(args.Validators) == 0 {
+		return disabled.NewDisabledBatchValidator(), nil
 	}
-	return disabled.NewDisabledBatchValidator(), nil
+
+	return batchValidatorManagement.NewBatchValidatorChain(args)
 }
@@ -1,8 +1,33 @@
 package batchValidatorManagement
 
-import "net/http"
+import (
+	"context"
+	"math/big"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+)
 
 // HTTPClient is the interface we expect to call in order to do the HTTP requests
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// ReloadableBatchValidator defines a batch validator whose settings can be changed while running
+type ReloadableBatchValidator interface {
+	clients.BatchValidator
+	Reload(args ArgsBatchValidator) error
+}
+
+// BatchFetcher defines the operations of a component able to fetch a batch from an independent source
+type BatchFetcher interface {
+	GetBatch(ctx context.Context, batchID uint64) (*clients.TransferBatch, error)
+	IsInterfaceNil() bool
+}
+
+// TokensPolicy defines the component able to tell if a deposit complies with the configured tokens policy
+type TokensPolicy interface {
+	CheckEthereumDeposit(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error
+	CheckElrondDeposit(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,77 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// ArgsTokensAllowlistValidator is the DTO used for creating a new tokens allowlist validator
+type ArgsTokensAllowlistValidator struct {
+	Log          logger.Logger
+	SourceChain  chain.Chain
+	TokensPolicy TokensPolicy
+}
+
+type tokensAllowlistValidator struct {
+	log            logger.Logger
+	isElrondSource bool
+	tokensPolicy   TokensPolicy
+}
+
+// NewTokensAllowlistValidator creates a batch validator that checks the accepted deposits of the batch against the
+// configured tokens policy
+func NewTokensAllowlistValidator(args ArgsTokensAllowlistValidator) (*tokensAllowlistValidator, error) {
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+	switch args.SourceChain {
+	case chain.Ethereum, chain.Bsc, chain.Elrond:
+	default:
+		return nil, fmt.Errorf("%w: %q", clients.ErrInvalidValue, args.SourceChain)
+	}
+	if check.IfNil(args.TokensPolicy) {
+		return nil, clients.ErrNilTokensPolicy
+	}
+
+	return &tokensAllowlistValidator{
+		log:            args.Log,
+		isElrondSource: args.SourceChain == chain.Elrond,
+		tokensPolicy:   args.TokensPolicy,
+	}, nil
+}
+
+// ValidateBatch returns true if all the accepted deposits of the batch comply with the tokens policy
+func (validator *tokensAllowlistValidator) ValidateBatch(_ context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, clients.ErrNilBatch
+	}
+
+	for _, deposit := range batch.AcceptedDeposits() {
+		err := validator.checkDeposit(deposit)
+		if err != nil {
+			validator.log.Warn("deposit not allowed by the tokens policy", "batch ID", batch.ID,
+				"deposit nonce", deposit.Nonce, "reason", err)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (validator *tokensAllowlistValidator) checkDeposit(deposit *clients.DepositTransfer) error {
+	if validator.isElrondSource {
+		return validator.tokensPolicy.CheckElrondDeposit(deposit.TokenBytes, deposit.ConvertedTokenBytes, deposit.Amount)
+	}
+
+	return validator.tokensPolicy.CheckEthereumDeposit(deposit.TokenBytes, deposit.ConvertedTokenBytes, deposit.Amount)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *tokensAllowlistValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
@@ -0,0 +1,109 @@
+package batchValidatorManagement
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsTokensAllowlistValidator() ArgsTokensAllowlistValidator {
+	return ArgsTokensAllowlistValidator{
+		Log:          logger.GetOrCreate("test"),
+		SourceChain:  chain.Ethereum,
+		TokensPolicy: &bridgeTests.TokensPolicyStub{},
+	}
+}
+
+func TestNewTokensAllowlistValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsTokensAllowlistValidator()
+		args.Log = nil
+
+		validator, err := NewTokensAllowlistValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("invalid source chain should error", func(t *testing.T) {
+		args := createMockArgsTokensAllowlistValidator()
+		args.SourceChain = ""
+
+		validator, err := NewTokensAllowlistValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("nil tokens policy should error", func(t *testing.T) {
+		args := createMockArgsTokensAllowlistValidator()
+		args.TokensPolicy = nil
+
+		validator, err := NewTokensAllowlistValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, clients.ErrNilTokensPolicy, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		validator, err := NewTokensAllowlistValidator(createMockArgsTokensAllowlistValidator())
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokensAllowlistValidator_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	errNotAllowed := errors.New("not allowed")
+	batch := createMockCrossCheckBatch()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		validator, _ := NewTokensAllowlistValidator(createMockArgsTokensAllowlistValidator())
+
+		isValid, err := validator.ValidateBatch(context.Background(), nil)
+		assert.False(t, isValid)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("ethereum source should check the accepted deposits as ethereum deposits", func(t *testing.T) {
+		args := createMockArgsTokensAllowlistValidator()
+		checkedTokens := make([]string, 0)
+		args.TokensPolicy = &bridgeTests.TokensPolicyStub{
+			CheckEthereumDepositCalled: func(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error {
+				checkedTokens = append(checkedTokens, string(erc20Address)+"/"+string(esdtTokenID))
+				return nil
+			},
+			CheckElrondDepositCalled: func(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		validator, _ := NewTokensAllowlistValidator(args)
+
+		isValid, err := validator.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		// the second deposit was already rejected
+		assert.Equal(t, []string{"token1/converted1"}, checkedTokens)
+	})
+	t.Run("elrond source should check the accepted deposits as elrond deposits", func(t *testing.T) {
+		args := createMockArgsTokensAllowlistValidator()
+		args.SourceChain = chain.Elrond
+		args.TokensPolicy = &bridgeTests.TokensPolicyStub{
+			CheckElrondDepositCalled: func(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error {
+				assert.Equal(t, []byte("token1"), esdtTokenID)
+				assert.Equal(t, []byte("converted1"), erc20Address)
+				return errNotAllowed
+			},
+		}
+		validator, _ := NewTokensAllowlistValidator(args)
+
+		isValid, err := validator.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Nil(t, err)
+	})
+}
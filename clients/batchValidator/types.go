@@ -6,7 +6,8 @@ import (
 )
 
 type microserviceResponse struct {
-	Valid bool `json:"valid"`
+	Valid     bool   `json:"valid"`
+	Signature string `json:"signature,omitempty"`
 }
 
 // String will convert the microservice response to a string
@@ -162,3 +162,69 @@ func TestTransferBatch_ResolveNewDeposits(t *testing.T) {
 		assert.Equal(t, []byte{0, 0, Rejected}, workingBatch.Statuses)
 	})
 }
+
+func TestTransferBatch_AcceptedDeposits(t *testing.T) {
+	t.Parallel()
+
+	batch := &TransferBatch{
+		Deposits: []*DepositTransfer{
+			{
+				Nonce: 1,
+			},
+			{
+				Nonce: 2,
+			},
+			{
+				Nonce: 3,
+			},
+		},
+		Statuses: []byte{0, Rejected, 0},
+	}
+
+	accepted := batch.AcceptedDeposits()
+	assert.Equal(t, []*DepositTransfer{batch.Deposits[0], batch.Deposits[2]}, accepted)
+
+	batch.Statuses = nil
+	assert.Equal(t, batch.Deposits, batch.AcceptedDeposits())
+}
+
+func TestTransferBatch_ApplyExecutionStatuses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without rejected deposits", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			Statuses: make([]byte, 2),
+		}
+		batch.ApplyExecutionStatuses([]byte{Executed, Rejected})
+		assert.Equal(t, []byte{Executed, Rejected}, batch.Statuses)
+	})
+	t.Run("with rejected deposits", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			Statuses: []byte{Rejected, 0, Rejected, 0},
+		}
+		batch.ApplyExecutionStatuses([]byte{Executed, Rejected})
+		assert.Equal(t, []byte{Rejected, Executed, Rejected, Rejected}, batch.Statuses)
+	})
+	t.Run("less execution statuses", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			Statuses: []byte{Rejected, 0, 0},
+		}
+		batch.ApplyExecutionStatuses([]byte{Executed})
+		assert.Equal(t, []byte{Rejected, Executed}, batch.Statuses)
+	})
+	t.Run("more execution statuses", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			Statuses: []byte{0, Rejected},
+		}
+		batch.ApplyExecutionStatuses([]byte{Executed, Executed})
+		assert.Equal(t, []byte{Executed, Rejected, Executed}, batch.Statuses)
+	})
+}
@@ -15,6 +15,8 @@ const (
 	elrondRoleProviderLogIdTemplate             = "%sElrond-ElrondRoleProvider"
 	evmCompatibleChainRoleProviderLogIdTemplate = "%sElrond-%sRoleProvider"
 	broadcasterLogIdTemplate                    = "%sElrond-Broadcaster"
+	balanceMonitorLogIdTemplate                 = "%sElrond-BalanceMonitor"
+	tokenRegistryLogIdTemplate                  = "%sElrond-TokenRegistry"
 )
 
 // Chain defines all the chain supported
@@ -80,3 +82,13 @@ func (c Chain) EvmCompatibleChainRoleProviderLogId() string {
 func (c Chain) BroadcasterLogId() string {
 	return fmt.Sprintf(broadcasterLogIdTemplate, c)
 }
+
+// BalanceMonitorLogId returns the string using chain value and balanceMonitorLogIdTemplate
+func (c Chain) BalanceMonitorLogId() string {
+	return fmt.Sprintf(balanceMonitorLogIdTemplate, c)
+}
+
+// TokenRegistryLogId returns the string using chain value and tokenRegistryLogIdTemplate
+func (c Chain) TokenRegistryLogId() string {
+	return fmt.Sprintf(tokenRegistryLogIdTemplate, c)
+}
@@ -51,6 +51,16 @@ func Test_broadcasterLogId(t *testing.T) {
 	assert.Equal(t, Bsc.BroadcasterLogId(), "BscElrond-Broadcaster")
 }
 
+func Test_balanceMonitorLogId(t *testing.T) {
+	assert.Equal(t, Ethereum.BalanceMonitorLogId(), "EthereumElrond-BalanceMonitor")
+	assert.Equal(t, Bsc.BalanceMonitorLogId(), "BscElrond-BalanceMonitor")
+}
+
+func Test_tokenRegistryLogId(t *testing.T) {
+	assert.Equal(t, Ethereum.TokenRegistryLogId(), "EthereumElrond-TokenRegistry")
+	assert.Equal(t, Bsc.TokenRegistryLogId(), "BscElrond-TokenRegistry")
+}
+
 func TestToLower(t *testing.T) {
 	assert.Equal(t, Elrond.ToLower(), "elrond")
 	assert.Equal(t, Ethereum.ToLower(), "ethereum")
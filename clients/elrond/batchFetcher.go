@@ -0,0 +1,110 @@
+package elrond
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const numFieldsForTransaction = 6
+
+// ArgsBatchFetcher is the DTO used in the batch fetcher constructor
+type ArgsBatchFetcher struct {
+	DataGetter       BatchDataGetter
+	AddressConverter bridgeCore.AddressConverter
+}
+
+type batchFetcher struct {
+	dataGetter       BatchDataGetter
+	addressConverter bridgeCore.AddressConverter
+}
+
+// NewBatchFetcher creates a component able to read the pending batch from the multisig contract, usually through
+// a proxy other than the one used by the client
+func NewBatchFetcher(args ArgsBatchFetcher) (*batchFetcher, error) {
+	if check.IfNil(args.DataGetter) {
+		return nil, clients.ErrNilDataGetter
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, clients.ErrNilAddressConverter
+	}
+
+	return &batchFetcher{
+		dataGetter:       args.DataGetter,
+		addressConverter: args.AddressConverter,
+	}, nil
+}
+
+// GetBatch returns the provided batch as stored in the multisig contract. Only the pending batch can be fetched.
+// The tokens are not converted and no status is set
+func (fetcher *batchFetcher) GetBatch(ctx context.Context, batchID uint64) (*clients.TransferBatch, error) {
+	responseData, err := fetcher.dataGetter.GetCurrentBatchAsDataBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if emptyResponse(responseData) {
+		return nil, ErrNoPendingBatchAvailable
+	}
+
+	batch, err := parseRawBatch(responseData, fetcher.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	if batch.ID != batchID {
+		return nil, fmt.Errorf("%w, pending batch ID: %d, requested batch ID: %d", errBatchNotPending, batch.ID, batchID)
+	}
+
+	return batch, nil
+}
+
+func parseRawBatch(responseData [][]byte, addressConverter bridgeCore.AddressConverter) (*clients.TransferBatch, error) {
+	dataLen := len(responseData)
+	haveCorrectNumberOfArgs := (dataLen-1)%numFieldsForTransaction == 0 && dataLen > 1
+	if !haveCorrectNumberOfArgs {
+		return nil, fmt.Errorf("%w, got %d argument(s)", errInvalidNumberOfArguments, dataLen)
+	}
+
+	batchID, err := parseUInt64FromByteSlice(responseData[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing batch ID", err)
+	}
+
+	numTransfers := (dataLen - 1) / numFieldsForTransaction
+	batch := &clients.TransferBatch{
+		ID:       batchID,
+		Deposits: make([]*clients.DepositTransfer, 0, numTransfers),
+		Statuses: make([]byte, numTransfers),
+	}
+
+	transferIndex := 0
+	for i := 1; i < dataLen; i += numFieldsForTransaction {
+		// blockNonce is the i-th element, let's ignore it for now
+		depositNonce, errParse := parseUInt64FromByteSlice(responseData[i+1])
+		if errParse != nil {
+			return nil, fmt.Errorf("%w while parsing the deposit nonce, transfer index %d", errParse, transferIndex)
+		}
+
+		batch.Deposits = append(batch.Deposits, &clients.DepositTransfer{
+			Nonce:            depositNonce,
+			FromBytes:        responseData[i+2],
+			DisplayableFrom:  addressConverter.ToBech32String(responseData[i+2]),
+			ToBytes:          responseData[i+3],
+			DisplayableTo:    addressConverter.ToHexStringWithPrefix(responseData[i+3]),
+			TokenBytes:       responseData[i+4],
+			DisplayableToken: string(responseData[i+4]),
+			Amount:           big.NewInt(0).SetBytes(responseData[i+5]),
+		})
+		transferIndex++
+	}
+
+	return batch, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fetcher *batchFetcher) IsInterfaceNil() bool {
+	return fetcher == nil
+}
@@ -0,0 +1,101 @@
+package elrond
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core/converters"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBatchFetcher() ArgsBatchFetcher {
+	addressConverter, _ := converters.NewAddressConverter()
+
+	return ArgsBatchFetcher{
+		DataGetter: &bridgeTests.DataGetterStub{
+			GetCurrentBatchAsDataBytesCalled: func(ctx context.Context) ([][]byte, error) {
+				return createMockPendingBatchBytes(2), nil
+			},
+		},
+		AddressConverter: addressConverter,
+	}
+}
+
+func TestNewBatchFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data getter should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		args.DataGetter = nil
+
+		fetcher, err := NewBatchFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.Equal(t, clients.ErrNilDataGetter, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		args.AddressConverter = nil
+
+		fetcher, err := NewBatchFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.Equal(t, clients.ErrNilAddressConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fetcher, err := NewBatchFetcher(createMockArgsBatchFetcher())
+		assert.False(t, check.IfNil(fetcher))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchFetcher_GetBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("data getter errors should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBatchFetcher()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetCurrentBatchAsDataBytesCalled: func(ctx context.Context) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		fetcher, _ := NewBatchFetcher(args)
+
+		batch, err := fetcher.GetBatch(context.Background(), 44562)
+		assert.Nil(t, batch)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("no pending batch should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		args.DataGetter = &bridgeTests.DataGetterStub{}
+		fetcher, _ := NewBatchFetcher(args)
+
+		batch, err := fetcher.GetBatch(context.Background(), 44562)
+		assert.Nil(t, batch)
+		assert.Equal(t, ErrNoPendingBatchAvailable, err)
+	})
+	t.Run("other pending batch should error", func(t *testing.T) {
+		fetcher, _ := NewBatchFetcher(createMockArgsBatchFetcher())
+
+		batch, err := fetcher.GetBatch(context.Background(), 44563)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errBatchNotPending))
+	})
+	t.Run("should work", func(t *testing.T) {
+		fetcher, _ := NewBatchFetcher(createMockArgsBatchFetcher())
+
+		batch, err := fetcher.GetBatch(context.Background(), 44562)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(44562), batch.ID)
+		require.Equal(t, 2, len(batch.Deposits))
+		assert.Equal(t, uint64(5001), batch.Deposits[1].Nonce)
+		assert.Equal(t, big.NewInt(20000), batch.Deposits[1].Amount)
+		assert.Nil(t, batch.Deposits[1].ConvertedTokenBytes)
+		assert.Equal(t, make([]byte, 2), batch.Statuses)
+	})
+}
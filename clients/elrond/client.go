@@ -2,8 +2,8 @@ package elrond
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/big"
 	"reflect"
 	"sync"
 	"time"
@@ -29,6 +29,7 @@ const (
 	signFuncName             = "sign"
 	performActionFuncName    = "performAction"
 	minAllowedDelta          = 1
+	percentDivider           = 100
 
 	elrondDataGetterLogId = "ElrondEth-ElrondDataGetter"
 )
@@ -44,7 +45,12 @@ type ClientArgs struct {
 	TokensMapper                 TokensMapper
 	RoleProvider                 roleProvider
 	StatusHandler                bridgeCore.StatusHandler
+	AlertHandler                 bridgeCore.AlertHandler
 	AllowDelta                   uint64
+	TransactionOutcomeConfig     config.ElrondTransactionOutcomeConfig
+	GasEstimationConfig          config.ElrondGasEstimationConfig
+	ContractProfile              ContractProfile
+	TokensPolicy                 TokensPolicy
 }
 
 // client represents the Elrond Client implementation
@@ -59,7 +65,19 @@ type client struct {
 	gasMapConfig              config.ElrondGasMapConfig
 	addressPublicKeyConverter bridgeCore.AddressConverter
 	statusHandler             bridgeCore.StatusHandler
+	alertHandler              bridgeCore.AlertHandler
 	allowDelta                uint64
+	outcomeTracker            outcomeTracker
+	gasEstimator              gasLimitEstimator
+	profile                   ContractProfile
+	tokensPolicy              TokensPolicy
+	outOfGasIncreasePercent   uint64
+	maxOutOfGasIncreases      uint64
+	maxGasLimit               uint64
+
+	mutGasIncreases   sync.Mutex
+	sentTransactions  map[string]string
+	outOfGasIncreases map[string]uint64
 
 	lastNonce                uint64
 	retriesAvailabilityCheck uint64
@@ -91,6 +109,7 @@ func NewClient(args ClientArgs) (*client, error) {
 		RelayerAddress:          relayerAddress,
 		Proxy:                   args.Proxy,
 		Log:                     bridgeCore.NewLoggerWithIdentifier(logger.GetOrCreate(elrondDataGetterLogId), elrondDataGetterLogId),
+		ContractProfile:         args.ContractProfile,
 	}
 	getter, err := NewDataGetter(argsDataGetter)
 	if err != nil {
@@ -102,6 +121,22 @@ func NewClient(args ClientArgs) (*client, error) {
 		return nil, clients.ErrNilAddressConverter
 	}
 
+	argsOutcomeTracker := ArgsTransactionOutcomeTracker{
+		Proxy:           args.Proxy,
+		Log:             args.Log,
+		PollingInterval: time.Millisecond * time.Duration(args.TransactionOutcomeConfig.PollingIntervalInMillis),
+		MaxWaitTime:     time.Second * time.Duration(args.TransactionOutcomeConfig.MaxWaitInSeconds),
+	}
+	tracker, err := NewTransactionOutcomeTracker(argsOutcomeTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	estimator, err := createGasEstimator(args, relayerAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &client{
 		txHandler: &transactionHandler{
 			proxy:                   args.Proxy,
@@ -121,16 +156,54 @@ func NewClient(args ClientArgs) (*client, error) {
 		addressPublicKeyConverter: addressConverter,
 		tokensMapper:              args.TokensMapper,
 		statusHandler:             args.StatusHandler,
+		alertHandler:              args.AlertHandler,
 		allowDelta:                args.AllowDelta,
+		outcomeTracker:            tracker,
+		gasEstimator:              estimator,
+		profile:                   args.ContractProfile,
+		tokensPolicy:              args.TokensPolicy,
+		outOfGasIncreasePercent:   args.TransactionOutcomeConfig.OutOfGasIncreasePercent,
+		maxOutOfGasIncreases:      args.TransactionOutcomeConfig.MaxOutOfGasIncreases,
+		maxGasLimit:               maxGasLimit(args.GasEstimationConfig),
+		sentTransactions:          make(map[string]string),
+		outOfGasIncreases:         make(map[string]uint64),
 	}
 
 	c.log.Info("NewElrondClient",
 		"relayer address", relayerAddress.AddressAsBech32String(),
-		"safe contract address", args.MultisigContractAddress.AddressAsBech32String())
+		"safe contract address", args.MultisigContractAddress.AddressAsBech32String(),
+		"contract profile", args.ContractProfile.Name())
 
 	return c, nil
 }
 
+func createGasEstimator(args ClientArgs, relayerAddress core.AddressHandler) (gasLimitEstimator, error) {
+	if !args.GasEstimationConfig.Enabled {
+		return &disabledGasEstimator{}, nil
+	}
+
+	argsGasEstimator := ArgsGasEstimator{
+		Proxy:                   args.Proxy,
+		Log:                     args.Log,
+		StatusHandler:           args.StatusHandler,
+		RelayerAddress:          relayerAddress,
+		MultisigContractAddress: args.MultisigContractAddress,
+		GasLimitMultiplier:      args.GasEstimationConfig.GasLimitMultiplier,
+		MaxGasLimit:             args.GasEstimationConfig.MaxGasLimit,
+	}
+
+	return NewGasEstimator(argsGasEstimator)
+}
+
+// maxGasLimit returns the gas limit ceiling, 0 meaning no ceiling as it is only enforced with the gas estimation enabled
+func maxGasLimit(cfg config.ElrondGasEstimationConfig) uint64 {
+	if !cfg.Enabled {
+		return 0
+	}
+
+	return cfg.MaxGasLimit
+}
+
 func checkArgs(args ClientArgs) error {
 	if check.IfNil(args.Proxy) {
 		return errNilProxy
@@ -153,6 +226,15 @@ func checkArgs(args ClientArgs) error {
 	if check.IfNil(args.StatusHandler) {
 		return clients.ErrNilStatusHandler
 	}
+	if check.IfNil(args.AlertHandler) {
+		return clients.ErrNilAlertHandler
+	}
+	if check.IfNil(args.ContractProfile) {
+		return errNilContractProfile
+	}
+	if check.IfNil(args.TokensPolicy) {
+		return clients.ErrNilTokensPolicy
+	}
 	if args.AllowDelta < minAllowedDelta {
 		return fmt.Errorf("%w for args.AllowedDelta, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.AllowDelta, minAllowedDelta)
@@ -198,46 +280,16 @@ func emptyResponse(response [][]byte) bool {
 }
 
 func (c *client) createPendingBatchFromResponse(ctx context.Context, responseData [][]byte) (*clients.TransferBatch, error) {
-	numFieldsForTransaction := 6
-	dataLen := len(responseData)
-	haveCorrectNumberOfArgs := (dataLen-1)%numFieldsForTransaction == 0 && dataLen > 1
-	if !haveCorrectNumberOfArgs {
-		return nil, fmt.Errorf("%w, got %d argument(s)", errInvalidNumberOfArguments, dataLen)
-	}
-
-	batchID, err := parseUInt64FromByteSlice(responseData[0])
+	batch, err := parseRawBatch(responseData, c.addressPublicKeyConverter)
 	if err != nil {
-		return nil, fmt.Errorf("%w while parsing batch ID", err)
-	}
-
-	batch := &clients.TransferBatch{
-		ID: batchID,
+		return nil, err
 	}
 
 	cachedTokens := make(map[string][]byte)
-	transferIndex := 0
-	for i := 1; i < dataLen; i += numFieldsForTransaction {
-		// blockNonce is the i-th element, let's ignore it for now
-		depositNonce, errParse := parseUInt64FromByteSlice(responseData[i+1])
-		if errParse != nil {
-			return nil, fmt.Errorf("%w while parsing the deposit nonce, transfer index %d", errParse, transferIndex)
-		}
-
-		amount := big.NewInt(0).SetBytes(responseData[i+5])
-		deposit := &clients.DepositTransfer{
-			Nonce:            depositNonce,
-			FromBytes:        responseData[i+2],
-			DisplayableFrom:  c.addressPublicKeyConverter.ToBech32String(responseData[i+2]),
-			ToBytes:          responseData[i+3],
-			DisplayableTo:    c.addressPublicKeyConverter.ToHexStringWithPrefix(responseData[i+3]),
-			TokenBytes:       responseData[i+4],
-			DisplayableToken: string(responseData[i+4]),
-			Amount:           amount,
-		}
-
+	for transferIndex, deposit := range batch.Deposits {
 		storedConvertedTokenBytes, exists := cachedTokens[deposit.DisplayableToken]
 		if !exists {
-			deposit.ConvertedTokenBytes, err = c.tokensMapper.ConvertToken(ctx, deposit.TokenBytes)
+			deposit.ConvertedTokenBytes, err = c.convertToken(ctx, deposit.TokenBytes)
 			if err != nil {
 				return nil, fmt.Errorf("%w while converting token bytes, transfer index %d", err, transferIndex)
 			}
@@ -246,17 +298,30 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 			deposit.ConvertedTokenBytes = storedConvertedTokenBytes
 		}
 
-		batch.Deposits = append(batch.Deposits, deposit)
-		transferIndex++
+		err = c.tokensPolicy.CheckElrondDeposit(deposit.TokenBytes, deposit.ConvertedTokenBytes, deposit.Amount)
+		if err != nil {
+			c.log.Warn("deposit rejected by the tokens policy", "batch ID", batch.ID,
+				"deposit nonce", deposit.Nonce, "reason", err)
+			batch.Statuses[transferIndex] = clients.Rejected
+		}
 	}
 
-	batch.Statuses = make([]byte, len(batch.Deposits))
-
 	c.log.Debug("created batch " + batch.String())
 
 	return batch, nil
 }
 
+// convertToken returns an empty converted token for the unknown tokens if the tokens policy is enforced, so the
+// deposits of unlisted tokens are rejected instead of stalling the batch
+func (c *client) convertToken(ctx context.Context, tokenBytes []byte) ([]byte, error) {
+	convertedTokenBytes, err := c.tokensMapper.ConvertToken(ctx, tokenBytes)
+	if errors.Is(err, clients.ErrUnknownToken) && c.tokensPolicy.IsEnforced() {
+		return make([]byte, 0), nil
+	}
+
+	return convertedTokenBytes, err
+}
+
 func (c *client) createCommonTxDataBuilder(funcName string, id int64) builders.TxDataBuilder {
 	return builders.NewTxDataBuilder().Function(funcName).ArgInt64(id)
 }
@@ -272,13 +337,14 @@ func (c *client) ProposeSetStatus(ctx context.Context, batch *clients.TransferBa
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeSetStatusFuncName, int64(batch.ID))
+	funcName := c.profile.Endpoint(operationProposeSetStatus)
+	txBuilder := c.createCommonTxDataBuilder(funcName, int64(batch.ID))
 	for _, stat := range batch.Statuses {
 		txBuilder.ArgBytes([]byte{stat})
 	}
 
 	gasLimit := c.gasMapConfig.ProposeStatusBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeStatusForEach
-	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
+	hash, err := c.sendTransaction(ctx, funcName, txBuilder, gasLimit)
 	if err == nil {
 		c.log.Info("proposed set statuses"+batch.String(), "transaction hash", hash)
 	}
@@ -297,18 +363,21 @@ func (c *client) ProposeTransfer(ctx context.Context, batch *clients.TransferBat
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeTransferFuncName, int64(batch.ID))
+	funcName := c.profile.Endpoint(operationProposeTransfer)
+	txBuilder := c.createCommonTxDataBuilder(funcName, int64(batch.ID))
 
 	for _, dt := range batch.Deposits {
-		txBuilder.ArgBytes(dt.FromBytes).
-			ArgBytes(dt.ToBytes).
-			ArgBytes(dt.ConvertedTokenBytes).
-			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce))
+		arguments, errArguments := c.profile.DepositArguments(dt)
+		if errArguments != nil {
+			return "", errArguments
+		}
+		for _, argument := range arguments {
+			txBuilder.ArgHexString(argument)
+		}
 	}
 
 	gasLimit := c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
-	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
+	hash, err := c.sendTransaction(ctx, funcName, txBuilder, gasLimit)
 	if err == nil {
 		c.log.Info("proposed transfer"+batch.String(), "transaction hash", hash)
 	}
@@ -323,9 +392,10 @@ func (c *client) Sign(ctx context.Context, actionID uint64) (string, error) {
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(signFuncName, int64(actionID))
+	funcName := c.profile.Endpoint(operationSign)
+	txBuilder := c.createCommonTxDataBuilder(funcName, int64(actionID))
 
-	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, c.gasMapConfig.Sign)
+	hash, err := c.sendTransaction(ctx, funcName, txBuilder, c.gasMapConfig.Sign)
 	if err == nil {
 		c.log.Info("signed", "action ID", actionID, "transaction hash", hash)
 	}
@@ -344,10 +414,11 @@ func (c *client) PerformAction(ctx context.Context, actionID uint64, batch *clie
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(performActionFuncName, int64(actionID))
+	funcName := c.profile.Endpoint(operationPerformAction)
+	txBuilder := c.createCommonTxDataBuilder(funcName, int64(actionID))
 
 	gasLimit := c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach
-	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
+	hash, err := c.sendTransaction(ctx, funcName, txBuilder, gasLimit)
 
 	if err == nil {
 		c.log.Info("performed action", "actionID", actionID, "transaction hash", hash)
@@ -356,6 +427,59 @@ func (c *client) PerformAction(ctx context.Context, actionID uint64, batch *clie
 	return hash, err
 }
 
+// sendTransaction sends the transaction with the estimated gas limit, falling back to the configured one. The gas
+// limit is increased if the previous transactions calling the same function ran out of gas, without exceeding the
+// maximum gas limit
+func (c *client) sendTransaction(ctx context.Context, funcName string, txBuilder builders.TxDataBuilder, configuredGasLimit uint64) (string, error) {
+	gasLimit := c.gasEstimator.EstimateGasLimit(ctx, txBuilder, configuredGasLimit)
+
+	c.mutGasIncreases.Lock()
+	gasLimit += gasLimit * c.outOfGasIncreasePercent * c.outOfGasIncreases[funcName] / percentDivider
+	c.mutGasIncreases.Unlock()
+	if c.maxGasLimit > 0 && gasLimit > c.maxGasLimit {
+		gasLimit = c.maxGasLimit
+	}
+
+	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
+	if err != nil {
+		return "", err
+	}
+
+	c.mutGasIncreases.Lock()
+	c.sentTransactions[hash] = funcName
+	c.mutGasIncreases.Unlock()
+
+	return hash, nil
+}
+
+// WaitForTransactionOutcome waits until the provided transaction is executed and returns its decoded outcome. The gas
+// limit for the transaction's function is increased after each out of gas outcome and restored after a successful one
+func (c *client) WaitForTransactionOutcome(ctx context.Context, hash string) error {
+	err := c.outcomeTracker.WaitForOutcome(ctx, hash)
+
+	c.mutGasIncreases.Lock()
+	defer c.mutGasIncreases.Unlock()
+
+	funcName, found := c.sentTransactions[hash]
+	delete(c.sentTransactions, hash)
+	if !found {
+		return err
+	}
+
+	switch {
+	case err == nil:
+		delete(c.outOfGasIncreases, funcName)
+	case errors.Is(err, clients.ErrTransactionOutOfGas):
+		if c.outOfGasIncreases[funcName] < c.maxOutOfGasIncreases {
+			c.outOfGasIncreases[funcName]++
+			c.log.Warn("increasing the gas limit for the next transactions", "function", funcName,
+				"increase percent", c.outOfGasIncreasePercent*c.outOfGasIncreases[funcName])
+		}
+	}
+
+	return err
+}
+
 func (c *client) checkIsPaused(ctx context.Context) error {
 	isPaused, err := c.IsPaused(ctx)
 	if err != nil {
@@ -408,6 +532,15 @@ func (c *client) setStatusForAvailabilityCheck(status ethElrond.ClientStatus, me
 	c.statusHandler.SetStringMetric(bridgeCore.MetricElrondClientStatus, status.String())
 	c.statusHandler.SetStringMetric(bridgeCore.MetricLastElrondClientError, message)
 	c.statusHandler.SetIntMetric(bridgeCore.MetricLastBlockNonce, int(nonce))
+
+	if status == ethElrond.Unavailable {
+		c.alertHandler.Alert(bridgeCore.Alert{
+			Type:     bridgeCore.AlertClientUnavailable,
+			Severity: bridgeCore.AlertSeverityWarning,
+			Source:   c.statusHandler.Name(),
+			Message:  message,
+		})
+	}
 }
 
 // Close will close any started go routines. It returns nil.
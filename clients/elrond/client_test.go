@@ -58,7 +58,16 @@ func createMockClientArgs() ClientArgs {
 		},
 		RoleProvider:  &roleProviders.ElrondRoleProviderStub{},
 		StatusHandler: &testsCommon.StatusHandlerStub{},
+		AlertHandler:  &testsCommon.AlertHandlerStub{},
 		AllowDelta:    5,
+		TransactionOutcomeConfig: config.ElrondTransactionOutcomeConfig{
+			PollingIntervalInMillis: 100,
+			MaxWaitInSeconds:        1,
+			OutOfGasIncreasePercent: 20,
+			MaxOutOfGasIncreases:    2,
+		},
+		ContractProfile: NewDefaultContractProfile(),
+		TokensPolicy:    &bridgeTests.TokensPolicyStub{},
 	}
 }
 
@@ -191,6 +200,39 @@ func TestNewClient(t *testing.T) {
 		require.True(t, check.IfNil(c))
 		require.Equal(t, clients.ErrNilStatusHandler, err)
 	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.AlertHandler = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, clients.ErrNilAlertHandler, err)
+	})
+	t.Run("nil contract profile should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.ContractProfile = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, errNilContractProfile, err)
+	})
+	t.Run("nil tokens policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.TokensPolicy = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, clients.ErrNilTokensPolicy, err)
+	})
 	t.Run("invalid AllowDelta should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -203,6 +245,33 @@ func TestNewClient(t *testing.T) {
 		require.True(t, errors.Is(err, clients.ErrInvalidValue))
 		require.True(t, strings.Contains(err.Error(), "for args.AllowedDelta"))
 	})
+	t.Run("invalid gas estimation config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.GasEstimationConfig = config.ElrondGasEstimationConfig{
+			Enabled:            true,
+			GasLimitMultiplier: 1.2,
+		}
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.True(t, errors.Is(err, clients.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "MaxGasLimit"))
+	})
+	t.Run("invalid transaction outcome config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.TransactionOutcomeConfig.PollingIntervalInMillis = 0
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.True(t, errors.Is(err, errInvalidDuration))
+		require.True(t, strings.Contains(err.Error(), "PollingInterval"))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -317,6 +386,40 @@ func TestClient_GetPending(t *testing.T) {
 		assert.True(t, errors.Is(err, expectedErr))
 		assert.True(t, strings.Contains(err.Error(), "while converting token bytes, transfer index 0"))
 	})
+	t.Run("deposits not complying with the tokens policy should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		tokenBytes1 := bytes.Repeat([]byte{3}, 32)
+		args.TokensMapper = &bridgeTests.TokensMapperStub{
+			ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+				if bytes.Equal(sourceBytes, tokenBytes1) {
+					return nil, fmt.Errorf("%w for provided token", clients.ErrUnknownToken)
+				}
+				return append([]byte("converted_"), sourceBytes...), nil
+			},
+		}
+		args.TokensPolicy = &bridgeTests.TokensPolicyStub{
+			IsEnforcedCalled: func() bool {
+				return true
+			},
+			CheckElrondDepositCalled: func(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error {
+				if len(erc20Address) == 0 {
+					return errors.New("token not listed")
+				}
+				return nil
+			},
+		}
+		args.Proxy = createMockProxy(createMockPendingBatchBytes(2))
+
+		c, _ := NewClient(args)
+		batch, err := c.GetPending(context.Background())
+
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(batch.Deposits))
+		assert.Empty(t, batch.Deposits[0].ConvertedTokenBytes)
+		assert.Equal(t, []byte{clients.Rejected, 0}, batch.Statuses)
+	})
 	t.Run("should create pending batch", func(t *testing.T) {
 		t.Parallel()
 
@@ -519,6 +622,7 @@ func TestClient_ProposeTransfer(t *testing.T) {
 		c, _ := NewClient(args)
 		sendWasCalled := false
 		batch := createMockBatch()
+		batch.Statuses = make([]byte, len(batch.Deposits))
 
 		c.txHandler = &bridgeTests.TxHandlerStub{
 			SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
@@ -720,6 +824,111 @@ func TestClient_PerformAction(t *testing.T) {
 	})
 }
 
+func TestClient_WaitForTransactionOutcome(t *testing.T) {
+	t.Parallel()
+
+	args := createMockClientArgs()
+	args.Proxy = createMockProxy(make([][]byte, 0))
+	c, _ := NewClient(args)
+
+	sentGasLimits := make([]uint64, 0)
+	c.txHandler = &bridgeTests.TxHandlerStub{
+		SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+			sentGasLimits = append(sentGasLimits, gasLimit)
+			return fmt.Sprintf("hash %d", len(sentGasLimits)), nil
+		},
+	}
+	var outcomeErr error
+	c.outcomeTracker = &bridgeTests.OutcomeTrackerStub{
+		WaitForOutcomeCalled: func(ctx context.Context, hash string) error {
+			return outcomeErr
+		},
+	}
+
+	outcomeErr = newTransactionOutcomeError(clients.ErrTransactionOutOfGas, "hash", outOfGasReturnCode, "not enough gas")
+	for i := 0; i < 3; i++ {
+		hash, _ := c.Sign(context.Background(), 1)
+		err := c.WaitForTransactionOutcome(context.Background(), hash)
+		assert.True(t, errors.Is(err, clients.ErrTransactionOutOfGas))
+	}
+	outcomeErr = nil
+	hash, _ := c.Sign(context.Background(), 1)
+	err := c.WaitForTransactionOutcome(context.Background(), hash)
+	assert.Nil(t, err)
+	_, _ = c.Sign(context.Background(), 1)
+
+	signGas := args.GasMapConfig.Sign
+	expectedGasLimits := []uint64{signGas, signGas * 120 / 100, signGas * 140 / 100, signGas * 140 / 100, signGas}
+	assert.Equal(t, expectedGasLimits, sentGasLimits)
+	assert.Equal(t, 1, len(c.sentTransactions))
+}
+
+func TestClient_SendTransactionWithEstimatedGas(t *testing.T) {
+	t.Parallel()
+
+	args := createMockClientArgs()
+	args.GasEstimationConfig = config.ElrondGasEstimationConfig{
+		Enabled:            true,
+		GasLimitMultiplier: 1.5,
+		MaxGasLimit:        1000,
+	}
+	proxy := createMockProxy(make([][]byte, 0))
+	proxy.RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+		return &data.TxCostResponseData{TxCost: 400}, nil
+	}
+	args.Proxy = proxy
+	c, _ := NewClient(args)
+
+	sentGasLimit := uint64(0)
+	c.txHandler = &bridgeTests.TxHandlerStub{
+		SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+			sentGasLimit = gasLimit
+			return "hash", nil
+		},
+	}
+
+	_, err := c.Sign(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(600), sentGasLimit)
+}
+
+func TestClient_SendTransactionWithIncreasedGasShouldNotExceedMaxGasLimit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockClientArgs()
+	args.GasEstimationConfig = config.ElrondGasEstimationConfig{
+		Enabled:            true,
+		GasLimitMultiplier: 2,
+		MaxGasLimit:        1000,
+	}
+	proxy := createMockProxy(make([][]byte, 0))
+	proxy.RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+		return &data.TxCostResponseData{TxCost: 450}, nil
+	}
+	args.Proxy = proxy
+	c, _ := NewClient(args)
+
+	sentGasLimits := make([]uint64, 0)
+	c.txHandler = &bridgeTests.TxHandlerStub{
+		SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+			sentGasLimits = append(sentGasLimits, gasLimit)
+			return fmt.Sprintf("hash %d", len(sentGasLimits)), nil
+		},
+	}
+	c.outcomeTracker = &bridgeTests.OutcomeTrackerStub{
+		WaitForOutcomeCalled: func(ctx context.Context, hash string) error {
+			return newTransactionOutcomeError(clients.ErrTransactionOutOfGas, hash, outOfGasReturnCode, "not enough gas")
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		hash, _ := c.Sign(context.Background(), 1)
+		_ = c.WaitForTransactionOutcome(context.Background(), hash)
+	}
+
+	assert.Equal(t, []uint64{900, 1000, 1000}, sentGasLimits)
+}
+
 func TestClient_Close(t *testing.T) {
 	t.Parallel()
 
@@ -830,10 +1039,19 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 				return nil, expectedErr
 			},
 		}
+		var receivedAlert bridgeCore.Alert
+		c.alertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert bridgeCore.Alert) {
+				receivedAlert = alert
+			},
+		}
 
 		err := c.CheckClientAvailability(context.Background())
 		checkStatusHandler(t, statusHandler, ethElrond.Unavailable, expectedErr.Error())
 		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, bridgeCore.AlertClientUnavailable, receivedAlert.Type)
+		assert.Equal(t, expectedErr.Error(), receivedAlert.Message)
+		assert.Equal(t, "test", receivedAlert.Source)
 	})
 }
 
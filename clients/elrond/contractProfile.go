@@ -0,0 +1,225 @@
+package elrond
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+)
+
+const defaultContractProfileName = "v1"
+
+// logical operations of the multisig contract, used as keys in the profile's endpoints map
+const (
+	operationGetCurrentTxBatch                                 = "GetCurrentTxBatch"
+	operationWasTransferActionProposed                         = "WasTransferActionProposed"
+	operationWasActionExecuted                                 = "WasActionExecuted"
+	operationGetActionIdForTransferBatch                       = "GetActionIdForTransferBatch"
+	operationWasSetCurrentTransactionBatchStatusActionProposed = "WasSetCurrentTransactionBatchStatusActionProposed"
+	operationGetStatusesAfterExecution                         = "GetStatusesAfterExecution"
+	operationGetActionIdForSetCurrentTransactionBatchStatus    = "GetActionIdForSetCurrentTransactionBatchStatus"
+	operationGetTokenIdForErc20Address                         = "GetTokenIdForErc20Address"
+	operationGetErc20AddressForTokenId                         = "GetErc20AddressForTokenId"
+	operationQuorumReached                                     = "QuorumReached"
+	operationGetLastExecutedEthBatchId                         = "GetLastExecutedEthBatchId"
+	operationGetLastExecutedEthTxId                            = "GetLastExecutedEthTxId"
+	operationSigned                                            = "Signed"
+	operationGetAllStakedRelayers                              = "GetAllStakedRelayers"
+	operationIsPaused                                          = "IsPaused"
+	operationProposeTransfer                                   = "ProposeTransfer"
+	operationProposeSetStatus                                  = "ProposeSetStatus"
+	operationSign                                              = "Sign"
+	operationPerformAction                                     = "PerformAction"
+)
+
+// fields of a deposit that can be used in the profile's deposit arguments layout
+const (
+	depositArgumentFrom        = "from"
+	depositArgumentTo          = "to"
+	depositArgumentToken       = "token"
+	depositArgumentSourceToken = "sourceToken"
+	depositArgumentAmount      = "amount"
+	depositArgumentNonce       = "nonce"
+)
+
+var defaultEndpoints = map[string]string{
+	operationGetCurrentTxBatch:                                 getCurrentTxBatchFuncName,
+	operationWasTransferActionProposed:                         wasTransferActionProposedFuncName,
+	operationWasActionExecuted:                                 wasActionExecutedFuncName,
+	operationGetActionIdForTransferBatch:                       getActionIdForTransferBatchFuncName,
+	operationWasSetCurrentTransactionBatchStatusActionProposed: wasSetCurrentTransactionBatchStatusActionProposedFuncName,
+	operationGetStatusesAfterExecution:                         getStatusesAfterExecutionFuncName,
+	operationGetActionIdForSetCurrentTransactionBatchStatus:    getActionIdForSetCurrentTransactionBatchStatusFuncName,
+	operationGetTokenIdForErc20Address:                         getTokenIdForErc20AddressFuncName,
+	operationGetErc20AddressForTokenId:                         getErc20AddressForTokenIdFuncName,
+	operationQuorumReached:                                     quorumReachedFuncName,
+	operationGetLastExecutedEthBatchId:                         getLastExecutedEthBatchIdFuncName,
+	operationGetLastExecutedEthTxId:                            getLastExecutedEthTxId,
+	operationSigned:                                            signedFuncName,
+	operationGetAllStakedRelayers:                              getAllStakedRelayersFuncName,
+	operationIsPaused:                                          isPausedFuncName,
+	operationProposeTransfer:                                   proposeTransferFuncName,
+	operationProposeSetStatus:                                  proposeSetStatusFuncName,
+	operationSign:                                              signFuncName,
+	operationPerformAction:                                     performActionFuncName,
+}
+
+var defaultDepositArguments = []string{
+	depositArgumentFrom,
+	depositArgumentTo,
+	depositArgumentToken,
+	depositArgumentAmount,
+	depositArgumentNonce,
+}
+
+var knownDepositArguments = map[string]bool{
+	depositArgumentFrom:        true,
+	depositArgumentTo:          true,
+	depositArgumentToken:       true,
+	depositArgumentSourceToken: true,
+	depositArgumentAmount:      true,
+	depositArgumentNonce:       true,
+}
+
+type contractProfile struct {
+	name             string
+	versionEndpoint  string
+	version          string
+	endpoints        map[string]string
+	depositArguments []string
+}
+
+// NewDefaultContractProfile creates the profile of the current multisig contract version
+func NewDefaultContractProfile() *contractProfile {
+	return &contractProfile{
+		name:             defaultContractProfileName,
+		endpoints:        copyEndpoints(defaultEndpoints),
+		depositArguments: defaultDepositArguments,
+	}
+}
+
+// NewContractProfile creates a contract profile from the provided config. The operations not defined in the config
+// use the endpoint names of the default profile and an empty deposit arguments layout means the default one
+func NewContractProfile(cfg config.ElrondContractProfileConfig) (*contractProfile, error) {
+	err := checkContractProfileConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &contractProfile{
+		name:             cfg.Name,
+		versionEndpoint:  cfg.VersionEndpoint,
+		version:          cfg.Version,
+		endpoints:        copyEndpoints(defaultEndpoints),
+		depositArguments: defaultDepositArguments,
+	}
+	for operation, endpoint := range cfg.Endpoints {
+		profile.endpoints[operation] = endpoint
+	}
+	if len(cfg.DepositArguments) > 0 {
+		profile.depositArguments = append(make([]string, 0, len(cfg.DepositArguments)), cfg.DepositArguments...)
+	}
+
+	return profile, nil
+}
+
+func checkContractProfileConfig(cfg config.ElrondContractProfileConfig) error {
+	if len(cfg.Name) == 0 {
+		return fmt.Errorf("%w, empty name", errInvalidContractProfile)
+	}
+	if len(cfg.VersionEndpoint) > 0 && len(cfg.Version) == 0 {
+		return fmt.Errorf("%w, profile %s defines a version endpoint without a version", errInvalidContractProfile, cfg.Name)
+	}
+	for operation, endpoint := range cfg.Endpoints {
+		_, isKnown := defaultEndpoints[operation]
+		if !isKnown {
+			return fmt.Errorf("%w, profile %s defines the unknown operation %s", errInvalidContractProfile, cfg.Name, operation)
+		}
+		if len(endpoint) == 0 {
+			return fmt.Errorf("%w, profile %s defines an empty endpoint for operation %s", errInvalidContractProfile, cfg.Name, operation)
+		}
+	}
+	if len(cfg.DepositArguments) == 0 {
+		return nil
+	}
+
+	usedArguments := make(map[string]bool)
+	for _, argument := range cfg.DepositArguments {
+		if !knownDepositArguments[argument] {
+			return fmt.Errorf("%w, profile %s defines the unknown deposit argument %s", errInvalidContractProfile, cfg.Name, argument)
+		}
+		if usedArguments[argument] {
+			return fmt.Errorf("%w, profile %s defines the deposit argument %s more than once", errInvalidContractProfile, cfg.Name, argument)
+		}
+		usedArguments[argument] = true
+	}
+	for _, argument := range defaultDepositArguments {
+		if !usedArguments[argument] {
+			return fmt.Errorf("%w, profile %s is missing the deposit argument %s", errInvalidContractProfile, cfg.Name, argument)
+		}
+	}
+
+	return nil
+}
+
+func copyEndpoints(endpoints map[string]string) map[string]string {
+	result := make(map[string]string, len(endpoints))
+	for operation, endpoint := range endpoints {
+		result[operation] = endpoint
+	}
+
+	return result
+}
+
+// Name returns the profile's name
+func (profile *contractProfile) Name() string {
+	return profile.name
+}
+
+// Endpoint returns the contract endpoint name of the provided logical operation
+func (profile *contractProfile) Endpoint(operation string) string {
+	return profile.endpoints[operation]
+}
+
+// DepositArguments returns the hex encoded arguments of the provided deposit, in the profile's layout
+func (profile *contractProfile) DepositArguments(deposit *clients.DepositTransfer) ([]string, error) {
+	if deposit.Amount == nil {
+		return nil, fmt.Errorf("%w for deposit nonce %d", errNilAmount, deposit.Nonce)
+	}
+
+	arguments := make([]string, 0, len(profile.depositArguments))
+	for _, argument := range profile.depositArguments {
+		switch argument {
+		case depositArgumentFrom:
+			arguments = append(arguments, encodeArgument(deposit.FromBytes))
+		case depositArgumentTo:
+			arguments = append(arguments, encodeArgument(deposit.ToBytes))
+		case depositArgumentToken:
+			arguments = append(arguments, encodeArgument(deposit.ConvertedTokenBytes))
+		case depositArgumentSourceToken:
+			arguments = append(arguments, encodeArgument(deposit.TokenBytes))
+		case depositArgumentAmount:
+			arguments = append(arguments, encodeArgument(deposit.Amount.Bytes()))
+		case depositArgumentNonce:
+			arguments = append(arguments, encodeArgument(big.NewInt(0).SetUint64(deposit.Nonce).Bytes()))
+		}
+	}
+
+	return arguments, nil
+}
+
+// encodeArgument hex encodes the argument the same way the erdgo builders do, an empty value being encoded as 00
+func encodeArgument(buff []byte) string {
+	if len(buff) == 0 {
+		buff = []byte{0}
+	}
+
+	return hex.EncodeToString(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (profile *contractProfile) IsInterfaceNil() bool {
+	return profile == nil
+}
@@ -0,0 +1,156 @@
+package elrond
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+)
+
+// AutoDetectContractProfile is the profile name that selects the contract profile by querying the deployed contract
+const AutoDetectContractProfile = "auto"
+
+// ArgsContractProfileSelector is the DTO used in the SelectContractProfile function
+type ArgsContractProfileSelector struct {
+	Proxy                   ElrondProxy
+	Log                     logger.Logger
+	MultisigContractAddress core.AddressHandler
+	ProfileName             string
+	Profiles                []config.ElrondContractProfileConfig
+}
+
+// SelectContractProfile returns the configured contract profile with the provided name. If the name is empty or
+// AutoDetectContractProfile, the profiles defining a version endpoint are queried in order and the first one
+// reporting its version is returned. If none matches, the first profile without a version endpoint is returned.
+// A version query failing before reaching the contract is returned as error so a transient failure can not select
+// the fallback profile. The default profile is used when no profiles are configured
+func SelectContractProfile(ctx context.Context, args ArgsContractProfileSelector) (ContractProfile, error) {
+	err := checkArgsContractProfileSelector(args)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := createContractProfiles(args.Profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args.ProfileName) > 0 && args.ProfileName != AutoDetectContractProfile {
+		for _, profile := range profiles {
+			if profile.name == args.ProfileName {
+				args.Log.Info("using the configured multisig contract profile", "profile", profile.name)
+				return profile, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w, name: %s", errContractProfileNotFound, args.ProfileName)
+	}
+
+	return detectContractProfile(ctx, args, profiles)
+}
+
+func checkArgsContractProfileSelector(args ArgsContractProfileSelector) error {
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.MultisigContractAddress) {
+		return fmt.Errorf("%w for the MultisigContractAddress argument", errNilAddressHandler)
+	}
+
+	return nil
+}
+
+func createContractProfiles(profilesConfig []config.ElrondContractProfileConfig) ([]*contractProfile, error) {
+	if len(profilesConfig) == 0 {
+		return []*contractProfile{NewDefaultContractProfile()}, nil
+	}
+
+	profiles := make([]*contractProfile, 0, len(profilesConfig))
+	names := make(map[string]bool)
+	for _, cfg := range profilesConfig {
+		if names[cfg.Name] {
+			return nil, fmt.Errorf("%w, duplicated name %s", errInvalidContractProfile, cfg.Name)
+		}
+		names[cfg.Name] = true
+
+		profile, err := NewContractProfile(cfg)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+func detectContractProfile(ctx context.Context, args ArgsContractProfileSelector, profiles []*contractProfile) (ContractProfile, error) {
+	for _, profile := range profiles {
+		if len(profile.versionEndpoint) == 0 {
+			continue
+		}
+
+		version, err := queryContractVersion(ctx, args, profile.versionEndpoint)
+		var contractErr *queryResponseError
+		if errors.As(err, &contractErr) {
+			args.Log.Warn("the contract rejected the version query", "profile", profile.name,
+				"endpoint", profile.versionEndpoint, "error", err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w while querying the contract version for the %s profile", err, profile.name)
+		}
+		if version == profile.version {
+			args.Log.Info("detected the multisig contract profile", "profile", profile.name, "version", version)
+			return profile, nil
+		}
+
+		args.Log.Debug("contract version does not match", "profile", profile.name,
+			"expected", profile.version, "got", version)
+	}
+
+	for _, profile := range profiles {
+		if len(profile.versionEndpoint) == 0 {
+			args.Log.Warn("no versioned multisig contract profile matched, using the fallback profile", "profile", profile.name)
+			return profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w, no profile matches the deployed contract", errContractProfileNotFound)
+}
+
+func queryContractVersion(ctx context.Context, args ArgsContractProfileSelector, versionEndpoint string) (string, error) {
+	request, err := builders.NewVMQueryBuilder().
+		Address(args.MultisigContractAddress).
+		Function(versionEndpoint).
+		ToVmValueRequest()
+	if err != nil {
+		return "", err
+	}
+
+	response, err := args.Proxy.ExecuteVMQuery(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if response.Data.ReturnCode != okCodeAfterExecution {
+		return "", NewQueryResponseError(
+			response.Data.ReturnCode,
+			response.Data.ReturnMessage,
+			request.FuncName,
+			request.Address,
+			request.Args...,
+		)
+	}
+	if len(response.Data.ReturnData) == 0 {
+		return "", nil
+	}
+
+	return string(response.Data.ReturnData[0]), nil
+}
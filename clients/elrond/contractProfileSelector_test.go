@@ -0,0 +1,182 @@
+package elrond
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsContractProfileSelector() ArgsContractProfileSelector {
+	multisig, _ := data.NewAddressFromBech32String(testMultisigAddress)
+
+	return ArgsContractProfileSelector{
+		Proxy:                   &interactors.ElrondProxyStub{},
+		Log:                     logger.GetOrCreate("test"),
+		MultisigContractAddress: multisig,
+		ProfileName:             AutoDetectContractProfile,
+		Profiles: []config.ElrondContractProfileConfig{
+			{
+				Name: "v1",
+			},
+			createMockContractProfileConfig(),
+		},
+	}
+}
+
+func createVersionProxy(returnCode string, version string, numCalls *int) *interactors.ElrondProxyStub {
+	return &interactors.ElrondProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			*numCalls++
+			if vmRequest.FuncName != "getVersion" {
+				return nil, errors.New("unexpected function " + vmRequest.FuncName)
+			}
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: returnCode,
+					ReturnData: [][]byte{[]byte(version)},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestSelectContractProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Proxy = nil
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.Equal(t, errNilProxy, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Log = nil
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil multisig contract address should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.MultisigContractAddress = nil
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errNilAddressHandler))
+		assert.True(t, strings.Contains(err.Error(), "MultisigContractAddress"))
+	})
+	t.Run("invalid profile should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Profiles[1].DepositArguments = []string{"from"}
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+	})
+	t.Run("duplicated profile names should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Profiles[1].Name = "v1"
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "duplicated name v1"))
+	})
+	t.Run("unknown profile name should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.ProfileName = "v3"
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errContractProfileNotFound))
+	})
+	t.Run("no configured profiles should return the default profile without querying", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Profiles = nil
+		args.ProfileName = ""
+		numCalls := 0
+		args.Proxy = createVersionProxy(okCodeAfterExecution, "2", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		require.Nil(t, err)
+		assert.Equal(t, defaultContractProfileName, profile.Name())
+		assert.Equal(t, 0, numCalls)
+	})
+	t.Run("configured profile name should not query", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.ProfileName = "v2"
+		numCalls := 0
+		args.Proxy = createVersionProxy(okCodeAfterExecution, "1", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		require.Nil(t, err)
+		assert.Equal(t, "v2", profile.Name())
+		assert.Equal(t, 0, numCalls)
+	})
+	t.Run("auto should detect the matching version", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		numCalls := 0
+		args.Proxy = createVersionProxy(okCodeAfterExecution, "2", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		require.Nil(t, err)
+		assert.Equal(t, "v2", profile.Name())
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("auto should fall back on version mismatch", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		numCalls := 0
+		args.Proxy = createVersionProxy(okCodeAfterExecution, "3", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		require.Nil(t, err)
+		assert.Equal(t, "v1", profile.Name())
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("auto should fall back if the version endpoint does not exist", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		numCalls := 0
+		args.Proxy = createVersionProxy("function not found", "", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		require.Nil(t, err)
+		assert.Equal(t, "v1", profile.Name())
+	})
+	t.Run("auto should error if the version query fails before reaching the contract", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		expectedErr := errors.New("expected error")
+		args.Proxy = &interactors.ElrondProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("auto without a fallback profile should error", func(t *testing.T) {
+		args := createMockArgsContractProfileSelector()
+		args.Profiles = args.Profiles[1:]
+		numCalls := 0
+		args.Proxy = createVersionProxy(okCodeAfterExecution, "3", &numCalls)
+
+		profile, err := SelectContractProfile(context.Background(), args)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errContractProfileNotFound))
+	})
+}
@@ -0,0 +1,157 @@
+package elrond
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockContractProfileConfig() config.ElrondContractProfileConfig {
+	return config.ElrondContractProfileConfig{
+		Name:             "v2",
+		VersionEndpoint:  "getVersion",
+		Version:          "2",
+		DepositArguments: []string{"nonce", "from", "to", "token", "amount", "sourceToken"},
+		Endpoints: map[string]string{
+			operationProposeTransfer: "proposeTransfer",
+		},
+	}
+}
+
+func createMockDeposit() *clients.DepositTransfer {
+	return &clients.DepositTransfer{
+		Nonce:               0,
+		ToBytes:             []byte("to"),
+		FromBytes:           []byte("from"),
+		TokenBytes:          []byte("erc20"),
+		ConvertedTokenBytes: []byte("esdt"),
+		Amount:              big.NewInt(1000),
+	}
+}
+
+func TestNewContractProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.Name = ""
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+	})
+	t.Run("version endpoint without version should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.Version = ""
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "without a version"))
+	})
+	t.Run("unknown operation should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.Endpoints["Unknown"] = "unknown"
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "unknown operation Unknown"))
+	})
+	t.Run("empty endpoint should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.Endpoints[operationSign] = ""
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "empty endpoint for operation Sign"))
+	})
+	t.Run("unknown deposit argument should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.DepositArguments = append(cfg.DepositArguments, "data")
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "unknown deposit argument data"))
+	})
+	t.Run("duplicated deposit argument should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.DepositArguments = append(cfg.DepositArguments, depositArgumentNonce)
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "more than once"))
+	})
+	t.Run("missing deposit argument should error", func(t *testing.T) {
+		cfg := createMockContractProfileConfig()
+		cfg.DepositArguments = []string{"from", "to", "token", "amount"}
+
+		profile, err := NewContractProfile(cfg)
+		assert.True(t, check.IfNil(profile))
+		assert.True(t, errors.Is(err, errInvalidContractProfile))
+		assert.True(t, strings.Contains(err.Error(), "missing the deposit argument nonce"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		profile, err := NewContractProfile(createMockContractProfileConfig())
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(profile))
+		assert.Equal(t, "v2", profile.Name())
+		assert.Equal(t, "proposeTransfer", profile.Endpoint(operationProposeTransfer))
+		assert.Equal(t, getCurrentTxBatchFuncName, profile.Endpoint(operationGetCurrentTxBatch))
+	})
+}
+
+func TestContractProfile_DepositArguments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil amount should error", func(t *testing.T) {
+		deposit := createMockDeposit()
+		deposit.Amount = nil
+
+		arguments, err := NewDefaultContractProfile().DepositArguments(deposit)
+		assert.Nil(t, arguments)
+		assert.True(t, errors.Is(err, errNilAmount))
+	})
+	t.Run("default profile", func(t *testing.T) {
+		arguments, err := NewDefaultContractProfile().DepositArguments(createMockDeposit())
+		require.Nil(t, err)
+
+		expectedArguments := []string{
+			hex.EncodeToString([]byte("from")),
+			hex.EncodeToString([]byte("to")),
+			hex.EncodeToString([]byte("esdt")),
+			hex.EncodeToString(big.NewInt(1000).Bytes()),
+			"00",
+		}
+		assert.Equal(t, expectedArguments, arguments)
+	})
+	t.Run("configured layout", func(t *testing.T) {
+		profile, _ := NewContractProfile(createMockContractProfileConfig())
+		deposit := createMockDeposit()
+		deposit.Nonce = 37
+
+		arguments, err := profile.DepositArguments(deposit)
+		require.Nil(t, err)
+
+		expectedArguments := []string{
+			hex.EncodeToString([]byte{37}),
+			hex.EncodeToString([]byte("from")),
+			hex.EncodeToString([]byte("to")),
+			hex.EncodeToString([]byte("esdt")),
+			hex.EncodeToString(big.NewInt(1000).Bytes()),
+			hex.EncodeToString([]byte("erc20")),
+		}
+		assert.Equal(t, expectedArguments, arguments)
+	})
+}
@@ -41,6 +41,7 @@ type ArgsDataGetter struct {
 	RelayerAddress          core.AddressHandler
 	Proxy                   ElrondProxy
 	Log                     logger.Logger
+	ContractProfile         ContractProfile
 }
 
 type elrondClientDataGetter struct {
@@ -48,6 +49,7 @@ type elrondClientDataGetter struct {
 	relayerAddress          core.AddressHandler
 	proxy                   ElrondProxy
 	log                     logger.Logger
+	profile                 ContractProfile
 	mutNodeStatus           sync.Mutex
 	wasShardIDFetched       bool
 	shardID                 uint32
@@ -67,12 +69,16 @@ func NewDataGetter(args ArgsDataGetter) (*elrondClientDataGetter, error) {
 	if check.IfNil(args.MultisigContractAddress) {
 		return nil, fmt.Errorf("%w for the MultisigContractAddress argument", errNilAddressHandler)
 	}
+	if check.IfNil(args.ContractProfile) {
+		return nil, errNilContractProfile
+	}
 
 	return &elrondClientDataGetter{
 		multisigContractAddress: args.MultisigContractAddress,
 		relayerAddress:          args.RelayerAddress,
 		proxy:                   args.Proxy,
 		log:                     args.Log,
+		profile:                 args.ContractProfile,
 	}, nil
 }
 
@@ -243,7 +249,7 @@ func (dg *elrondClientDataGetter) createDefaultVmQueryBuilder() builders.VMQuery
 // GetCurrentBatchAsDataBytes will assemble a builder and query the proxy for the current pending batch
 func (dg *elrondClientDataGetter) GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getCurrentTxBatchFuncName)
+	builder.Function(dg.profile.Endpoint(operationGetCurrentTxBatch))
 
 	return dg.executeQueryFromBuilder(ctx, builder)
 }
@@ -251,7 +257,7 @@ func (dg *elrondClientDataGetter) GetCurrentBatchAsDataBytes(ctx context.Context
 // GetTokenIdForErc20Address will assemble a builder and query the proxy for a token id given a specific erc20 address
 func (dg *elrondClientDataGetter) GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getTokenIdForErc20AddressFuncName)
+	builder.Function(dg.profile.Endpoint(operationGetTokenIdForErc20Address))
 	builder.ArgBytes(erc20Address)
 
 	return dg.executeQueryFromBuilder(ctx, builder)
@@ -260,7 +266,7 @@ func (dg *elrondClientDataGetter) GetTokenIdForErc20Address(ctx context.Context,
 // GetERC20AddressForTokenId will assemble a builder and query the proxy for an erc20 address given a specific token id
 func (dg *elrondClientDataGetter) GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getErc20AddressForTokenIdFuncName)
+	builder.Function(dg.profile.Endpoint(operationGetErc20AddressForTokenId))
 	builder.ArgBytes(tokenId)
 	return dg.executeQueryFromBuilder(ctx, builder)
 }
@@ -272,8 +278,11 @@ func (dg *elrondClientDataGetter) WasProposedTransfer(ctx context.Context, batch
 	}
 
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(wasTransferActionProposedFuncName).ArgInt64(int64(batch.ID))
-	addBatchInfo(builder, batch)
+	builder.Function(dg.profile.Endpoint(operationWasTransferActionProposed)).ArgInt64(int64(batch.ID))
+	err := dg.addBatchInfo(builder, batch)
+	if err != nil {
+		return false, err
+	}
 
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
@@ -281,7 +290,7 @@ func (dg *elrondClientDataGetter) WasProposedTransfer(ctx context.Context, batch
 // WasExecuted returns true if the provided actionID was executed or not
 func (dg *elrondClientDataGetter) WasExecuted(ctx context.Context, actionID uint64) (bool, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(wasActionExecutedFuncName).ArgInt64(int64(actionID))
+	builder.Function(dg.profile.Endpoint(operationWasActionExecuted)).ArgInt64(int64(actionID))
 
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
@@ -293,8 +302,11 @@ func (dg *elrondClientDataGetter) GetActionIDForProposeTransfer(ctx context.Cont
 	}
 
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getActionIdForTransferBatchFuncName).ArgInt64(int64(batch.ID))
-	addBatchInfo(builder, batch)
+	builder.Function(dg.profile.Endpoint(operationGetActionIdForTransferBatch)).ArgInt64(int64(batch.ID))
+	err := dg.addBatchInfo(builder, batch)
+	if err != nil {
+		return 0, err
+	}
 
 	return dg.executeQueryUint64FromBuilder(ctx, builder)
 }
@@ -306,7 +318,7 @@ func (dg *elrondClientDataGetter) WasProposedSetStatus(ctx context.Context, batc
 	}
 
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(wasSetCurrentTransactionBatchStatusActionProposedFuncName).ArgInt64(int64(batch.ID))
+	builder.Function(dg.profile.Endpoint(operationWasSetCurrentTransactionBatchStatusActionProposed)).ArgInt64(int64(batch.ID))
 	for _, stat := range batch.Statuses {
 		builder.ArgBytes([]byte{stat})
 	}
@@ -317,7 +329,7 @@ func (dg *elrondClientDataGetter) WasProposedSetStatus(ctx context.Context, batc
 // GetTransactionsStatuses will return the transactions statuses from the batch ID
 func (dg *elrondClientDataGetter) GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getStatusesAfterExecutionFuncName).ArgInt64(int64(batchID))
+	builder.Function(dg.profile.Endpoint(operationGetStatusesAfterExecution)).ArgInt64(int64(batchID))
 
 	values, err := dg.executeQueryFromBuilder(ctx, builder)
 	if err != nil {
@@ -327,7 +339,7 @@ func (dg *elrondClientDataGetter) GetTransactionsStatuses(ctx context.Context, b
 		return nil, fmt.Errorf("%w for batch ID %v", errNoStatusForBatchID, batchID)
 	}
 
-	isFinished, err := dg.parseBool(values[0], getStatusesAfterExecutionFuncName, dg.multisigContractAddress.AddressAsBech32String())
+	isFinished, err := dg.parseBool(values[0], dg.profile.Endpoint(operationGetStatusesAfterExecution), dg.multisigContractAddress.AddressAsBech32String())
 	if err != nil {
 		return nil, err
 	}
@@ -357,7 +369,7 @@ func (dg *elrondClientDataGetter) GetActionIDForSetStatusOnPendingTransfer(ctx c
 	}
 
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getActionIdForSetCurrentTransactionBatchStatusFuncName).ArgInt64(int64(batch.ID))
+	builder.Function(dg.profile.Endpoint(operationGetActionIdForSetCurrentTransactionBatchStatus)).ArgInt64(int64(batch.ID))
 	for _, stat := range batch.Statuses {
 		builder.ArgBytes([]byte{stat})
 	}
@@ -368,21 +380,21 @@ func (dg *elrondClientDataGetter) GetActionIDForSetStatusOnPendingTransfer(ctx c
 // QuorumReached returns true if the provided action ID reached the set quorum
 func (dg *elrondClientDataGetter) QuorumReached(ctx context.Context, actionID uint64) (bool, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(quorumReachedFuncName).ArgInt64(int64(actionID))
+	builder.Function(dg.profile.Endpoint(operationQuorumReached)).ArgInt64(int64(actionID))
 
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
 
 // GetLastExecutedEthBatchID returns the last executed Ethereum batch ID
 func (dg *elrondClientDataGetter) GetLastExecutedEthBatchID(ctx context.Context) (uint64, error) {
-	builder := dg.createDefaultVmQueryBuilder().Function(getLastExecutedEthBatchIdFuncName)
+	builder := dg.createDefaultVmQueryBuilder().Function(dg.profile.Endpoint(operationGetLastExecutedEthBatchId))
 
 	return dg.executeQueryUint64FromBuilder(ctx, builder)
 }
 
 // GetLastExecutedEthTxID returns the last executed Ethereum deposit ID
 func (dg *elrondClientDataGetter) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
-	builder := dg.createDefaultVmQueryBuilder().Function(getLastExecutedEthTxId)
+	builder := dg.createDefaultVmQueryBuilder().Function(dg.profile.Endpoint(operationGetLastExecutedEthTxId))
 
 	return dg.executeQueryUint64FromBuilder(ctx, builder)
 }
@@ -390,7 +402,7 @@ func (dg *elrondClientDataGetter) GetLastExecutedEthTxID(ctx context.Context) (u
 // WasSigned returns true if the action was already signed by the current relayer
 func (dg *elrondClientDataGetter) WasSigned(ctx context.Context, actionID uint64) (bool, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(signedFuncName).ArgAddress(dg.relayerAddress).ArgInt64(int64(actionID))
+	builder.Function(dg.profile.Endpoint(operationSigned)).ArgAddress(dg.relayerAddress).ArgInt64(int64(actionID))
 
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
@@ -398,7 +410,7 @@ func (dg *elrondClientDataGetter) WasSigned(ctx context.Context, actionID uint64
 // GetAllStakedRelayers returns all staked relayers defined in Elrond SC
 func (dg *elrondClientDataGetter) GetAllStakedRelayers(ctx context.Context) ([][]byte, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(getAllStakedRelayersFuncName)
+	builder.Function(dg.profile.Endpoint(operationGetAllStakedRelayers))
 
 	return dg.executeQueryFromBuilder(ctx, builder)
 }
@@ -406,7 +418,7 @@ func (dg *elrondClientDataGetter) GetAllStakedRelayers(ctx context.Context) ([][
 // IsPaused returns true if the multisig contract is paused
 func (dg *elrondClientDataGetter) IsPaused(ctx context.Context) (bool, error) {
 	builder := dg.createDefaultVmQueryBuilder()
-	builder.Function(isPausedFuncName)
+	builder.Function(dg.profile.Endpoint(operationIsPaused))
 
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
@@ -419,14 +431,18 @@ func getStatusFromBuff(buff []byte) (byte, error) {
 	return buff[len(buff)-1], nil
 }
 
-func addBatchInfo(builder builders.VMQueryBuilder, batch *clients.TransferBatch) {
+func (dg *elrondClientDataGetter) addBatchInfo(builder builders.VMQueryBuilder, batch *clients.TransferBatch) error {
 	for _, dt := range batch.Deposits {
-		builder.ArgBytes(dt.FromBytes).
-			ArgBytes(dt.ToBytes).
-			ArgBytes(dt.ConvertedTokenBytes).
-			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce))
+		arguments, err := dg.profile.DepositArguments(dt)
+		if err != nil {
+			return err
+		}
+		for _, argument := range arguments {
+			builder.ArgHexString(argument)
+		}
 	}
+
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
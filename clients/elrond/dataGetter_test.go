@@ -30,8 +30,9 @@ var calledArgs = []string{"args1", "args2"}
 
 func createMockArgsDataGetter() ArgsDataGetter {
 	args := ArgsDataGetter{
-		Log:   logger.GetOrCreate("test"),
-		Proxy: &interactors.ElrondProxyStub{},
+		Log:             logger.GetOrCreate("test"),
+		Proxy:           &interactors.ElrondProxyStub{},
+		ContractProfile: NewDefaultContractProfile(),
 	}
 
 	args.MultisigContractAddress, _ = data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf")
@@ -129,6 +130,16 @@ func TestNewDataGetter(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), "RelayerAddress"))
 		assert.True(t, check.IfNil(dg))
 	})
+	t.Run("nil contract profile", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDataGetter()
+		args.ContractProfile = nil
+
+		dg, err := NewDataGetter(args)
+		assert.Equal(t, errNilContractProfile, err)
+		assert.True(t, check.IfNil(dg))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -546,6 +557,7 @@ func TestDataGetter_WasProposedTransfer(t *testing.T) {
 		dg, _ := NewDataGetter(args)
 
 		batch := createMockBatch()
+		batch.Statuses = make([]byte, len(batch.Deposits))
 
 		result, err := dg.WasProposedTransfer(context.Background(), batch)
 		assert.True(t, result)
@@ -667,6 +679,7 @@ func TestDataGetter_GetActionIDForProposeTransfer(t *testing.T) {
 		dg, _ := NewDataGetter(args)
 
 		batch := createMockBatch()
+		batch.Statuses = make([]byte, len(batch.Deposits))
 
 		result, err := dg.GetActionIDForProposeTransfer(context.Background(), batch)
 		assert.Equal(t, uint64(1234), result)
@@ -0,0 +1,21 @@
+package elrond
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+)
+
+// disabledGasEstimator is the gas estimator used when the gas estimation is disabled
+type disabledGasEstimator struct {
+}
+
+// EstimateGasLimit returns the configured gas limit
+func (estimator *disabledGasEstimator) EstimateGasLimit(_ context.Context, _ builders.TxDataBuilder, configuredGasLimit uint64) uint64 {
+	return configuredGasLimit
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (estimator *disabledGasEstimator) IsInterfaceNil() bool {
+	return estimator == nil
+}
@@ -14,8 +14,14 @@ var (
 	errBatchNotFinished         = errors.New("batch not finished")
 	errMalformedBatchResponse   = errors.New("malformed batch response")
 	errNilRoleProvider          = errors.New("nil role provider")
-	errRelayerNotWhitelisted    = errors.New("relayer not whitelisted")
 	errNilNodeStatusResponse    = errors.New("nil node status response")
+	errInvalidDuration          = errors.New("invalid duration")
+	errGasEstimationFailed      = errors.New("gas estimation failed")
+	errInvalidContractProfile   = errors.New("invalid contract profile")
+	errContractProfileNotFound  = errors.New("contract profile not found")
+	errNilContractProfile       = errors.New("nil contract profile")
+	errNilAmount                = errors.New("nil amount")
+	errBatchNotPending          = errors.New("batch not pending")
 
 	// ErrNoPendingBatchAvailable signals that no pending batch is available
 	ErrNoPendingBatchAvailable = errors.New("no pending batch available")
@@ -0,0 +1,154 @@
+package elrond
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+const minGasLimitMultiplier = 1.0
+
+// ArgsGasEstimator is the DTO used to create a new instance of gasEstimator
+type ArgsGasEstimator struct {
+	Proxy                   ElrondProxy
+	Log                     logger.Logger
+	StatusHandler           bridgeCore.StatusHandler
+	RelayerAddress          core.AddressHandler
+	MultisigContractAddress core.AddressHandler
+	GasLimitMultiplier      float64
+	MaxGasLimit             uint64
+}
+
+type gasEstimator struct {
+	proxy                   ElrondProxy
+	log                     logger.Logger
+	statusHandler           bridgeCore.StatusHandler
+	relayerAddress          core.AddressHandler
+	multisigAddressAsBech32 string
+	gasLimitMultiplier      float64
+	maxGasLimit             uint64
+}
+
+// NewGasEstimator creates a new instance of gasEstimator able to compute the gas limit of a transaction by
+// simulating it
+func NewGasEstimator(args ArgsGasEstimator) (*gasEstimator, error) {
+	err := checkArgsGasEstimator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasEstimator{
+		proxy:                   args.Proxy,
+		log:                     args.Log,
+		statusHandler:           args.StatusHandler,
+		relayerAddress:          args.RelayerAddress,
+		multisigAddressAsBech32: args.MultisigContractAddress.AddressAsBech32String(),
+		gasLimitMultiplier:      args.GasLimitMultiplier,
+		maxGasLimit:             args.MaxGasLimit,
+	}, nil
+}
+
+func checkArgsGasEstimator(args ArgsGasEstimator) error {
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return clients.ErrNilStatusHandler
+	}
+	if check.IfNil(args.RelayerAddress) {
+		return fmt.Errorf("%w for the RelayerAddress argument", errNilAddressHandler)
+	}
+	if check.IfNil(args.MultisigContractAddress) {
+		return fmt.Errorf("%w for the MultisigContractAddress argument", errNilAddressHandler)
+	}
+	if args.GasLimitMultiplier < minGasLimitMultiplier {
+		return fmt.Errorf("%w for GasLimitMultiplier, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.GasLimitMultiplier, minGasLimitMultiplier)
+	}
+	if args.MaxGasLimit == 0 {
+		return fmt.Errorf("%w for MaxGasLimit, got: %d", clients.ErrInvalidValue, args.MaxGasLimit)
+	}
+
+	return nil
+}
+
+// EstimateGasLimit returns the simulated gas limit of the transaction, multiplied and capped as configured. The
+// configured gas limit is returned if the simulation fails
+func (estimator *gasEstimator) EstimateGasLimit(ctx context.Context, builder builders.TxDataBuilder, configuredGasLimit uint64) uint64 {
+	estimator.statusHandler.SetIntMetric(bridgeCore.MetricElrondLastConfiguredGasLimit, int(configuredGasLimit))
+
+	cost, err := estimator.requestTransactionCost(ctx, builder)
+	if err != nil {
+		estimator.log.Warn("gas estimation failed, using the configured gas limit",
+			"configured gas limit", configuredGasLimit, "error", err)
+		estimator.statusHandler.AddIntMetric(bridgeCore.MetricElrondNumGasEstimationFailures, 1)
+		return configuredGasLimit
+	}
+
+	gasLimit := uint64(float64(cost) * estimator.gasLimitMultiplier)
+	if gasLimit > estimator.maxGasLimit {
+		gasLimit = estimator.maxGasLimit
+	}
+
+	estimator.log.Debug("estimated gas limit", "simulated cost", cost, "gas limit", gasLimit,
+		"configured gas limit", configuredGasLimit)
+	estimator.statusHandler.SetIntMetric(bridgeCore.MetricElrondLastEstimatedGasLimit, int(gasLimit))
+
+	return gasLimit
+}
+
+func (estimator *gasEstimator) requestTransactionCost(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+	networkConfig, err := estimator.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := estimator.proxy.GetAccount(ctx, estimator.relayerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	dataBytes, err := builder.ToDataBytes()
+	if err != nil {
+		return 0, err
+	}
+
+	tx := &data.Transaction{
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+		GasPrice: networkConfig.MinGasPrice,
+		Nonce:    account.Nonce,
+		Data:     dataBytes,
+		SndAddr:  estimator.relayerAddress.AddressAsBech32String(),
+		RcvAddr:  estimator.multisigAddressAsBech32,
+		Value:    "0",
+	}
+
+	response, err := estimator.proxy.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(response.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w, simulation returned message: %s", errGasEstimationFailed, response.RetMessage)
+	}
+	if response.TxCost == 0 {
+		return 0, fmt.Errorf("%w, simulation returned 0 gas", errGasEstimationFailed)
+	}
+
+	return response.TxCost, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (estimator *gasEstimator) IsInterfaceNil() bool {
+	return estimator == nil
+}
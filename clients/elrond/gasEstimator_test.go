@@ -0,0 +1,176 @@
+package elrond
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsGasEstimator() ArgsGasEstimator {
+	relayer, _ := data.NewAddressFromBech32String(relayerAddress)
+	multisig, _ := data.NewAddressFromBech32String(testMultisigAddress)
+
+	return ArgsGasEstimator{
+		Proxy: &interactors.ElrondProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: 37}, nil
+			},
+		},
+		Log:                     logger.GetOrCreate("test"),
+		StatusHandler:           testsCommon.NewStatusHandlerMock("test"),
+		RelayerAddress:          relayer,
+		MultisigContractAddress: multisig,
+		GasLimitMultiplier:      1.5,
+		MaxGasLimit:             1000,
+	}
+}
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.Proxy = nil
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, errNilProxy, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.Log = nil
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.StatusHandler = nil
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, clients.ErrNilStatusHandler, err)
+	})
+	t.Run("nil relayer address should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.RelayerAddress = nil
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.True(t, errors.Is(err, errNilAddressHandler))
+		assert.True(t, strings.Contains(err.Error(), "RelayerAddress"))
+	})
+	t.Run("nil multisig contract address should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.MultisigContractAddress = nil
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.True(t, errors.Is(err, errNilAddressHandler))
+		assert.True(t, strings.Contains(err.Error(), "MultisigContractAddress"))
+	})
+	t.Run("invalid gas limit multiplier should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.GasLimitMultiplier = 0.9
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "GasLimitMultiplier"))
+	})
+	t.Run("invalid max gas limit should error", func(t *testing.T) {
+		args := createMockArgsGasEstimator()
+		args.MaxGasLimit = 0
+
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxGasLimit"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		estimator, err := NewGasEstimator(createMockArgsGasEstimator())
+		assert.False(t, check.IfNil(estimator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGasEstimator_EstimateGasLimit(t *testing.T) {
+	t.Parallel()
+
+	builder := builders.NewTxDataBuilder().Function("function").ArgInt64(22)
+	configuredGasLimit := uint64(300)
+
+	t.Run("simulation error should return the configured gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.Proxy.(*interactors.ElrondProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+			return nil, errors.New("expected error")
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit := estimator.EstimateGasLimit(context.Background(), builder, configuredGasLimit)
+		assert.Equal(t, configuredGasLimit, gasLimit)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricElrondNumGasEstimationFailures))
+		assert.Equal(t, int(configuredGasLimit), statusHandler.GetIntMetric(bridgeCore.MetricElrondLastConfiguredGasLimit))
+	})
+	t.Run("simulation return message should return the configured gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*interactors.ElrondProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 10, RetMessage: "action already executed"}, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit := estimator.EstimateGasLimit(context.Background(), builder, configuredGasLimit)
+		assert.Equal(t, configuredGasLimit, gasLimit)
+	})
+	t.Run("should apply the multiplier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.Proxy.(*interactors.ElrondProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+			assert.Equal(t, uint64(37), tx.Nonce)
+			assert.Equal(t, relayerAddress, tx.SndAddr)
+			assert.Equal(t, testMultisigAddress, tx.RcvAddr)
+			assert.Equal(t, "function@16", string(tx.Data))
+			return &data.TxCostResponseData{TxCost: 100}, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit := estimator.EstimateGasLimit(context.Background(), builder, configuredGasLimit)
+		assert.Equal(t, uint64(150), gasLimit)
+		assert.Equal(t, 150, statusHandler.GetIntMetric(bridgeCore.MetricElrondLastEstimatedGasLimit))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(bridgeCore.MetricElrondNumGasEstimationFailures))
+	})
+	t.Run("should cap to the max gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*interactors.ElrondProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 1000}, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit := estimator.EstimateGasLimit(context.Background(), builder, configuredGasLimit)
+		assert.Equal(t, args.MaxGasLimit, gasLimit)
+	})
+}
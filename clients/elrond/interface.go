@@ -2,7 +2,9 @@ package elrond
 
 import (
 	"context"
+	"math/big"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
@@ -17,6 +19,8 @@ type ElrondProxy interface {
 	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
 	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
 	GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error)
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	RequestTransactionCost(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 	IsInterfaceNil() bool
 }
 
@@ -27,17 +31,48 @@ type NonceTransactionsHandler interface {
 	Close() error
 }
 
+// BatchDataGetter defines the operation able to fetch the pending batch from the multisig contract
+type BatchDataGetter interface {
+	GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
 // TokensMapper can convert a token bytes from one chain to another
 type TokensMapper interface {
 	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
+// TokensPolicy defines the component able to tell if a deposit complies with the configured tokens policy
+type TokensPolicy interface {
+	IsEnforced() bool
+	CheckElrondDeposit(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error
+	IsInterfaceNil() bool
+}
+
+// ContractProfile defines the endpoint names and the deposit arguments layout of a multisig contract version
+type ContractProfile interface {
+	Name() string
+	Endpoint(operation string) string
+	DepositArguments(deposit *clients.DepositTransfer) ([]string, error)
+	IsInterfaceNil() bool
+}
+
 type txHandler interface {
 	SendTransactionReturnHash(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error)
 	Close() error
 }
 
+type outcomeTracker interface {
+	WaitForOutcome(ctx context.Context, hash string) error
+	IsInterfaceNil() bool
+}
+
+type gasLimitEstimator interface {
+	EstimateGasLimit(ctx context.Context, builder builders.TxDataBuilder, configuredGasLimit uint64) uint64
+	IsInterfaceNil() bool
+}
+
 type roleProvider interface {
 	IsWhitelisted(address core.AddressHandler) bool
 	IsInterfaceNil() bool
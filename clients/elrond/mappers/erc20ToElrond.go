@@ -33,7 +33,7 @@ func (mapper *erc20ToElrond) ConvertToken(ctx context.Context, sourceBytes []byt
 	}
 
 	if len(response) == 0 {
-		return nil, fmt.Errorf("%w for provided %s", errUnknownToken, hex.EncodeToString(sourceBytes))
+		return nil, fmt.Errorf("%w for provided %s", clients.ErrUnknownToken, hex.EncodeToString(sourceBytes))
 	}
 
 	return response[0], nil
@@ -2,4 +2,7 @@ package mappers
 
 import "errors"
 
-var errUnknownToken = errors.New("unknown token")
+var (
+	errTokenMappingMismatch   = errors.New("token mapping mismatch")
+	errInvalidExpectedMapping = errors.New("invalid expected token mapping")
+)
@@ -0,0 +1,379 @@
+package mappers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	minCacheTTL       = time.Second
+	erc20AddressLen   = 20
+	hexPrefix         = "0x"
+	tokenRegistryName = "TokenRegistry"
+)
+
+// ArgsTokenRegistry is the DTO used to create a new instance of tokenRegistry
+type ArgsTokenRegistry struct {
+	DataGetter       DataGetter
+	Log              logger.Logger
+	AlertHandler     bridgeCore.AlertHandler
+	CacheTTL         time.Duration
+	ExpectedMappings []config.TokenMappingConfig
+}
+
+type tokenMapping struct {
+	erc20Address    []byte
+	esdtTokenID     []byte
+	fetchedAt       time.Time
+	mismatch        string
+	roundTripFailed bool
+}
+
+type tokenRegistry struct {
+	dataGetter      DataGetter
+	log             logger.Logger
+	alertHandler    bridgeCore.AlertHandler
+	cacheTTL        time.Duration
+	expectedByErc20 map[string][]byte
+	expectedByEsdt  map[string][]byte
+	getTimeHandler  func() time.Time
+
+	mut           sync.RWMutex
+	erc20Mappings map[string]*tokenMapping
+	esdtMappings  map[string]*tokenMapping
+}
+
+// NewTokenRegistry creates a token registry that caches the ERC20 - ESDT mappings fetched from the Elrond multisig
+// contract. Each mapping is checked to round-trip and to match the expected mappings. The lookups of a token with a
+// mismatching mapping return an error so the batches containing that token can not be signed
+func NewTokenRegistry(args ArgsTokenRegistry) (*tokenRegistry, error) {
+	err := checkArgsTokenRegistry(args)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := &tokenRegistry{
+		dataGetter:      args.DataGetter,
+		log:             args.Log,
+		alertHandler:    args.AlertHandler,
+		cacheTTL:        args.CacheTTL,
+		expectedByErc20: make(map[string][]byte),
+		expectedByEsdt:  make(map[string][]byte),
+		getTimeHandler:  time.Now,
+		erc20Mappings:   make(map[string]*tokenMapping),
+		esdtMappings:    make(map[string]*tokenMapping),
+	}
+
+	for _, expected := range args.ExpectedMappings {
+		erc20Address, errDecode := decodeErc20Address(expected.Erc20Address)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		if len(expected.EsdtTokenID) == 0 {
+			return nil, fmt.Errorf("%w, empty ESDT token ID for ERC20 address %s", errInvalidExpectedMapping, expected.Erc20Address)
+		}
+
+		erc20Key := hex.EncodeToString(erc20Address)
+		_, exists := registry.expectedByErc20[erc20Key]
+		if exists {
+			return nil, fmt.Errorf("%w, duplicated ERC20 address %s", errInvalidExpectedMapping, expected.Erc20Address)
+		}
+		_, exists = registry.expectedByEsdt[expected.EsdtTokenID]
+		if exists {
+			return nil, fmt.Errorf("%w, duplicated ESDT token ID %s", errInvalidExpectedMapping, expected.EsdtTokenID)
+		}
+
+		registry.expectedByErc20[erc20Key] = []byte(expected.EsdtTokenID)
+		registry.expectedByEsdt[expected.EsdtTokenID] = erc20Address
+	}
+
+	return registry, nil
+}
+
+func checkArgsTokenRegistry(args ArgsTokenRegistry) error {
+	if check.IfNil(args.DataGetter) {
+		return clients.ErrNilDataGetter
+	}
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.AlertHandler) {
+		return clients.ErrNilAlertHandler
+	}
+	if args.CacheTTL < minCacheTTL {
+		return fmt.Errorf("%w for CacheTTL, got: %v, minimum: %v", clients.ErrInvalidValue, args.CacheTTL, minCacheTTL)
+	}
+
+	return nil
+}
+
+func decodeErc20Address(address string) ([]byte, error) {
+	addressBytes, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(address), hexPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("%w, ERC20 address %s: %s", errInvalidExpectedMapping, address, err.Error())
+	}
+	if len(addressBytes) != erc20AddressLen {
+		return nil, fmt.Errorf("%w, ERC20 address %s has %d bytes", errInvalidExpectedMapping, address, len(addressBytes))
+	}
+
+	return addressBytes, nil
+}
+
+// GetTokenIdForErc20Address returns the cached ESDT token ID of the provided ERC20 address, fetching it if missing or
+// expired. Errors if the mapping is inconsistent
+func (registry *tokenRegistry) GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+	mapping, found := registry.getFreshMapping(registry.erc20Mappings, hex.EncodeToString(erc20Address))
+	if !found {
+		var err error
+		mapping, err = registry.fetchFromErc20Address(ctx, erc20Address)
+		if err != nil || mapping == nil {
+			return nil, err
+		}
+	}
+
+	return registry.checkedResponse(mapping, mapping.esdtTokenID)
+}
+
+// GetERC20AddressForTokenId returns the cached ERC20 address of the provided ESDT token ID, fetching it if missing or
+// expired. Errors if the mapping is inconsistent
+func (registry *tokenRegistry) GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error) {
+	mapping, found := registry.getFreshMapping(registry.esdtMappings, string(tokenId))
+	if !found {
+		var err error
+		mapping, err = registry.fetchFromEsdtTokenID(ctx, tokenId)
+		if err != nil || mapping == nil {
+			return nil, err
+		}
+	}
+
+	return registry.checkedResponse(mapping, mapping.erc20Address)
+}
+
+func (registry *tokenRegistry) getFreshMapping(mappings map[string]*tokenMapping, key string) (*tokenMapping, bool) {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	mapping, found := mappings[key]
+	if !found {
+		return nil, false
+	}
+
+	return mapping, registry.getTimeHandler().Sub(mapping.fetchedAt) < registry.cacheTTL
+}
+
+func (registry *tokenRegistry) checkedResponse(mapping *tokenMapping, value []byte) ([][]byte, error) {
+	if len(mapping.mismatch) > 0 {
+		return nil, fmt.Errorf("%w for ERC20 address %s and ESDT token ID %s: %s", errTokenMappingMismatch,
+			hex.EncodeToString(mapping.erc20Address), mapping.esdtTokenID, mapping.mismatch)
+	}
+
+	return [][]byte{value}, nil
+}
+
+// fetchFromErc20Address fetches and checks the mapping of the provided ERC20 address. Returns nil if the contract
+// does not know the address
+func (registry *tokenRegistry) fetchFromErc20Address(ctx context.Context, erc20Address []byte) (*tokenMapping, error) {
+	esdtTokenID, err := registry.queryFirstValue(ctx, registry.dataGetter.GetTokenIdForErc20Address, erc20Address)
+	if err != nil || len(esdtTokenID) == 0 {
+		return nil, err
+	}
+	roundTripAddress, err := registry.queryFirstValue(ctx, registry.dataGetter.GetERC20AddressForTokenId, esdtTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &tokenMapping{
+		erc20Address: erc20Address,
+		esdtTokenID:  esdtTokenID,
+		fetchedAt:    registry.getTimeHandler(),
+	}
+	if !bytes.Equal(roundTripAddress, erc20Address) {
+		mapping.mismatch = fmt.Sprintf("ESDT token ID maps back to ERC20 address %s", hex.EncodeToString(roundTripAddress))
+		mapping.roundTripFailed = true
+	}
+	registry.checkExpectedMapping(mapping)
+	registry.storeMapping(mapping, true)
+
+	return mapping, nil
+}
+
+// fetchFromEsdtTokenID fetches and checks the mapping of the provided ESDT token ID. Returns nil if the contract
+// does not know the token ID
+func (registry *tokenRegistry) fetchFromEsdtTokenID(ctx context.Context, esdtTokenID []byte) (*tokenMapping, error) {
+	erc20Address, err := registry.queryFirstValue(ctx, registry.dataGetter.GetERC20AddressForTokenId, esdtTokenID)
+	if err != nil || len(erc20Address) == 0 {
+		return nil, err
+	}
+	roundTripTokenID, err := registry.queryFirstValue(ctx, registry.dataGetter.GetTokenIdForErc20Address, erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &tokenMapping{
+		erc20Address: erc20Address,
+		esdtTokenID:  esdtTokenID,
+		fetchedAt:    registry.getTimeHandler(),
+	}
+	if !bytes.Equal(roundTripTokenID, esdtTokenID) {
+		mapping.mismatch = fmt.Sprintf("ERC20 address maps back to ESDT token ID %s", roundTripTokenID)
+		mapping.roundTripFailed = true
+	}
+	registry.checkExpectedMapping(mapping)
+	registry.storeMapping(mapping, false)
+
+	return mapping, nil
+}
+
+func (registry *tokenRegistry) queryFirstValue(
+	ctx context.Context,
+	query func(ctx context.Context, key []byte) ([][]byte, error),
+	key []byte,
+) ([]byte, error) {
+	response, err := query(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	return response[0], nil
+}
+
+func (registry *tokenRegistry) checkExpectedMapping(mapping *tokenMapping) {
+	if len(mapping.mismatch) > 0 {
+		return
+	}
+
+	expectedTokenID, found := registry.expectedByErc20[hex.EncodeToString(mapping.erc20Address)]
+	if found && !bytes.Equal(expectedTokenID, mapping.esdtTokenID) {
+		mapping.mismatch = fmt.Sprintf("expected ESDT token ID %s", expectedTokenID)
+		return
+	}
+
+	expectedAddress, found := registry.expectedByEsdt[string(mapping.esdtTokenID)]
+	if found && !bytes.Equal(expectedAddress, mapping.erc20Address) {
+		mapping.mismatch = fmt.Sprintf("expected ERC20 address %s", hex.EncodeToString(expectedAddress))
+	}
+}
+
+// storeMapping stores the mapping under the key it was fetched with. A mapping that round-trips is also stored under
+// the other key, so a dangling mapping can not block the token it points to
+func (registry *tokenRegistry) storeMapping(mapping *tokenMapping, fetchedFromErc20 bool) {
+	erc20Key := hex.EncodeToString(mapping.erc20Address)
+	esdtKey := string(mapping.esdtTokenID)
+
+	registry.mut.Lock()
+	var previous *tokenMapping
+	if fetchedFromErc20 {
+		previous = registry.erc20Mappings[erc20Key]
+	} else {
+		previous = registry.esdtMappings[esdtKey]
+	}
+	if fetchedFromErc20 || !mapping.roundTripFailed {
+		registry.erc20Mappings[erc20Key] = mapping
+	}
+	if !fetchedFromErc20 || !mapping.roundTripFailed {
+		registry.esdtMappings[esdtKey] = mapping
+	}
+	registry.mut.Unlock()
+
+	wasMismatched := previous != nil && len(previous.mismatch) > 0
+	isMismatched := len(mapping.mismatch) > 0
+	switch {
+	case isMismatched:
+		registry.alertMismatch(mapping)
+	case wasMismatched:
+		registry.log.Info("token mapping is consistent again, unblocking the token",
+			"ERC20 address", erc20Key, "ESDT token ID", esdtKey)
+	default:
+		registry.log.Debug("stored token mapping", "ERC20 address", erc20Key, "ESDT token ID", esdtKey)
+	}
+}
+
+func (registry *tokenRegistry) alertMismatch(mapping *tokenMapping) {
+	erc20Address := hex.EncodeToString(mapping.erc20Address)
+	registry.log.Error("token mapping mismatch, the token is blocked", "ERC20 address", erc20Address,
+		"ESDT token ID", string(mapping.esdtTokenID), "mismatch", mapping.mismatch)
+
+	registry.alertHandler.Alert(bridgeCore.Alert{
+		Type:     bridgeCore.AlertTokenMappingMismatch,
+		Severity: bridgeCore.AlertSeverityCritical,
+		Source:   tokenRegistryName + "/" + erc20Address,
+		Message: fmt.Sprintf("token mapping between ERC20 address %s and ESDT token ID %s is blocked: %s",
+			erc20Address, mapping.esdtTokenID, mapping.mismatch),
+	})
+}
+
+// Execute fetches again the expected and all the known mappings, in both directions. It is called at startup and
+// then periodically
+func (registry *tokenRegistry) Execute(ctx context.Context) error {
+	erc20Addresses, esdtTokenIDs := registry.knownTokens()
+
+	var lastErr error
+	for _, erc20Address := range erc20Addresses {
+		_, err := registry.fetchFromErc20Address(ctx, erc20Address)
+		if err != nil {
+			registry.log.Debug("error refreshing token mapping", "ERC20 address", hex.EncodeToString(erc20Address),
+				"error", err)
+			lastErr = err
+		}
+	}
+	for _, esdtTokenID := range esdtTokenIDs {
+		_, err := registry.fetchFromEsdtTokenID(ctx, esdtTokenID)
+		if err != nil {
+			registry.log.Debug("error refreshing token mapping", "ESDT token ID", string(esdtTokenID), "error", err)
+			lastErr = err
+		}
+	}
+
+	registry.log.Debug("refreshed token mappings", "num ERC20 addresses", len(erc20Addresses),
+		"num ESDT token IDs", len(esdtTokenIDs))
+
+	return lastErr
+}
+
+func (registry *tokenRegistry) knownTokens() ([][]byte, [][]byte) {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	erc20Keys := make(map[string][]byte)
+	esdtKeys := make(map[string][]byte)
+	for esdtKey, erc20Address := range registry.expectedByEsdt {
+		erc20Keys[hex.EncodeToString(erc20Address)] = erc20Address
+		esdtKeys[esdtKey] = []byte(esdtKey)
+	}
+	for erc20Key, mapping := range registry.erc20Mappings {
+		erc20Keys[erc20Key] = mapping.erc20Address
+	}
+	for esdtKey, mapping := range registry.esdtMappings {
+		esdtKeys[esdtKey] = mapping.esdtTokenID
+	}
+
+	return mapValues(erc20Keys), mapValues(esdtKeys)
+}
+
+func mapValues(values map[string][]byte) [][]byte {
+	result := make([][]byte, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *tokenRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
@@ -0,0 +1,359 @@
+package mappers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	erc20Address      = bytes.Repeat([]byte{1}, erc20AddressLen)
+	otherErc20Address = bytes.Repeat([]byte{2}, erc20AddressLen)
+	esdtTokenID       = []byte("WEGLD-bd4d79")
+)
+
+type contractMappings struct {
+	mut         sync.Mutex
+	erc20ToEsdt map[string][]byte
+	esdtToErc20 map[string][]byte
+	numQueries  int
+}
+
+func newContractMappings() *contractMappings {
+	return &contractMappings{
+		erc20ToEsdt: map[string][]byte{string(erc20Address): esdtTokenID},
+		esdtToErc20: map[string][]byte{string(esdtTokenID): erc20Address},
+	}
+}
+
+func (mappings *contractMappings) createDataGetter() *bridgeTests.DataGetterStub {
+	return &bridgeTests.DataGetterStub{
+		GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+			return mappings.get(mappings.erc20ToEsdt, erc20Address), nil
+		},
+		GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			return mappings.get(mappings.esdtToErc20, tokenId), nil
+		},
+	}
+}
+
+func (mappings *contractMappings) get(values map[string][]byte, key []byte) [][]byte {
+	mappings.mut.Lock()
+	defer mappings.mut.Unlock()
+
+	mappings.numQueries++
+	value, found := values[string(key)]
+	if !found {
+		return make([][]byte, 0)
+	}
+
+	return [][]byte{value}
+}
+
+func (mappings *contractMappings) set(values map[string][]byte, key []byte, value []byte) {
+	mappings.mut.Lock()
+	values[string(key)] = value
+	mappings.mut.Unlock()
+}
+
+func (mappings *contractMappings) getNumQueries() int {
+	mappings.mut.Lock()
+	defer mappings.mut.Unlock()
+
+	return mappings.numQueries
+}
+
+func createMockArgsTokenRegistry(mappings *contractMappings) ArgsTokenRegistry {
+	return ArgsTokenRegistry{
+		DataGetter:   mappings.createDataGetter(),
+		Log:          logger.GetOrCreate("test"),
+		AlertHandler: &testsCommon.AlertHandlerStub{},
+		CacheTTL:     time.Minute,
+		ExpectedMappings: []config.TokenMappingConfig{
+			{
+				Erc20Address: "0x" + hex.EncodeToString(erc20Address),
+				EsdtTokenID:  string(esdtTokenID),
+			},
+		},
+	}
+}
+
+func TestNewTokenRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data getter should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.DataGetter = nil
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, clients.ErrNilDataGetter, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.Log = nil
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.AlertHandler = nil
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, clients.ErrNilAlertHandler, err)
+	})
+	t.Run("invalid cache TTL should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.CacheTTL = minCacheTTL - time.Millisecond
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "CacheTTL"))
+	})
+	t.Run("invalid expected ERC20 address should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.ExpectedMappings[0].Erc20Address = "0x0102"
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.True(t, errors.Is(err, errInvalidExpectedMapping))
+		assert.True(t, strings.Contains(err.Error(), "has 2 bytes"))
+	})
+	t.Run("empty expected ESDT token ID should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.ExpectedMappings[0].EsdtTokenID = ""
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.True(t, errors.Is(err, errInvalidExpectedMapping))
+	})
+	t.Run("duplicated expected ERC20 address should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.ExpectedMappings = append(args.ExpectedMappings, config.TokenMappingConfig{
+			Erc20Address: hex.EncodeToString(erc20Address),
+			EsdtTokenID:  "OTHER-123456",
+		})
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.True(t, errors.Is(err, errInvalidExpectedMapping))
+		assert.True(t, strings.Contains(err.Error(), "duplicated ERC20 address"))
+	})
+	t.Run("duplicated expected ESDT token ID should error", func(t *testing.T) {
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.ExpectedMappings = append(args.ExpectedMappings, config.TokenMappingConfig{
+			Erc20Address: hex.EncodeToString(otherErc20Address),
+			EsdtTokenID:  string(esdtTokenID),
+		})
+
+		registry, err := NewTokenRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.True(t, errors.Is(err, errInvalidExpectedMapping))
+		assert.True(t, strings.Contains(err.Error(), "duplicated ESDT token ID"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		registry, err := NewTokenRegistry(createMockArgsTokenRegistry(newContractMappings()))
+		assert.False(t, check.IfNil(registry))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenRegistry_Lookups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("consistent mapping should be cached in both directions", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		registry, _ := NewTokenRegistry(createMockArgsTokenRegistry(mappings))
+
+		response, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{esdtTokenID}, response)
+		assert.Equal(t, 2, mappings.getNumQueries())
+
+		response, err = registry.GetERC20AddressForTokenId(context.Background(), esdtTokenID)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{erc20Address}, response)
+		assert.Equal(t, 2, mappings.getNumQueries())
+	})
+	t.Run("expired mapping should be fetched again", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		registry, _ := NewTokenRegistry(createMockArgsTokenRegistry(mappings))
+		currentTime := time.Now()
+		registry.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		_, _ = registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Equal(t, 2, mappings.getNumQueries())
+
+		currentTime = currentTime.Add(time.Minute)
+		_, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, mappings.getNumQueries())
+	})
+	t.Run("unknown token should return an empty response", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		registry, _ := NewTokenRegistry(createMockArgsTokenRegistry(mappings))
+
+		response, err := registry.GetTokenIdForErc20Address(context.Background(), otherErc20Address)
+		assert.Nil(t, err)
+		assert.Empty(t, response)
+
+		mapper, _ := NewErc20ToElrondMapper(registry)
+		_, err = mapper.ConvertToken(context.Background(), otherErc20Address)
+		assert.True(t, errors.Is(err, clients.ErrUnknownToken))
+	})
+	t.Run("query error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		registry, _ := NewTokenRegistry(args)
+
+		response, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("round-trip mismatch should block the token and alert", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		mappings.set(mappings.esdtToErc20, esdtTokenID, otherErc20Address)
+		mappings.set(mappings.erc20ToEsdt, otherErc20Address, []byte("OTHER-123456"))
+		mappings.set(mappings.esdtToErc20, []byte("OTHER-123456"), otherErc20Address)
+		args := createMockArgsTokenRegistry(mappings)
+		args.ExpectedMappings = nil
+		var alerts []core.Alert
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				alerts = append(alerts, alert)
+			},
+		}
+		registry, _ := NewTokenRegistry(args)
+
+		response, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, errTokenMappingMismatch))
+		assert.True(t, strings.Contains(err.Error(), "maps back to ERC20 address "+hex.EncodeToString(otherErc20Address)))
+		require.Equal(t, 1, len(alerts))
+		assert.Equal(t, core.AlertTokenMappingMismatch, alerts[0].Type)
+		assert.Equal(t, core.AlertSeverityCritical, alerts[0].Severity)
+
+		// the dangling mapping does not block the token it points to
+		response, err = registry.GetTokenIdForErc20Address(context.Background(), otherErc20Address)
+		assert.Equal(t, [][]byte{[]byte("OTHER-123456")}, response)
+		assert.Nil(t, err)
+	})
+	t.Run("expected mapping mismatch should block both directions", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		args := createMockArgsTokenRegistry(mappings)
+		args.ExpectedMappings[0].EsdtTokenID = "OTHER-123456"
+		numAlerts := 0
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				numAlerts++
+			},
+		}
+		registry, _ := NewTokenRegistry(args)
+
+		_, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.True(t, errors.Is(err, errTokenMappingMismatch))
+		assert.True(t, strings.Contains(err.Error(), "expected ESDT token ID OTHER-123456"))
+
+		_, err = registry.GetERC20AddressForTokenId(context.Background(), esdtTokenID)
+		assert.True(t, errors.Is(err, errTokenMappingMismatch))
+		assert.Equal(t, 1, numAlerts)
+		assert.Equal(t, 2, mappings.getNumQueries())
+	})
+}
+
+func TestTokenRegistry_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should load the expected mappings", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		registry, _ := NewTokenRegistry(createMockArgsTokenRegistry(mappings))
+
+		err := registry.Execute(context.Background())
+		assert.Nil(t, err)
+		numQueries := mappings.getNumQueries()
+		assert.Equal(t, 4, numQueries)
+
+		_, err = registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		_, err = registry.GetERC20AddressForTokenId(context.Background(), esdtTokenID)
+		assert.Nil(t, err)
+		assert.Equal(t, numQueries, mappings.getNumQueries())
+	})
+	t.Run("should unblock the token after the mapping was fixed", func(t *testing.T) {
+		t.Parallel()
+
+		mappings := newContractMappings()
+		mappings.set(mappings.esdtToErc20, esdtTokenID, otherErc20Address)
+		registry, _ := NewTokenRegistry(createMockArgsTokenRegistry(mappings))
+
+		_ = registry.Execute(context.Background())
+		_, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.True(t, errors.Is(err, errTokenMappingMismatch))
+
+		mappings.set(mappings.esdtToErc20, esdtTokenID, erc20Address)
+		err = registry.Execute(context.Background())
+		assert.Nil(t, err)
+
+		response, err := registry.GetTokenIdForErc20Address(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{esdtTokenID}, response)
+	})
+	t.Run("should return the query error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsTokenRegistry(newContractMappings())
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return nil, expectedErr
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		registry, _ := NewTokenRegistry(args)
+
+		err := registry.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
@@ -24,7 +25,7 @@ type transactionHandler struct {
 // SendTransactionReturnHash will try to assemble a transaction, sign it, send it and, if everything is OK, returns the transaction's hash
 func (txHandler *transactionHandler) SendTransactionReturnHash(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
 	if !txHandler.roleProvider.IsWhitelisted(txHandler.relayerAddress) {
-		return "", errRelayerNotWhitelisted
+		return "", clients.ErrRelayerNotWhitelisted
 	}
 	tx, err := txHandler.signTransaction(ctx, builder, gasLimit)
 	if err != nil {
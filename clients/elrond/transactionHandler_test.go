@@ -6,6 +6,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	cryptoMock "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/crypto"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
@@ -114,7 +115,7 @@ func TestTransactionHandler_SendTransactionReturnHash(t *testing.T) {
 
 		hash, err := txHandlerInstance.SendTransactionReturnHash(context.Background(), builder, gasLimit)
 		assert.Empty(t, hash)
-		assert.Equal(t, errRelayerNotWhitelisted, err)
+		assert.Equal(t, clients.ErrRelayerNotWhitelisted, err)
 		assert.True(t, wasWhiteListedCalled)
 		assert.False(t, wasSendTransactionCalled)
 	})
@@ -0,0 +1,31 @@
+package elrond
+
+import "fmt"
+
+// transactionOutcomeError represents the DTO struct holding the decoded failure of a sent transaction
+type transactionOutcomeError struct {
+	reason     error
+	hash       string
+	returnCode string
+	message    string
+}
+
+func newTransactionOutcomeError(reason error, hash string, returnCode string, message string) *transactionOutcomeError {
+	return &transactionOutcomeError{
+		reason:     reason,
+		hash:       hash,
+		returnCode: returnCode,
+		message:    message,
+	}
+}
+
+// Error returns the error string
+func (err *transactionOutcomeError) Error() string {
+	return fmt.Sprintf("%s, hash: %s, return code: '%s', message: '%s'",
+		err.reason.Error(), err.hash, err.returnCode, err.message)
+}
+
+// Unwrap returns the typed reason of the failure so it can be checked with errors.Is
+func (err *transactionOutcomeError) Unwrap() error {
+	return err.reason
+}
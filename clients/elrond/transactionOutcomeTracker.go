@@ -0,0 +1,200 @@
+package elrond
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	minOutcomePollingInterval = time.Millisecond * 100
+	minOutcomeMaxWaitTime     = time.Second
+
+	signalErrorIdentifier = "signalError"
+	returnDataSeparator   = "@"
+	okReturnCode          = "ok"
+	outOfGasReturnCode    = "out of gas"
+	userErrorReturnCode   = "user error"
+	// executedStatus is the status reported by older nodes for successfully executed transactions
+	executedStatus = "executed"
+)
+
+// ArgsTransactionOutcomeTracker is the DTO used to create a new instance of transactionOutcomeTracker
+type ArgsTransactionOutcomeTracker struct {
+	Proxy           ElrondProxy
+	Log             logger.Logger
+	PollingInterval time.Duration
+	MaxWaitTime     time.Duration
+}
+
+type transactionOutcomeTracker struct {
+	proxy           ElrondProxy
+	log             logger.Logger
+	pollingInterval time.Duration
+	maxWaitTime     time.Duration
+}
+
+// NewTransactionOutcomeTracker creates a new instance of transactionOutcomeTracker able to determine the outcome
+// of the sent transactions
+func NewTransactionOutcomeTracker(args ArgsTransactionOutcomeTracker) (*transactionOutcomeTracker, error) {
+	err := checkArgsTransactionOutcomeTracker(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionOutcomeTracker{
+		proxy:           args.Proxy,
+		log:             args.Log,
+		pollingInterval: args.PollingInterval,
+		maxWaitTime:     args.MaxWaitTime,
+	}, nil
+}
+
+func checkArgsTransactionOutcomeTracker(args ArgsTransactionOutcomeTracker) error {
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if args.PollingInterval < minOutcomePollingInterval {
+		return fmt.Errorf("%w for PollingInterval, got: %v, minimum: %v",
+			errInvalidDuration, args.PollingInterval, minOutcomePollingInterval)
+	}
+	if args.MaxWaitTime < minOutcomeMaxWaitTime {
+		return fmt.Errorf("%w for MaxWaitTime, got: %v, minimum: %v",
+			errInvalidDuration, args.MaxWaitTime, minOutcomeMaxWaitTime)
+	}
+
+	return nil
+}
+
+// WaitForOutcome polls the provided transaction until its execution is final. It returns nil if the transaction and
+// its smart contract results were successfully executed, an error wrapping one of the clients.ErrTransaction... errors
+// otherwise
+func (tracker *transactionOutcomeTracker) WaitForOutcome(ctx context.Context, hash string) error {
+	ctx, cancel := context.WithTimeout(ctx, tracker.maxWaitTime)
+	defer cancel()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w, hash: %s", clients.ErrTransactionOutcomeTimeout, hash)
+		case <-timer.C:
+		}
+
+		isFinal, err := tracker.checkOutcome(ctx, hash)
+		if isFinal {
+			return err
+		}
+
+		timer.Reset(tracker.pollingInterval)
+	}
+}
+
+func (tracker *transactionOutcomeTracker) checkOutcome(ctx context.Context, hash string) (bool, error) {
+	info, err := tracker.proxy.GetTransactionInfoWithResults(ctx, hash)
+	if err != nil {
+		tracker.log.Debug("error fetching the transaction info", "hash", hash, "error", err)
+		return false, nil
+	}
+
+	tx := info.Data.Transaction
+	tracker.log.Trace("fetched the transaction info", "hash", hash, "status", tx.Status,
+		"num smart contract results", len(tx.ScResults))
+
+	switch transaction.TxStatus(tx.Status) {
+	case transaction.TxStatusSuccess, executedStatus:
+		return true, decodeSmartContractResults(hash, tx.ScResults)
+	case transaction.TxStatusFail:
+		err = decodeSmartContractResults(hash, tx.ScResults)
+		if err == nil {
+			err = newTransactionOutcomeError(clients.ErrTransactionFailed, hash, "", "")
+		}
+		return true, err
+	case transaction.TxStatusInvalid:
+		return true, newTransactionOutcomeError(clients.ErrTransactionFailed, hash, "", "invalid transaction")
+	default:
+		return false, nil
+	}
+}
+
+// decodeSmartContractResults returns the first error found in the provided smart contract results. The signalError
+// log events take precedence as they hold the message emitted by the contract
+func decodeSmartContractResults(hash string, scResults []*transaction.ApiSmartContractResult) error {
+	for _, scr := range scResults {
+		if scr == nil || scr.Logs == nil {
+			continue
+		}
+
+		for _, event := range scr.Logs.Events {
+			if event == nil || event.Identifier != signalErrorIdentifier {
+				continue
+			}
+
+			message := ""
+			if len(event.Topics) > 1 {
+				message = string(event.Topics[1])
+			}
+			returnCode, _ := decodeReturnCode(string(event.Data))
+
+			return newTransactionOutcomeError(reasonFromReturnCode(returnCode), hash, returnCode, message)
+		}
+	}
+
+	for _, scr := range scResults {
+		if scr == nil {
+			continue
+		}
+
+		returnCode, ok := decodeReturnCode(scr.Data)
+		if !ok || returnCode == okReturnCode {
+			continue
+		}
+
+		return newTransactionOutcomeError(reasonFromReturnCode(returnCode), hash, returnCode, scr.ReturnMessage)
+	}
+
+	return nil
+}
+
+// decodeReturnCode extracts the return code from the data field formatted as @hex(return code)@hex(result)...
+func decodeReturnCode(data string) (string, bool) {
+	if !strings.HasPrefix(data, returnDataSeparator) {
+		return "", false
+	}
+
+	parts := strings.Split(data[len(returnDataSeparator):], returnDataSeparator)
+	returnCode, err := hex.DecodeString(parts[0])
+	if err != nil || len(returnCode) == 0 {
+		return "", false
+	}
+
+	return string(returnCode), true
+}
+
+func reasonFromReturnCode(returnCode string) error {
+	switch returnCode {
+	case outOfGasReturnCode:
+		return clients.ErrTransactionOutOfGas
+	case userErrorReturnCode:
+		return clients.ErrTransactionRejectedByContract
+	default:
+		return clients.ErrTransactionFailed
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *transactionOutcomeTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
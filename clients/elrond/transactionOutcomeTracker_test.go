@@ -0,0 +1,205 @@
+package elrond
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTransactionOutcomeTracker() ArgsTransactionOutcomeTracker {
+	return ArgsTransactionOutcomeTracker{
+		Proxy:           &interactors.ElrondProxyStub{},
+		Log:             logger.GetOrCreate("test"),
+		PollingInterval: minOutcomePollingInterval,
+		MaxWaitTime:     minOutcomeMaxWaitTime,
+	}
+}
+
+func createTransactionInfo(status string, scResults ...*transaction.ApiSmartContractResult) *data.TransactionInfo {
+	info := &data.TransactionInfo{}
+	info.Data.Transaction.Status = status
+	info.Data.Transaction.ScResults = scResults
+
+	return info
+}
+
+func createReturnData(returnCode string) string {
+	return "@" + hex.EncodeToString([]byte(returnCode))
+}
+
+func TestNewTransactionOutcomeTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = nil
+
+		tracker, err := NewTransactionOutcomeTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilProxy, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Log = nil
+
+		tracker, err := NewTransactionOutcomeTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		args := createMockArgsTransactionOutcomeTracker()
+		args.PollingInterval = minOutcomePollingInterval - time.Millisecond
+
+		tracker, err := NewTransactionOutcomeTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.True(t, errors.Is(err, errInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "PollingInterval"))
+	})
+	t.Run("invalid max wait time should error", func(t *testing.T) {
+		args := createMockArgsTransactionOutcomeTracker()
+		args.MaxWaitTime = minOutcomeMaxWaitTime - time.Millisecond
+
+		tracker, err := NewTransactionOutcomeTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.True(t, errors.Is(err, errInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "MaxWaitTime"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		tracker, err := NewTransactionOutcomeTracker(createMockArgsTransactionOutcomeTracker())
+		assert.False(t, check.IfNil(tracker))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTransactionOutcomeTracker_WaitForOutcome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should poll until the transaction is executed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		numCalls := 0
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Equal(t, "hash", hash)
+				numCalls++
+				switch numCalls {
+				case 1:
+					return nil, errors.New("transaction not found")
+				case 2:
+					return createTransactionInfo(string(transaction.TxStatusPending)), nil
+				default:
+					return createTransactionInfo(string(transaction.TxStatusSuccess),
+						&transaction.ApiSmartContractResult{Data: createReturnData(okReturnCode)}), nil
+				}
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numCalls)
+	})
+	t.Run("signal error event should be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(string(transaction.TxStatusSuccess),
+					&transaction.ApiSmartContractResult{Data: createReturnData(okReturnCode)},
+					&transaction.ApiSmartContractResult{
+						Data: createReturnData(userErrorReturnCode),
+						Logs: &transaction.ApiLogs{
+							Events: []*transaction.Events{
+								{Identifier: "writeLog"},
+								{
+									Identifier: signalErrorIdentifier,
+									Topics:     [][]byte{[]byte("address"), []byte("Action already executed")},
+									Data:       []byte(createReturnData(userErrorReturnCode)),
+								},
+							},
+						},
+					}), nil
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		require.True(t, errors.Is(err, clients.ErrTransactionRejectedByContract))
+		assert.Equal(t, newTransactionOutcomeError(clients.ErrTransactionRejectedByContract, "hash",
+			userErrorReturnCode, "Action already executed"), err)
+	})
+	t.Run("out of gas result should be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(string(transaction.TxStatusFail), &transaction.ApiSmartContractResult{
+					Data:          createReturnData(outOfGasReturnCode),
+					ReturnMessage: "not enough gas",
+				}), nil
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		assert.True(t, errors.Is(err, clients.ErrTransactionOutOfGas))
+		assert.True(t, strings.Contains(err.Error(), "not enough gas"))
+	})
+	t.Run("failed transaction without results should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(string(transaction.TxStatusFail)), nil
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		assert.True(t, errors.Is(err, clients.ErrTransactionFailed))
+	})
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(string(transaction.TxStatusInvalid)), nil
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		assert.True(t, errors.Is(err, clients.ErrTransactionFailed))
+	})
+	t.Run("pending transaction should timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionOutcomeTracker()
+		args.Proxy = &interactors.ElrondProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(string(transaction.TxStatusPending)), nil
+			},
+		}
+		tracker, _ := NewTransactionOutcomeTracker(args)
+
+		err := tracker.WaitForOutcome(context.Background(), "hash")
+		assert.True(t, errors.Is(err, clients.ErrTransactionOutcomeTimeout))
+	})
+}
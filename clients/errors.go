@@ -29,4 +29,28 @@ var (
 
 	// ErrMultisigContractPaused signals that the multisig contract is paused
 	ErrMultisigContractPaused = errors.New("multisig contract paused")
+
+	// ErrNilAlertHandler signals that a nil alert handler was provided
+	ErrNilAlertHandler = errors.New("nil alert handler")
+
+	// ErrRelayerNotWhitelisted signals that the relayer is not whitelisted
+	ErrRelayerNotWhitelisted = errors.New("relayer not whitelisted")
+
+	// ErrTransactionOutOfGas signals that a sent transaction ran out of gas
+	ErrTransactionOutOfGas = errors.New("transaction ran out of gas")
+
+	// ErrTransactionRejectedByContract signals that a sent transaction was rejected by the smart contract
+	ErrTransactionRejectedByContract = errors.New("transaction rejected by the smart contract")
+
+	// ErrTransactionFailed signals that a sent transaction failed
+	ErrTransactionFailed = errors.New("transaction failed")
+
+	// ErrNilTokensPolicy signals that a nil tokens policy was provided
+	ErrNilTokensPolicy = errors.New("nil tokens policy")
+
+	// ErrUnknownToken signals that the token is not mapped on the other chain
+	ErrUnknownToken = errors.New("unknown token")
+
+	// ErrTransactionOutcomeTimeout signals that the outcome of a sent transaction could not be determined in time
+	ErrTransactionOutcomeTimeout = errors.New("timeout waiting for the transaction outcome")
 )
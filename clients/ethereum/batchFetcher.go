@@ -0,0 +1,89 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// ArgsBatchFetcher is the DTO used in the batch fetcher constructor
+type ArgsBatchFetcher struct {
+	BatchGetter      BatchGetter
+	AddressConverter core.AddressConverter
+}
+
+type batchFetcher struct {
+	batchGetter      BatchGetter
+	addressConverter core.AddressConverter
+}
+
+// NewBatchFetcher creates a component able to read the batches from the Safe contract, usually through an Ethereum
+// node other than the one used by the client
+func NewBatchFetcher(args ArgsBatchFetcher) (*batchFetcher, error) {
+	if check.IfNil(args.BatchGetter) {
+		return nil, errNilBatchGetter
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, clients.ErrNilAddressConverter
+	}
+
+	return &batchFetcher{
+		batchGetter:      args.BatchGetter,
+		addressConverter: args.AddressConverter,
+	}, nil
+}
+
+// GetBatch returns the batch as stored in the Safe contract. The tokens are not converted and no status is set
+func (fetcher *batchFetcher) GetBatch(ctx context.Context, batchID uint64) (*clients.TransferBatch, error) {
+	return getRawBatch(ctx, fetcher.batchGetter, fetcher.addressConverter, batchID)
+}
+
+func getRawBatch(ctx context.Context, batchGetter BatchGetter, addressConverter core.AddressConverter, nonce uint64) (*clients.TransferBatch, error) {
+	nonceAsBigInt := big.NewInt(0).SetUint64(nonce)
+	batch, err := batchGetter.GetBatch(ctx, nonceAsBigInt)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := batchGetter.GetBatchDeposits(ctx, nonceAsBigInt)
+	if err != nil {
+		return nil, err
+	}
+	if int(batch.DepositsCount) != len(deposits) {
+		return nil, fmt.Errorf("%w, batch.DepositsCount: %d, fetched deposits len: %d",
+			errDepositsAndBatchDepositsCountDiffer, batch.DepositsCount, len(deposits))
+	}
+
+	transferBatch := &clients.TransferBatch{
+		ID:       batch.Nonce.Uint64(),
+		Deposits: make([]*clients.DepositTransfer, 0, batch.DepositsCount),
+		Statuses: make([]byte, len(deposits)),
+	}
+	for i := range deposits {
+		deposit := deposits[i]
+		toBytes := deposit.Recipient[:]
+		fromBytes := deposit.Depositor[:]
+		tokenBytes := deposit.TokenAddress[:]
+
+		transferBatch.Deposits = append(transferBatch.Deposits, &clients.DepositTransfer{
+			Nonce:            deposit.Nonce.Uint64(),
+			ToBytes:          toBytes,
+			DisplayableTo:    addressConverter.ToBech32String(toBytes),
+			FromBytes:        fromBytes,
+			DisplayableFrom:  addressConverter.ToHexString(fromBytes),
+			TokenBytes:       tokenBytes,
+			DisplayableToken: addressConverter.ToHexString(tokenBytes),
+			Amount:           big.NewInt(0).Set(deposit.Amount),
+		})
+	}
+
+	return transferBatch, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fetcher *batchFetcher) IsInterfaceNil() bool {
+	return fetcher == nil
+}
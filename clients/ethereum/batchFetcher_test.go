@@ -0,0 +1,85 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum/contract"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core/converters"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBatchFetcher() ArgsBatchFetcher {
+	addressConverter, _ := converters.NewAddressConverter()
+
+	return ArgsBatchFetcher{
+		BatchGetter:      createClientWrapperWithTwoDeposits(),
+		AddressConverter: addressConverter,
+	}
+}
+
+func TestNewBatchFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch getter should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		args.BatchGetter = nil
+
+		fetcher, err := NewBatchFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.Equal(t, errNilBatchGetter, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		args.AddressConverter = nil
+
+		fetcher, err := NewBatchFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.Equal(t, clients.ErrNilAddressConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fetcher, err := NewBatchFetcher(createMockArgsBatchFetcher())
+		assert.False(t, check.IfNil(fetcher))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchFetcher_GetBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("deposits count mismatch should error", func(t *testing.T) {
+		args := createMockArgsBatchFetcher()
+		wrapper := createClientWrapperWithTwoDeposits()
+		wrapper.GetBatchCalled = func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			return contract.Batch{
+				Nonce:         batchNonce,
+				DepositsCount: 3,
+			}, nil
+		}
+		args.BatchGetter = wrapper
+		fetcher, _ := NewBatchFetcher(args)
+
+		batch, err := fetcher.GetBatch(context.Background(), 112)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errDepositsAndBatchDepositsCountDiffer))
+	})
+	t.Run("should work without converting the tokens", func(t *testing.T) {
+		fetcher, _ := NewBatchFetcher(createMockArgsBatchFetcher())
+
+		batch, err := fetcher.GetBatch(context.Background(), 112)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(112), batch.ID)
+		require.Equal(t, 2, len(batch.Deposits))
+		assert.Equal(t, uint64(30), batch.Deposits[1].Nonce)
+		assert.Equal(t, common.BytesToAddress([]byte{2}).Bytes(), batch.Deposits[1].TokenBytes)
+		assert.Equal(t, big.NewInt(40), batch.Deposits[1].Amount)
+		assert.Nil(t, batch.Deposits[1].ConvertedTokenBytes)
+		assert.Equal(t, make([]byte, 2), batch.Statuses)
+	})
+}
@@ -5,10 +5,13 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/tokensPolicy"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -25,10 +28,11 @@ const (
 )
 
 type argListsBatch struct {
-	tokens     []common.Address
-	recipients []common.Address
-	amounts    []*big.Int
-	nonces     []*big.Int
+	tokens       []common.Address
+	sourceTokens [][]byte
+	recipients   []common.Address
+	amounts      []*big.Int
+	nonces       []*big.Int
 }
 
 // ArgsEthereumClient is the DTO used in the ethereum's client constructor
@@ -46,6 +50,8 @@ type ArgsEthereumClient struct {
 	TransferGasLimitBase    uint64
 	TransferGasLimitForEach uint64
 	AllowDelta              uint64
+	AlertHandler            core.AlertHandler
+	TokensPolicy            TokensPolicy
 }
 
 type client struct {
@@ -63,6 +69,8 @@ type client struct {
 	transferGasLimitBase    uint64
 	transferGasLimitForEach uint64
 	allowDelta              uint64
+	alertHandler            core.AlertHandler
+	tokensPolicy            TokensPolicy
 
 	lastBlockNumber          uint64
 	retriesAvailabilityCheck uint64
@@ -97,6 +105,8 @@ func NewEthereumClient(args ArgsEthereumClient) (*client, error) {
 		transferGasLimitBase:    args.TransferGasLimitBase,
 		transferGasLimitForEach: args.TransferGasLimitForEach,
 		allowDelta:              args.AllowDelta,
+		alertHandler:            args.AlertHandler,
+		tokensPolicy:            args.TokensPolicy,
 	}
 
 	c.log.Info("NewEthereumClient",
@@ -144,47 +154,25 @@ func checkArgs(args ArgsEthereumClient) error {
 		return fmt.Errorf("%w for args.AllowedDelta, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.AllowDelta, minAllowedDelta)
 	}
+	if check.IfNil(args.AlertHandler) {
+		return clients.ErrNilAlertHandler
+	}
+	if check.IfNil(args.TokensPolicy) {
+		return clients.ErrNilTokensPolicy
+	}
 	return nil
 }
 
 // GetBatch returns the batch (if existing) from the Ethereum contract by providing the nonce
 func (c *client) GetBatch(ctx context.Context, nonce uint64) (*clients.TransferBatch, error) {
 	c.log.Info("Getting batch", "nonce", nonce)
-	nonceAsBigInt := big.NewInt(0).SetUint64(nonce)
-	batch, err := c.clientWrapper.GetBatch(ctx, nonceAsBigInt)
-	if err != nil {
-		return nil, err
-	}
-	deposits, err := c.clientWrapper.GetBatchDeposits(ctx, nonceAsBigInt)
+	transferBatch, err := getRawBatch(ctx, c.clientWrapper, c.addressConverter, nonce)
 	if err != nil {
 		return nil, err
 	}
-	if int(batch.DepositsCount) != len(deposits) {
-		return nil, fmt.Errorf("%w, batch.DepositsCount: %d, fetched deposits len: %d",
-			errDepositsAndBatchDepositsCountDiffer, batch.DepositsCount, len(deposits))
-	}
 
-	transferBatch := &clients.TransferBatch{
-		ID:       batch.Nonce.Uint64(),
-		Deposits: make([]*clients.DepositTransfer, 0, batch.DepositsCount),
-	}
 	cachedTokens := make(map[string][]byte)
-	for i := range deposits {
-		deposit := deposits[i]
-		toBytes := deposit.Recipient[:]
-		fromBytes := deposit.Depositor[:]
-		tokenBytes := deposit.TokenAddress[:]
-
-		depositTransfer := &clients.DepositTransfer{
-			Nonce:            deposit.Nonce.Uint64(),
-			ToBytes:          toBytes,
-			DisplayableTo:    c.addressConverter.ToBech32String(toBytes),
-			FromBytes:        fromBytes,
-			DisplayableFrom:  c.addressConverter.ToHexString(fromBytes),
-			TokenBytes:       tokenBytes,
-			DisplayableToken: c.addressConverter.ToHexString(tokenBytes),
-			Amount:           big.NewInt(0).Set(deposit.Amount),
-		}
+	for _, depositTransfer := range transferBatch.Deposits {
 		storedConvertedTokenBytes, exists := cachedTokens[depositTransfer.DisplayableToken]
 		if !exists {
 			depositTransfer.ConvertedTokenBytes, err = c.tokensMapper.ConvertToken(ctx, depositTransfer.TokenBytes)
@@ -195,12 +183,8 @@ func (c *client) GetBatch(ctx context.Context, nonce uint64) (*clients.TransferB
 		} else {
 			depositTransfer.ConvertedTokenBytes = storedConvertedTokenBytes
 		}
-
-		transferBatch.Deposits = append(transferBatch.Deposits, depositTransfer)
 	}
 
-	transferBatch.Statuses = make([]byte, len(transferBatch.Deposits))
-
 	return transferBatch, nil
 }
 
@@ -220,6 +204,11 @@ func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) {
 	c.broadcaster.BroadcastSignature(signature, msgHash.Bytes())
 }
 
+// RequestSignaturesForMessageHash will ask the other relayers for the signatures of the provided message hash
+func (c *client) RequestSignaturesForMessageHash(msgHash common.Hash) {
+	c.broadcaster.RequestSignatures(msgHash.Bytes())
+}
+
 // GenerateMessageHash will generate the message hash based on the provided batch
 func (c *client) GenerateMessageHash(batch *clients.TransferBatch) (common.Hash, error) {
 	if batch == nil {
@@ -279,12 +268,13 @@ func generateTransferArgs() (abi.Arguments, error) {
 func (c *client) extractList(batch *clients.TransferBatch) (argListsBatch, error) {
 	arg := argListsBatch{}
 
-	for _, dt := range batch.Deposits {
+	for _, dt := range batch.AcceptedDeposits() {
 		recipient := common.BytesToAddress(dt.ToBytes)
 		arg.recipients = append(arg.recipients, recipient)
 
 		token := common.BytesToAddress(dt.ConvertedTokenBytes)
 		arg.tokens = append(arg.tokens, token)
+		arg.sourceTokens = append(arg.sourceTokens, dt.TokenBytes)
 
 		amount := big.NewInt(0).Set(dt.Amount)
 		arg.amounts = append(arg.amounts, amount)
@@ -360,7 +350,7 @@ func (c *client) ExecuteTransfer(
 		return "", err
 	}
 
-	err = c.checkAvailableTokens(ctx, argLists.tokens, argLists.amounts)
+	balanceChecks, err := c.checkAvailableTokens(ctx, argLists)
 	if err != nil {
 		return "", err
 	}
@@ -379,7 +369,7 @@ func (c *client) ExecuteTransfer(
 	}
 
 	txHash := tx.Hash().String()
-	c.log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash)
+	c.log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash, "balance checks", balanceChecks)
 
 	return txHash, err
 }
@@ -421,15 +411,30 @@ func (c *client) incrementRetriesAvailabilityCheck() {
 }
 
 func (c *client) setStatusForAvailabilityCheck(status ethElrond.ClientStatus, message string, nonce uint64) {
-	c.clientWrapper.SetStringMetric(core.MetricElrondClientStatus, status.String())
-	c.clientWrapper.SetStringMetric(core.MetricLastElrondClientError, message)
+	c.clientWrapper.SetStringMetric(core.MetricEthereumClientStatus, status.String())
+	c.clientWrapper.SetStringMetric(core.MetricLastEthereumClientError, message)
 	c.clientWrapper.SetIntMetric(core.MetricLastBlockNonce, int(nonce))
+
+	if status == ethElrond.Unavailable {
+		c.alertHandler.Alert(core.Alert{
+			Type:     core.AlertClientUnavailable,
+			Severity: core.AlertSeverityWarning,
+			Source:   c.clientWrapper.Name(),
+			Message:  message,
+		})
+	}
 }
 
-func (c *client) checkAvailableTokens(ctx context.Context, tokens []common.Address, amounts []*big.Int) error {
-	transfers := c.getCumulatedTransfers(tokens, amounts)
+// checkAvailableTokens returns the balance check mode used for each ERC20 token of the batch if the Safe can transfer
+// all the cumulated amounts
+func (c *client) checkAvailableTokens(ctx context.Context, argLists argListsBatch) (string, error) {
+	transfers := c.getCumulatedTransfers(argLists.tokens, argLists.amounts)
+	sourceTokens := make(map[common.Address][]byte)
+	for i, token := range argLists.tokens {
+		sourceTokens[token] = argLists.sourceTokens[i]
+	}
 
-	return c.checkCumulatedTransfers(ctx, transfers)
+	return c.checkCumulatedTransfers(ctx, transfers, sourceTokens)
 }
 
 func (c *client) getCumulatedTransfers(tokens []common.Address, amounts []*big.Int) map[common.Address]*big.Int {
@@ -447,26 +452,97 @@ func (c *client) getCumulatedTransfers(tokens []common.Address, amounts []*big.I
 	return transfers
 }
 
-func (c *client) checkCumulatedTransfers(ctx context.Context, transfers map[common.Address]*big.Int) error {
+func (c *client) checkCumulatedTransfers(
+	ctx context.Context,
+	transfers map[common.Address]*big.Int,
+	sourceTokens map[common.Address][]byte,
+) (string, error) {
+	checkedModes := make([]string, 0, len(transfers))
+	defer func() {
+		c.clientWrapper.SetStringMetric(core.MetricEthereumLastBatchBalanceChecks, formatBalanceChecks(checkedModes))
+	}()
+
 	for erc20Address, value := range transfers {
-		existingBalance, err := c.erc20ContractsHandler.BalanceOf(ctx, erc20Address, c.safeContractAddress)
+		mode := c.tokensPolicy.BalanceCheckMode(erc20Address.Bytes(), sourceTokens[erc20Address])
+		checkedModes = append(checkedModes, fmt.Sprintf("%s: %s", erc20Address.String(), mode))
+
+		available, err := c.getAvailableAmount(ctx, erc20Address, mode)
 		if err != nil {
-			return fmt.Errorf("%w for address %s for ERC20 token %s", err, c.safeContractAddress.String(), erc20Address.String())
+			return "", err
+		}
+		if available == nil {
+			c.log.Debug("skipped ERC20 balance check",
+				"ERC20 token", erc20Address.String(),
+				"mode", mode,
+				"needed", value.String())
+			continue
 		}
 
-		if value.Cmp(existingBalance) > 0 {
-			return fmt.Errorf("%w, existing: %s, required: %s for ERC20 token %s and address %s",
-				errInsufficientErc20Balance, existingBalance.String(), value.String(), erc20Address.String(), c.safeContractAddress.String())
+		if value.Cmp(available) > 0 {
+			return "", fmt.Errorf("%w, mode: %s, existing: %s, required: %s for ERC20 token %s and address %s",
+				errInsufficientErc20Balance, mode, available.String(), value.String(), erc20Address.String(), c.safeContractAddress.String())
 		}
 
 		c.log.Debug("checked ERC20 balance",
 			"ERC20 token", erc20Address.String(),
 			"address", c.safeContractAddress.String(),
-			"existing balance", existingBalance.String(),
+			"mode", mode,
+			"existing balance", available.String(),
 			"needed", value.String())
 	}
 
-	return nil
+	return formatBalanceChecks(checkedModes), nil
+}
+
+func formatBalanceChecks(checkedModes []string) string {
+	sort.Strings(checkedModes)
+
+	return strings.Join(checkedModes, ", ")
+}
+
+// getAvailableAmount returns the amount the Safe can transfer for the provided ERC20 token, according to the
+// balance check mode. A nil amount is returned, without an error, if the mode does not limit the transfers
+func (c *client) getAvailableAmount(ctx context.Context, erc20Address common.Address, mode string) (*big.Int, error) {
+	switch mode {
+	case tokensPolicy.BalanceCheckSafeBalance:
+		existingBalance, err := c.erc20ContractsHandler.BalanceOf(ctx, erc20Address, c.safeContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s for ERC20 token %s", err, c.safeContractAddress.String(), erc20Address.String())
+		}
+
+		return existingBalance, nil
+	case tokensPolicy.BalanceCheckMinterAllowance:
+		allowance, err := c.erc20ContractsHandler.MinterAllowance(ctx, erc20Address, c.safeContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w, minter allowance for address %s for ERC20 token %s", err, c.safeContractAddress.String(), erc20Address.String())
+		}
+
+		return allowance, nil
+	case tokensPolicy.BalanceCheckCap:
+		return c.getMintableUpToCap(ctx, erc20Address)
+	case tokensPolicy.BalanceCheckNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w %s for ERC20 token %s", errUnknownBalanceCheckMode, mode, erc20Address.String())
+	}
+}
+
+func (c *client) getMintableUpToCap(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+	tokenCap, err := c.erc20ContractsHandler.Cap(ctx, erc20Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w, cap for ERC20 token %s", err, erc20Address.String())
+	}
+	totalSupply, err := c.erc20ContractsHandler.TotalSupply(ctx, erc20Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w, total supply for ERC20 token %s", err, erc20Address.String())
+	}
+
+	mintable := big.NewInt(0).Sub(tokenCap, totalSupply)
+	if mintable.Sign() < 0 {
+		return big.NewInt(0), nil
+	}
+
+	return mintable, nil
 }
 
 func (c *client) checkRelayerFundsForFee(ctx context.Context, transferFee *big.Int) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum/contract"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/tokensPolicy"
 	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core/converters"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
@@ -56,6 +57,8 @@ func createMockEthereumClientArgs() ArgsEthereumClient {
 		TransferGasLimitBase:    50,
 		TransferGasLimitForEach: 20,
 		AllowDelta:              5,
+		AlertHandler:            &testsCommon.AlertHandlerStub{},
+		TokensPolicy:            &bridgeTests.TokensPolicyStub{},
 	}
 }
 
@@ -193,6 +196,25 @@ func TestNewEthereumClient(t *testing.T) {
 		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
 		assert.True(t, strings.Contains(err.Error(), "for args.AllowedDelta"))
 	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.AlertHandler = nil
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, clients.ErrNilAlertHandler, err)
+	})
+	t.Run("nil tokens policy", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.TokensPolicy = nil
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, clients.ErrNilTokensPolicy, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockEthereumClientArgs()
 		c, err := NewEthereumClient(args)
@@ -344,7 +366,45 @@ func TestClient_GetBatch(t *testing.T) {
 		assert.Equal(t, expectedBatch, batch)
 		assert.Nil(t, err)
 	})
+	t.Run("unknown token should error", func(t *testing.T) {
+		localArgs := createMockEthereumClientArgs()
+		localArgs.ClientWrapper = createClientWrapperWithTwoDeposits()
+		localArgs.TokensMapper = &bridgeTests.TokensMapperStub{
+			ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+				return nil, clients.ErrUnknownToken
+			},
+		}
+		localClient, _ := NewEthereumClient(localArgs)
+
+		batch, err := localClient.GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, clients.ErrUnknownToken))
+	})
+}
 
+func createClientWrapperWithTwoDeposits() *bridgeTests.EthereumClientWrapperStub {
+	return &bridgeTests.EthereumClientWrapperStub{
+		GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			return contract.Batch{
+				Nonce:         batchNonce,
+				DepositsCount: 2,
+			}, nil
+		},
+		GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+			return []contract.Deposit{
+				{
+					Nonce:        big.NewInt(10),
+					TokenAddress: common.BytesToAddress([]byte{1}),
+					Amount:       big.NewInt(20),
+				},
+				{
+					Nonce:        big.NewInt(30),
+					TokenAddress: common.BytesToAddress([]byte{2}),
+					Amount:       big.NewInt(40),
+				},
+			}, nil
+		},
+	}
 }
 
 func TestClient_GenerateMessageHash(t *testing.T) {
@@ -372,6 +432,21 @@ func TestClient_GenerateMessageHash(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "c68190e0a3b8d7c6bd966272a11d618ceddc4b38662b0a1610621f4d30ec07ca", hex.EncodeToString(h.Bytes()))
 	})
+	t.Run("rejected deposits should be skipped", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		rejectedBatch := createMockTransferBatch()
+		rejectedBatch.Statuses[0] = clients.Rejected
+
+		argLists, _ := c.extractList(rejectedBatch)
+		assert.Equal(t, expectedAmounts[1:], argLists.amounts)
+		assert.Equal(t, expectedTokens[1:], argLists.tokens)
+		assert.Equal(t, expectedRecipients[1:], argLists.recipients)
+		assert.Equal(t, expectedNonces[1:], argLists.nonces)
+
+		h, err := c.GenerateMessageHash(rejectedBatch)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "c68190e0a3b8d7c6bd966272a11d618ceddc4b38662b0a1610621f4d30ec07ca", hex.EncodeToString(h.Bytes()))
+	})
 }
 
 func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
@@ -396,6 +471,25 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 	assert.True(t, broadcastCalled)
 }
 
+func TestClient_RequestSignaturesForMessageHash(t *testing.T) {
+	t.Parallel()
+
+	requestCalled := false
+	hash := common.HexToHash("c99286352d865e33f1747761cbd440a7906b9bd8a5261cb6909e5ba18dd19b08")
+	args := createMockEthereumClientArgs()
+	args.Broadcaster = &testsCommon.BroadcasterStub{
+		RequestSignaturesCalled: func(messageHash []byte) {
+			assert.Equal(t, hash.Bytes(), messageHash)
+			requestCalled = true
+		},
+	}
+
+	c, _ := NewEthereumClient(args)
+	c.RequestSignaturesForMessageHash(hash)
+
+	assert.True(t, requestCalled)
+}
+
 func TestClient_WasExecuted(t *testing.T) {
 	t.Parallel()
 
@@ -702,6 +796,116 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 	})
 }
 
+func TestClient_CheckAvailableTokensBalanceCheckModes(t *testing.T) {
+	t.Parallel()
+
+	token := common.BytesToAddress([]byte("ERC20token1"))
+	argLists := argListsBatch{
+		tokens:       []common.Address{token, token},
+		sourceTokens: [][]byte{[]byte("token1"), []byte("token1")},
+		amounts:      []*big.Int{big.NewInt(60), big.NewInt(40)},
+	}
+	createClient := func(mode string) (*client, map[string]string) {
+		metrics := make(map[string]string)
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			SetStringMetricCalled: func(metric string, val string) {
+				metrics[metric] = val
+			},
+		}
+		args.TokensPolicy = &bridgeTests.TokensPolicyStub{
+			BalanceCheckModeCalled: func(erc20Address []byte, esdtTokenID []byte) string {
+				assert.Equal(t, token.Bytes(), erc20Address)
+				assert.Equal(t, []byte("token1"), esdtTokenID)
+				return mode
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c, metrics
+	}
+
+	t.Run("minter allowance", func(t *testing.T) {
+		c, metrics := createClient(tokensPolicy.BalanceCheckMinterAllowance)
+		allowance := big.NewInt(99)
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				assert.Fail(t, "should have not called BalanceOf")
+				return nil, nil
+			},
+			MinterAllowanceCalled: func(ctx context.Context, erc20Address common.Address, minter common.Address) (*big.Int, error) {
+				assert.Equal(t, c.safeContractAddress, minter)
+				return allowance, nil
+			},
+		}
+
+		_, err := c.checkAvailableTokens(context.Background(), argLists)
+		assert.True(t, errors.Is(err, errInsufficientErc20Balance))
+		assert.True(t, strings.Contains(err.Error(), "mode: minterAllowance"))
+		assert.Equal(t, token.String()+": minterAllowance", metrics[bridgeCore.MetricEthereumLastBatchBalanceChecks])
+
+		allowance = big.NewInt(100)
+		_, err = c.checkAvailableTokens(context.Background(), argLists)
+		assert.Nil(t, err)
+	})
+	t.Run("cap", func(t *testing.T) {
+		c, metrics := createClient(tokensPolicy.BalanceCheckCap)
+		totalSupply := big.NewInt(950)
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			CapCalled: func(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+				return big.NewInt(1000), nil
+			},
+			TotalSupplyCalled: func(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+				return totalSupply, nil
+			},
+		}
+
+		_, err := c.checkAvailableTokens(context.Background(), argLists)
+		assert.True(t, errors.Is(err, errInsufficientErc20Balance))
+		assert.Equal(t, token.String()+": cap", metrics[bridgeCore.MetricEthereumLastBatchBalanceChecks])
+
+		totalSupply = big.NewInt(1100)
+		_, err = c.checkAvailableTokens(context.Background(), argLists)
+		assert.True(t, errors.Is(err, errInsufficientErc20Balance))
+
+		totalSupply = big.NewInt(900)
+		_, err = c.checkAvailableTokens(context.Background(), argLists)
+		assert.Nil(t, err)
+	})
+	t.Run("cap query errors", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		c, _ := createClient(tokensPolicy.BalanceCheckCap)
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			CapCalled: func(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+
+		_, err := c.checkAvailableTokens(context.Background(), argLists)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("none", func(t *testing.T) {
+		c, metrics := createClient(tokensPolicy.BalanceCheckNone)
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				assert.Fail(t, "should have not called BalanceOf")
+				return nil, nil
+			},
+		}
+
+		balanceChecks, err := c.checkAvailableTokens(context.Background(), argLists)
+		assert.Nil(t, err)
+		assert.Equal(t, token.String()+": none", balanceChecks)
+		assert.Equal(t, token.String()+": none", metrics[bridgeCore.MetricEthereumLastBatchBalanceChecks])
+	})
+	t.Run("unknown mode", func(t *testing.T) {
+		c, _ := createClient("unknown")
+
+		_, err := c.checkAvailableTokens(context.Background(), argLists)
+		assert.True(t, errors.Is(err, errUnknownBalanceCheckMode))
+	})
+}
+
 func TestClient_GetTransactionsStatuses(t *testing.T) {
 	t.Parallel()
 
@@ -846,8 +1050,8 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 		incrementor = 0
 
 		// place a random message as to test it is reset
-		statusHandler.SetStringMetric(bridgeCore.MetricElrondClientStatus, ethElrond.ClientStatus(3).String())
-		statusHandler.SetStringMetric(bridgeCore.MetricLastElrondClientError, "random")
+		statusHandler.SetStringMetric(bridgeCore.MetricEthereumClientStatus, ethElrond.ClientStatus(3).String())
+		statusHandler.SetStringMetric(bridgeCore.MetricLastEthereumClientError, "random")
 
 		// this will just increment the retry counter
 		for i := 0; i < int(args.AllowDelta); i++ {
@@ -896,10 +1100,19 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 				return 0, expectedErr
 			},
 		}
+		var receivedAlert bridgeCore.Alert
+		c.alertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert bridgeCore.Alert) {
+				receivedAlert = alert
+			},
+		}
 
 		err := c.CheckClientAvailability(context.Background())
 		checkStatusHandler(t, statusHandler, ethElrond.Unavailable, expectedErr.Error())
 		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, bridgeCore.AlertClientUnavailable, receivedAlert.Type)
+		assert.Equal(t, expectedErr.Error(), receivedAlert.Message)
+		assert.Equal(t, "test", receivedAlert.Source)
 	})
 }
 
@@ -907,11 +1120,11 @@ func resetClient(c *client) {
 	c.mut.Lock()
 	c.retriesAvailabilityCheck = 0
 	c.mut.Unlock()
-	c.clientWrapper.SetStringMetric(bridgeCore.MetricElrondClientStatus, "")
-	c.clientWrapper.SetStringMetric(bridgeCore.MetricLastElrondClientError, "")
+	c.clientWrapper.SetStringMetric(bridgeCore.MetricEthereumClientStatus, "")
+	c.clientWrapper.SetStringMetric(bridgeCore.MetricLastEthereumClientError, "")
 }
 
 func checkStatusHandler(t *testing.T, statusHandler *testsCommon.StatusHandlerMock, status ethElrond.ClientStatus, message string) {
-	assert.Equal(t, status.String(), statusHandler.GetStringMetric(bridgeCore.MetricElrondClientStatus))
-	assert.Equal(t, message, statusHandler.GetStringMetric(bridgeCore.MetricLastElrondClientError))
+	assert.Equal(t, status.String(), statusHandler.GetStringMetric(bridgeCore.MetricEthereumClientStatus))
+	assert.Equal(t, message, statusHandler.GetStringMetric(bridgeCore.MetricLastEthereumClientError))
 }
@@ -0,0 +1,53 @@
+package contract
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MintBurnErc20ABI is the input ABI of the view functions exposed by the ERC20 tokens minted and burned by the Safe
+// contract: the minter allowance (as defined by the FiatToken contracts) and the cap (as defined by ERC20Capped)
+const MintBurnErc20ABI = "[{\"inputs\":[],\"name\":\"cap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"minter\",\"type\":\"address\"}],\"name\":\"minterAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// MintBurnErc20Caller is a read-only Go binding around the mint/burn ERC20 view functions
+type MintBurnErc20Caller struct {
+	contract *bind.BoundContract
+}
+
+// NewMintBurnErc20Caller creates a new read-only instance of MintBurnErc20Caller, bound to a specific deployed contract
+func NewMintBurnErc20Caller(address common.Address, caller bind.ContractCaller) (*MintBurnErc20Caller, error) {
+	parsed, err := abi.JSON(strings.NewReader(MintBurnErc20ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MintBurnErc20Caller{contract: bind.NewBoundContract(address, parsed, caller, nil, nil)}, nil
+}
+
+// Cap is a free data retrieval call binding the contract method 0x355274ea.
+//
+// Solidity: function cap() view returns(uint256)
+func (caller *MintBurnErc20Caller) Cap(opts *bind.CallOpts) (*big.Int, error) {
+	return caller.callUint256(opts, "cap")
+}
+
+// MinterAllowance is a free data retrieval call binding the contract method 0x8a6db9c3.
+//
+// Solidity: function minterAllowance(address minter) view returns(uint256)
+func (caller *MintBurnErc20Caller) MinterAllowance(opts *bind.CallOpts, minter common.Address) (*big.Int, error) {
+	return caller.callUint256(opts, "minterAllowance", minter)
+}
+
+func (caller *MintBurnErc20Caller) callUint256(opts *bind.CallOpts, method string, params ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	err := caller.contract.Call(opts, &out, method, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -21,6 +21,12 @@ type ArgsErc20SafeContractsHolder struct {
 	EthClientStatusHandler core.StatusHandler
 }
 
+// erc20Contract groups the generic ERC20 binding and the mint/burn view functions binding of the same token
+type erc20Contract struct {
+	*contract.GenericErc20
+	*contract.MintBurnErc20Caller
+}
+
 // erc20SafeContractsHolder represents the Erc20ContractsHolder implementation
 type erc20SafeContractsHolder struct {
 	mut                    sync.RWMutex
@@ -47,28 +53,77 @@ func NewErc20SafeContractsHolder(args ArgsErc20SafeContractsHolder) (*erc20SafeC
 // BalanceOf returns the ERC20 balance of the provided address
 // if the ERC20 contract does not exists in the map of contract wrappers, it will create and add it first
 func (h *erc20SafeContractsHolder) BalanceOf(ctx context.Context, erc20Address ethCommon.Address, address ethCommon.Address) (*big.Int, error) {
+	wrapper, err := h.getOrCreateWrapper(erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.BalanceOf(ctx, address)
+}
+
+// TotalSupply returns the total supply of the provided ERC20 token
+func (h *erc20SafeContractsHolder) TotalSupply(ctx context.Context, erc20Address ethCommon.Address) (*big.Int, error) {
+	wrapper, err := h.getOrCreateWrapper(erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.TotalSupply(ctx)
+}
+
+// Cap returns the maximum total supply of the provided capped ERC20 token
+func (h *erc20SafeContractsHolder) Cap(ctx context.Context, erc20Address ethCommon.Address) (*big.Int, error) {
+	wrapper, err := h.getOrCreateWrapper(erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Cap(ctx)
+}
+
+// MinterAllowance returns the amount of the provided ERC20 token the minter is still allowed to mint
+func (h *erc20SafeContractsHolder) MinterAllowance(ctx context.Context, erc20Address ethCommon.Address, minter ethCommon.Address) (*big.Int, error) {
+	wrapper, err := h.getOrCreateWrapper(erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.MinterAllowance(ctx, minter)
+}
+
+func (h *erc20SafeContractsHolder) getOrCreateWrapper(erc20Address ethCommon.Address) (erc20ContractWrapper, error) {
 	h.mut.Lock()
 	defer h.mut.Unlock()
 
 	wrapper, exists := h.contracts[erc20Address]
-	if !exists {
-		contractInstance, err := contract.NewGenericErc20(erc20Address, h.ethClient)
-		if err != nil {
-			return nil, fmt.Errorf("%w for %s", err, erc20Address.String())
-		}
-		args := wrappers.ArgsErc20ContractWrapper{
-			StatusHandler: h.ethClientStatusHandler,
-			Erc20Contract: contractInstance,
-		}
-		wrapper, err = wrappers.NewErc20ContractWrapper(args)
-		if err != nil {
-			return nil, err
-		}
-
-		h.contracts[erc20Address] = wrapper
+	if exists {
+		return wrapper, nil
 	}
 
-	return wrapper.BalanceOf(ctx, address)
+	genericContract, err := contract.NewGenericErc20(erc20Address, h.ethClient)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, erc20Address.String())
+	}
+	mintBurnContract, err := contract.NewMintBurnErc20Caller(erc20Address, h.ethClient)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, erc20Address.String())
+	}
+
+	args := wrappers.ArgsErc20ContractWrapper{
+		StatusHandler: h.ethClientStatusHandler,
+		Erc20Contract: &erc20Contract{
+			GenericErc20:        genericContract,
+			MintBurnErc20Caller: mintBurnContract,
+		},
+	}
+	wrapper, err = wrappers.NewErc20ContractWrapper(args)
+	if err != nil {
+		return nil, err
+	}
+
+	h.contracts[erc20Address] = wrapper
+
+	return wrapper, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
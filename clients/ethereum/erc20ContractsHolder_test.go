@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"testing"
@@ -160,6 +161,37 @@ func TestBalanceOf(t *testing.T) {
 	})
 }
 
+func TestMintLimitQueries(t *testing.T) {
+	t.Parallel()
+
+	selectors := map[string]int64{
+		"18160ddd": 700,  // totalSupply()
+		"355274ea": 1000, // cap()
+		"8a6db9c3": 300,  // minterAllowance(address)
+	}
+	args := createMockArgsContractsHolder()
+	args.EthClient = &bridgeTests.ContractBackendStub{
+		CallContractCalled: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			return convertBigToAbiCompatible(big.NewInt(selectors[hex.EncodeToString(call.Data[:4])])), nil
+		},
+	}
+	ch, _ := NewErc20SafeContractsHolder(args)
+	contractAddress := testsCommon.CreateRandomEthereumAddress()
+
+	result, err := ch.TotalSupply(context.Background(), contractAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(700), result)
+
+	result, err = ch.Cap(context.Background(), contractAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), result)
+
+	result, err = ch.MinterAllowance(context.Background(), contractAddress, testsCommon.CreateRandomEthereumAddress())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(300), result)
+	assert.Equal(t, 1, len(ch.contracts))
+}
+
 func convertBigToAbiCompatible(number *big.Int) []byte {
 	numberAsBytes := number.Bytes()
 	size := len(numberAsBytes)
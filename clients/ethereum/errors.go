@@ -15,4 +15,6 @@ var (
 	errInvalidGasLimit                     = errors.New("invalid gas limit")
 	errNilEthClient                        = errors.New("nil eth client")
 	errDepositsAndBatchDepositsCountDiffer = errors.New("deposits and batch.DepositsCount differs")
+	errUnknownBalanceCheckMode             = errors.New("unknown balance check mode")
+	errNilBatchGetter                      = errors.New("nil batch getter")
 )
@@ -11,11 +11,17 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
+// BatchGetter defines the operations able to read the batches stored in the Safe contract
+type BatchGetter interface {
+	GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, error)
+	GetBatchDeposits(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error)
+	IsInterfaceNil() bool
+}
+
 // ClientWrapper represents the Ethereum client wrapper that the ethereum client can rely on
 type ClientWrapper interface {
 	core.StatusHandler
-	GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, error)
-	GetBatchDeposits(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error)
+	BatchGetter
 	GetRelayers(ctx context.Context) ([]common.Address, error)
 	WasBatchExecuted(ctx context.Context, batchNonce *big.Int) (bool, error)
 	ChainID(ctx context.Context) (*big.Int, error)
@@ -33,12 +39,16 @@ type ClientWrapper interface {
 // Erc20ContractsHolder defines the Ethereum ERC20 contract operations
 type Erc20ContractsHolder interface {
 	BalanceOf(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error)
+	TotalSupply(ctx context.Context, erc20Address common.Address) (*big.Int, error)
+	Cap(ctx context.Context, erc20Address common.Address) (*big.Int, error)
+	MinterAllowance(ctx context.Context, erc20Address common.Address, minter common.Address) (*big.Int, error)
 	IsInterfaceNil() bool
 }
 
 // Broadcaster defines the operations for a component used for communication with other peers
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	IsInterfaceNil() bool
 }
 
@@ -48,6 +58,12 @@ type TokensMapper interface {
 	IsInterfaceNil() bool
 }
 
+// TokensPolicy defines the component able to tell how the balance of a listed token is checked
+type TokensPolicy interface {
+	BalanceCheckMode(erc20Address []byte, esdtTokenID []byte) string
+	IsInterfaceNil() bool
+}
+
 // GasHandler defines the component able to fetch the current gas price
 type GasHandler interface {
 	GetCurrentGasPrice() (*big.Int, error)
@@ -63,5 +79,8 @@ type SignaturesHolder interface {
 
 type erc20ContractWrapper interface {
 	BalanceOf(ctx context.Context, account common.Address) (*big.Int, error)
+	TotalSupply(ctx context.Context) (*big.Int, error)
+	Cap(ctx context.Context) (*big.Int, error)
+	MinterAllowance(ctx context.Context, minter common.Address) (*big.Int, error)
 	IsInterfaceNil() bool
 }
@@ -43,6 +43,24 @@ func (wrapper *erc20ContractWrapper) BalanceOf(ctx context.Context, account comm
 	return wrapper.erc20Contract.BalanceOf(&bind.CallOpts{Context: ctx}, account)
 }
 
+// TotalSupply returns the ERC20 total supply
+func (wrapper *erc20ContractWrapper) TotalSupply(ctx context.Context) (*big.Int, error) {
+	wrapper.statusHandler.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.erc20Contract.TotalSupply(&bind.CallOpts{Context: ctx})
+}
+
+// Cap returns the maximum total supply of a capped ERC20 token
+func (wrapper *erc20ContractWrapper) Cap(ctx context.Context) (*big.Int, error) {
+	wrapper.statusHandler.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.erc20Contract.Cap(&bind.CallOpts{Context: ctx})
+}
+
+// MinterAllowance returns the amount the provided minter is still allowed to mint
+func (wrapper *erc20ContractWrapper) MinterAllowance(ctx context.Context, minter common.Address) (*big.Int, error) {
+	wrapper.statusHandler.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.erc20Contract.MinterAllowance(&bind.CallOpts{Context: ctx}, minter)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wrapper *erc20ContractWrapper) IsInterfaceNil() bool {
 	return wrapper == nil
@@ -69,3 +69,53 @@ func TestErc20ContractWrapper_BalanceOf(t *testing.T) {
 	assert.True(t, handlerCalled)
 	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
 }
+
+func TestErc20ContractWrapper_TotalSupply(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsErc20ContractWrapper()
+	args.Erc20Contract = &interactors.GenericErc20ContractStub{
+		TotalSupplyCalled: func() (*big.Int, error) {
+			return big.NewInt(37), nil
+		},
+	}
+	wrapper, _ := NewErc20ContractWrapper(args)
+	totalSupply, err := wrapper.TotalSupply(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), totalSupply)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestErc20ContractWrapper_Cap(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsErc20ContractWrapper()
+	args.Erc20Contract = &interactors.GenericErc20ContractStub{
+		CapCalled: func() (*big.Int, error) {
+			return big.NewInt(100), nil
+		},
+	}
+	wrapper, _ := NewErc20ContractWrapper(args)
+	tokenCap, err := wrapper.Cap(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), tokenCap)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestErc20ContractWrapper_MinterAllowance(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsErc20ContractWrapper()
+	minter := common.BytesToAddress([]byte("minter"))
+	args.Erc20Contract = &interactors.GenericErc20ContractStub{
+		MinterAllowanceCalled: func(providedMinter common.Address) (*big.Int, error) {
+			assert.Equal(t, minter, providedMinter)
+			return big.NewInt(50), nil
+		},
+	}
+	wrapper, _ := NewErc20ContractWrapper(args)
+	allowance, err := wrapper.MinterAllowance(context.TODO(), minter)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), allowance)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
@@ -12,6 +12,9 @@ import (
 
 type genericErc20Contract interface {
 	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	Cap(opts *bind.CallOpts) (*big.Int, error)
+	MinterAllowance(opts *bind.CallOpts, minter common.Address) (*big.Int, error)
 }
 
 type multiSigContract interface {
@@ -1,6 +1,10 @@
 package disabled
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/gasManagement"
+)
 
 // DisabledGasStation implementation in case no gasStation is used
 type DisabledGasStation struct{}
@@ -10,6 +14,11 @@ func (dgs *DisabledGasStation) GetCurrentGasPrice() (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
+// Reload does nothing as there are no settings to be applied
+func (dgs *DisabledGasStation) Reload(_ gasManagement.ArgsGasStation) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dgs *DisabledGasStation) IsInterfaceNil() bool {
 	return dgs == nil
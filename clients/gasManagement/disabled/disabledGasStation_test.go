@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/gasManagement"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,4 +17,5 @@ func TestNewDisabledGasStation(t *testing.T) {
 	gasPrice, err := dgs.GetCurrentGasPrice()
 	assert.Equal(t, big.NewInt(0), gasPrice)
 	assert.Nil(t, err)
+	assert.Nil(t, dgs.Reload(gasManagement.ArgsGasStation{}))
 }
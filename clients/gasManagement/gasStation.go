@@ -112,7 +112,7 @@ func (gs *gasStation) processLoop(ctx context.Context) {
 	gs.loopStatus.SetValue(true)
 	defer gs.loopStatus.SetValue(false)
 
-	timer := time.NewTimer(gs.requestPollingInterval)
+	timer := time.NewTimer(gs.getRequestPollingInterval())
 	defer timer.Stop()
 
 	for {
@@ -129,22 +129,36 @@ func (gs *gasStation) processLoop(ctx context.Context) {
 }
 
 func (gs *gasStation) doRequestWithRetryMechanism(ctx context.Context) time.Duration {
-	requestContext, cancel := context.WithTimeout(ctx, gs.requestTime)
+	gs.mut.RLock()
+	requestTime := gs.requestTime
+	requestPollingInterval := gs.requestPollingInterval
+	requestRetryDelay := gs.requestRetryDelay
+	maximumFetchRetries := gs.maximumFetchRetries
+	gs.mut.RUnlock()
+
+	requestContext, cancel := context.WithTimeout(ctx, requestTime)
 	defer cancel()
 	err := gs.doRequest(requestContext)
 	if err == nil {
 		gs.fetchRetries = 0
-		return gs.requestPollingInterval
+		return requestPollingInterval
 	}
 
 	gs.fetchRetries++
-	if gs.fetchRetries <= gs.maximumFetchRetries {
+	if gs.fetchRetries <= maximumFetchRetries {
 		gs.log.Debug("gasHandler.processLoop", "message", err.Error())
-		return gs.requestRetryDelay
+		return requestRetryDelay
 	}
 
 	gs.log.Error("gasHandler.processLoop", "error", err.Error())
 	gs.fetchRetries = 0
+	return requestPollingInterval
+}
+
+func (gs *gasStation) getRequestPollingInterval() time.Duration {
+	gs.mut.RLock()
+	defer gs.mut.RUnlock()
+
 	return gs.requestPollingInterval
 }
 
@@ -183,7 +197,11 @@ func (gs *gasStation) doRequest(ctx context.Context) error {
 }
 
 func (gs *gasStation) doRequestReturningBytes(ctx context.Context) ([]byte, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, gs.requestURL, nil)
+	gs.mut.RLock()
+	requestURL := gs.requestURL
+	gs.mut.RUnlock()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +242,31 @@ func (gs *gasStation) GetCurrentGasPrice() (*big.Int, error) {
 	return result.Mul(result, gs.gasPriceMultiplier), nil
 }
 
+// Reload validates and applies new settings on the running gas station. The new polling intervals are used
+// starting with the next fetch cycle
+func (gs *gasStation) Reload(args ArgsGasStation) error {
+	err := checkArgs(args)
+	if err != nil {
+		return err
+	}
+
+	gs.mut.Lock()
+	gs.requestURL = args.RequestURL
+	gs.requestTime = args.RequestTime
+	gs.requestPollingInterval = args.RequestPollingInterval
+	gs.requestRetryDelay = args.RequestRetryDelay
+	gs.maximumFetchRetries = args.MaximumFetchRetries
+	gs.maximumGasPrice = args.MaximumGasPrice
+	gs.gasPriceSelector = args.GasPriceSelector
+	gs.gasPriceMultiplier = big.NewInt(int64(args.GasPriceMultiplier))
+	gs.mut.Unlock()
+
+	gs.log.Info("gas station settings reloaded", "maximum gas price", args.MaximumGasPrice,
+		"gas price selector", args.GasPriceSelector, "polling interval", args.RequestPollingInterval)
+
+	return nil
+}
+
 // Close will stop any started go routines
 func (gs *gasStation) Close() error {
 	gs.cancel()
@@ -407,6 +407,57 @@ func TestGasStation_GetCurrentGasPriceExceededMaximum(t *testing.T) {
 	_ = gs.Close()
 }
 
+func TestGasStation_Reload(t *testing.T) {
+	t.Parallel()
+
+	gsResponse := createMockGasStationResponse()
+	gsResponse.Result.SafeGasPrice = "101"
+	args := createMockArgsGasStation()
+	httpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+
+		resp, _ := json.Marshal(&gsResponse)
+		_, _ = rw.Write(resp)
+	}))
+	defer httpServer.Close()
+
+	args.RequestURL = httpServer.URL
+
+	gs, err := NewGasStation(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = gs.Close()
+	}()
+
+	time.Sleep(time.Millisecond * 500)
+	_, err = gs.GetCurrentGasPrice()
+	require.True(t, errors.Is(err, ErrGasPriceIsHigherThanTheMaximumSet))
+
+	t.Run("invalid args should error and keep the old settings", func(t *testing.T) {
+		newArgs := args
+		newArgs.MaximumGasPrice = 200
+		newArgs.GasPriceSelector = "invalid"
+
+		err = gs.Reload(newArgs)
+		assert.True(t, errors.Is(err, ErrInvalidGasPriceSelector))
+
+		_, err = gs.GetCurrentGasPrice()
+		assert.True(t, errors.Is(err, ErrGasPriceIsHigherThanTheMaximumSet))
+	})
+	t.Run("should apply the new maximum gas price", func(t *testing.T) {
+		newArgs := args
+		newArgs.MaximumGasPrice = 200
+		newArgs.GasPriceMultiplier = 1
+
+		err = gs.Reload(newArgs)
+		assert.Nil(t, err)
+
+		price, errGet := gs.GetCurrentGasPrice()
+		assert.Nil(t, errGet)
+		assert.Equal(t, big.NewInt(101), price)
+	})
+}
+
 func createMockGasStationResponse() gasStationResponse {
 	return gasStationResponse{
 		Status:  "1",
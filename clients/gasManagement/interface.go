@@ -1,8 +1,18 @@
 package gasManagement
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+)
 
 // HTTPClient is the interface we expect to call in order to do the HTTP requests
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// ReloadableGasHandler defines a gas handler whose settings can be changed while running
+type ReloadableGasHandler interface {
+	clients.GasHandler
+	Reload(args ArgsGasStation) error
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -26,6 +27,7 @@ type elrondRoleProvider struct {
 	dataGetter           DataGetter
 	log                  logger.Logger
 	whitelistedAddresses map[string]struct{}
+	lastUpdate           time.Time
 	mut                  sync.RWMutex
 }
 
@@ -83,6 +85,7 @@ func (erp *elrondRoleProvider) processResults(results [][]byte) error {
 
 	erp.mut.Lock()
 	erp.whitelistedAddresses = temporaryMap
+	erp.lastUpdate = time.Now()
 	erp.mut.Unlock()
 
 	erp.log.Debug("fetched whitelisted addresses:\n" + strings.Join(currentList, "\n"))
@@ -104,6 +107,15 @@ func (erp *elrondRoleProvider) IsWhitelisted(address core.AddressHandler) bool {
 	return exists
 }
 
+// LastUpdateTime returns the time of the last successful fetch of the whitelisted addresses or the zero time if
+// they were never fetched
+func (erp *elrondRoleProvider) LastUpdateTime() time.Time {
+	erp.mut.RLock()
+	defer erp.mut.RUnlock()
+
+	return erp.lastUpdate
+}
+
 // SortedPublicKeys will return all the sorted public keys
 func (erp *elrondRoleProvider) SortedPublicKeys() [][]byte {
 	erp.mut.RLock()
@@ -71,6 +71,7 @@ func TestElrondRoleProvider_ExecuteErrors(t *testing.T) {
 	erp, _ := NewElrondRoleProvider(args)
 	err := erp.Execute(context.TODO())
 	assert.Equal(t, expectedErr, err)
+	assert.True(t, erp.LastUpdateTime().IsZero())
 }
 
 func TestElrondProvider_ExecuteShouldWork(t *testing.T) {
@@ -106,6 +107,7 @@ func testElrondExecuteShouldWork(whitelistedAddresses [][]byte, expectedSortedPu
 		erp, _ := NewElrondRoleProvider(args)
 		err := erp.Execute(context.TODO())
 		assert.Nil(t, err)
+		assert.False(t, erp.LastUpdateTime().IsZero())
 
 		for _, addr := range whitelistedAddresses {
 			addressHandler := data.NewAddressFromBytes(addr)
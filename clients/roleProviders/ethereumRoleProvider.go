@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -24,6 +25,7 @@ type ethereumRoleProvider struct {
 	ethereumChainInteractor EthereumChainInteractor
 	log                     logger.Logger
 	whitelistedAddresses    map[common.Address]struct{}
+	lastUpdate              time.Time
 	mut                     sync.RWMutex
 }
 
@@ -77,6 +79,7 @@ func (erp *ethereumRoleProvider) processResults(results []common.Address) {
 		erp.whitelistedAddresses[addr] = struct{}{}
 		currentList = append(currentList, addr.String())
 	}
+	erp.lastUpdate = time.Now()
 	erp.mut.Unlock()
 
 	erp.log.Debug("fetched Ethereum whitelisted addresses:\n" + strings.Join(currentList, "\n"))
@@ -96,7 +99,7 @@ func (erp *ethereumRoleProvider) VerifyEthSignature(signature []byte, messageHas
 	}
 
 	address := crypto.PubkeyToAddress(*pk)
-	if !erp.isWhitelisted(address) {
+	if !erp.IsWhitelisted(address) {
 		return ErrAddressIsNotWhitelisted
 	}
 
@@ -113,7 +116,8 @@ func (erp *ethereumRoleProvider) VerifyEthSignature(signature []byte, messageHas
 	return nil
 }
 
-func (erp *ethereumRoleProvider) isWhitelisted(address common.Address) bool {
+// IsWhitelisted returns true if the provided address is whitelisted
+func (erp *ethereumRoleProvider) IsWhitelisted(address common.Address) bool {
 	erp.mut.RLock()
 	defer erp.mut.RUnlock()
 
@@ -122,6 +126,15 @@ func (erp *ethereumRoleProvider) isWhitelisted(address common.Address) bool {
 	return exists
 }
 
+// LastUpdateTime returns the time of the last successful fetch of the whitelisted addresses or the zero time if
+// they were never fetched
+func (erp *ethereumRoleProvider) LastUpdateTime() time.Time {
+	erp.mut.RLock()
+	defer erp.mut.RUnlock()
+
+	return erp.lastUpdate
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (erp *ethereumRoleProvider) IsInterfaceNil() bool {
 	return erp == nil
@@ -70,6 +70,7 @@ func TestEthereumRoleProvider_ExecuteErrorsInInteractor(t *testing.T) {
 	erp, _ := NewEthereumRoleProvider(args)
 	err := erp.Execute(context.TODO())
 	assert.Equal(t, expectedErr, err)
+	assert.True(t, erp.LastUpdateTime().IsZero())
 }
 
 func TestEthereumProvider_ExecuteShouldWork(t *testing.T) {
@@ -99,13 +100,14 @@ func testEthereumExecuteShouldWork(whitelistedAddresses []common.Address) func(t
 		erp, _ := NewEthereumRoleProvider(args)
 		err := erp.Execute(context.TODO())
 		assert.Nil(t, err)
+		assert.False(t, erp.LastUpdateTime().IsZero())
 
 		for _, addr := range whitelistedAddresses {
-			assert.True(t, erp.isWhitelisted(addr))
+			assert.True(t, erp.IsWhitelisted(addr))
 		}
 
 		randomAddress := common.HexToAddress("0x093c0B280ba430A9Cc9C3649FF34FCBf6347bC50")
-		assert.False(t, erp.isWhitelisted(randomAddress))
+		assert.False(t, erp.IsWhitelisted(randomAddress))
 		erp.mut.RLock()
 		assert.Equal(t, len(whitelistedAddresses), len(erp.whitelistedAddresses))
 		erp.mut.RUnlock()
@@ -0,0 +1,12 @@
+package tokensPolicy
+
+import "errors"
+
+var (
+	errInvalidTokenPolicy = errors.New("invalid token policy")
+	errTokenNotListed     = errors.New("token not listed")
+	errTokenDisabled      = errors.New("token disabled")
+	errNilAmount          = errors.New("nil amount")
+	errAmountBelowMinimum = errors.New("deposit amount below the minimum")
+	errAmountAboveMaximum = errors.New("deposit amount above the maximum")
+)
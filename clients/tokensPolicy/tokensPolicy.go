@@ -0,0 +1,266 @@
+package tokensPolicy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+)
+
+const (
+	// CustodyLocked is the custody model of the tokens held in the Safe contract
+	CustodyLocked = "locked"
+
+	// CustodyMintBurn is the custody model of the tokens minted and burned by the bridge
+	CustodyMintBurn = "mintBurn"
+
+	// BalanceCheckSafeBalance is the balance check mode that requires the Safe balance to cover the transfers
+	BalanceCheckSafeBalance = "safeBalance"
+
+	// BalanceCheckMinterAllowance is the balance check mode that requires the Safe minter allowance to cover the transfers
+	BalanceCheckMinterAllowance = "minterAllowance"
+
+	// BalanceCheckCap is the balance check mode that requires the difference between the token cap and its total
+	// supply to cover the transfers
+	BalanceCheckCap = "cap"
+
+	// BalanceCheckNone is the balance check mode of the mint/burn tokens without a mint limit
+	BalanceCheckNone = "none"
+
+	hexPrefix          = "0x"
+	erc20AddressLen    = 20
+	maxDecimals        = 36
+	esdtTickerSplitter = "-"
+)
+
+type tokenPolicy struct {
+	key              string
+	enabled          bool
+	custody          string
+	mintLimit        string
+	minDepositAmount *big.Int
+	maxDepositAmount *big.Int
+	ethereumDecimals uint32
+	elrondDecimals   uint32
+}
+
+type tokensPolicy struct {
+	byErc20Address map[string]*tokenPolicy
+	byEsdt         map[string]*tokenPolicy
+}
+
+// NewTokensPolicy creates the component holding the configured tokens allowlist and policies. The keys are either
+// 0x prefixed ERC20 addresses or ESDT token identifiers or tickers. An empty configuration disables the policy checks
+func NewTokensPolicy(tokensConfig map[string]config.TokenPolicyConfig) (*tokensPolicy, error) {
+	tp := &tokensPolicy{
+		byErc20Address: make(map[string]*tokenPolicy),
+		byEsdt:         make(map[string]*tokenPolicy),
+	}
+
+	for key, cfg := range tokensConfig {
+		policy, err := newTokenPolicy(key, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = tp.addPolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tp, nil
+}
+
+func newTokenPolicy(key string, cfg config.TokenPolicyConfig) (*tokenPolicy, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w, empty token key", errInvalidTokenPolicy)
+	}
+	if cfg.Custody != CustodyLocked && cfg.Custody != CustodyMintBurn {
+		return nil, fmt.Errorf("%w for token %s, unknown custody model %s", errInvalidTokenPolicy, key, cfg.Custody)
+	}
+	err := checkMintLimit(key, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.EthereumDecimals > maxDecimals || cfg.ElrondDecimals > maxDecimals {
+		return nil, fmt.Errorf("%w for token %s, the decimals can not exceed %d", errInvalidTokenPolicy, key, maxDecimals)
+	}
+
+	minDepositAmount, err := parseAmount(key, "MinDepositAmount", cfg.MinDepositAmount)
+	if err != nil {
+		return nil, err
+	}
+	maxDepositAmount, err := parseAmount(key, "MaxDepositAmount", cfg.MaxDepositAmount)
+	if err != nil {
+		return nil, err
+	}
+	if minDepositAmount != nil && maxDepositAmount != nil && minDepositAmount.Cmp(maxDepositAmount) > 0 {
+		return nil, fmt.Errorf("%w for token %s, MinDepositAmount is greater than MaxDepositAmount", errInvalidTokenPolicy, key)
+	}
+
+	return &tokenPolicy{
+		key:              key,
+		enabled:          cfg.Enabled,
+		custody:          cfg.Custody,
+		mintLimit:        cfg.MintLimit,
+		minDepositAmount: minDepositAmount,
+		maxDepositAmount: maxDepositAmount,
+		ethereumDecimals: cfg.EthereumDecimals,
+		elrondDecimals:   cfg.ElrondDecimals,
+	}, nil
+}
+
+func checkMintLimit(key string, cfg config.TokenPolicyConfig) error {
+	if cfg.Custody == CustodyLocked {
+		if len(cfg.MintLimit) > 0 {
+			return fmt.Errorf("%w for token %s, the mint limit can be set only for the %s tokens",
+				errInvalidTokenPolicy, key, CustodyMintBurn)
+		}
+
+		return nil
+	}
+
+	switch cfg.MintLimit {
+	case BalanceCheckMinterAllowance, BalanceCheckCap, BalanceCheckNone:
+		return nil
+	default:
+		return fmt.Errorf("%w for token %s, unknown mint limit %s", errInvalidTokenPolicy, key, cfg.MintLimit)
+	}
+}
+
+func parseAmount(key string, name string, value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%w for token %s, invalid %s %s", errInvalidTokenPolicy, key, name, value)
+	}
+
+	return amount, nil
+}
+
+func (tp *tokensPolicy) addPolicy(policy *tokenPolicy) error {
+	lookup := tp.byEsdt
+	normalizedKey := policy.key
+	if strings.HasPrefix(strings.ToLower(policy.key), hexPrefix) {
+		erc20Address, err := hex.DecodeString(policy.key[len(hexPrefix):])
+		if err != nil || len(erc20Address) != erc20AddressLen {
+			return fmt.Errorf("%w, invalid ERC20 address %s", errInvalidTokenPolicy, policy.key)
+		}
+
+		lookup = tp.byErc20Address
+		normalizedKey = hex.EncodeToString(erc20Address)
+	}
+
+	_, exists := lookup[normalizedKey]
+	if exists {
+		return fmt.Errorf("%w, token %s is listed more than once", errInvalidTokenPolicy, policy.key)
+	}
+	lookup[normalizedKey] = policy
+
+	return nil
+}
+
+// IsEnforced returns true if at least one token is listed
+func (tp *tokensPolicy) IsEnforced() bool {
+	return len(tp.byErc20Address)+len(tp.byEsdt) > 0
+}
+
+// CheckEthereumDeposit returns an error if the deposit of the provided amount, denominated in the Ethereum token
+// units, does not comply with the token policy. The ESDT token identifier can be empty if the token is not mapped
+func (tp *tokensPolicy) CheckEthereumDeposit(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error {
+	return tp.checkDeposit(erc20Address, esdtTokenID, amount, false)
+}
+
+// CheckElrondDeposit returns an error if the deposit of the provided amount, denominated in the ESDT token units,
+// does not comply with the token policy. The ERC20 address can be empty if the token is not mapped
+func (tp *tokensPolicy) CheckElrondDeposit(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error {
+	return tp.checkDeposit(erc20Address, esdtTokenID, amount, true)
+}
+
+// BalanceCheckMode returns how the Safe is checked to cover the transfers of the provided token. The Safe balance
+// is checked for the locked tokens and for the tokens without a policy
+func (tp *tokensPolicy) BalanceCheckMode(erc20Address []byte, esdtTokenID []byte) string {
+	policy := tp.getPolicy(erc20Address, esdtTokenID)
+	if policy == nil || policy.custody == CustodyLocked {
+		return BalanceCheckSafeBalance
+	}
+
+	return policy.mintLimit
+}
+
+func (tp *tokensPolicy) checkDeposit(erc20Address []byte, esdtTokenID []byte, amount *big.Int, isElrondAmount bool) error {
+	if !tp.IsEnforced() {
+		return nil
+	}
+
+	policy := tp.getPolicy(erc20Address, esdtTokenID)
+	if policy == nil {
+		return fmt.Errorf("%w, ERC20 address: %s, ESDT token: %s",
+			errTokenNotListed, hexPrefix+hex.EncodeToString(erc20Address), esdtTokenID)
+	}
+	if !policy.enabled {
+		return fmt.Errorf("%w, token: %s", errTokenDisabled, policy.key)
+	}
+	if amount == nil {
+		return fmt.Errorf("%w, token: %s", errNilAmount, policy.key)
+	}
+
+	amountDecimals := policy.ethereumDecimals
+	if isElrondAmount {
+		amountDecimals = policy.elrondDecimals
+	}
+	if policy.minDepositAmount != nil && compareAmount(amount, amountDecimals, policy.minDepositAmount, policy.ethereumDecimals) < 0 {
+		return fmt.Errorf("%w, token: %s, amount: %s, minimum: %s",
+			errAmountBelowMinimum, policy.key, amount.String(), policy.minDepositAmount.String())
+	}
+	if policy.maxDepositAmount != nil && compareAmount(amount, amountDecimals, policy.maxDepositAmount, policy.ethereumDecimals) > 0 {
+		return fmt.Errorf("%w, token: %s, amount: %s, maximum: %s",
+			errAmountAboveMaximum, policy.key, amount.String(), policy.maxDepositAmount.String())
+	}
+
+	return nil
+}
+
+func (tp *tokensPolicy) getPolicy(erc20Address []byte, esdtTokenID []byte) *tokenPolicy {
+	if len(erc20Address) > 0 {
+		policy, found := tp.byErc20Address[hex.EncodeToString(erc20Address)]
+		if found {
+			return policy
+		}
+	}
+	if len(esdtTokenID) == 0 {
+		return nil
+	}
+
+	policy, found := tp.byEsdt[string(esdtTokenID)]
+	if found {
+		return policy
+	}
+
+	ticker := strings.Split(string(esdtTokenID), esdtTickerSplitter)[0]
+
+	return tp.byEsdt[ticker]
+}
+
+// compareAmount compares the amounts expressed with different decimals without losing precision
+func compareAmount(amount *big.Int, amountDecimals uint32, limit *big.Int, limitDecimals uint32) int {
+	scaledAmount := big.NewInt(0).Mul(amount, pow10(limitDecimals))
+	scaledLimit := big.NewInt(0).Mul(limit, pow10(amountDecimals))
+
+	return scaledAmount.Cmp(scaledLimit)
+}
+
+func pow10(exponent uint32) *big.Int {
+	return big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tokensPolicy) IsInterfaceNil() bool {
+	return tp == nil
+}
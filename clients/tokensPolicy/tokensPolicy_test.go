@@ -0,0 +1,232 @@
+package tokensPolicy
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testErc20AddressHex = "0x3a4b5f0c3a4fae1e3e1a6c5dc7a3a6f4e0a7b8c9"
+	otherErc20Address   = "0x0000000000000000000000000000000000000001"
+)
+
+var testErc20Address, _ = hex.DecodeString(testErc20AddressHex[2:])
+
+func createMockTokensConfig() map[string]config.TokenPolicyConfig {
+	return map[string]config.TokenPolicyConfig{
+		"0x3A4B5F0C3A4FAE1E3E1A6C5DC7A3A6F4E0A7B8C9": {
+			Enabled:          true,
+			Custody:          CustodyLocked,
+			MinDepositAmount: "1000000",
+			MaxDepositAmount: "5000000",
+			EthereumDecimals: 6,
+			ElrondDecimals:   18,
+		},
+		"WEGLD": {
+			Enabled:          true,
+			Custody:          CustodyMintBurn,
+			MintLimit:        BalanceCheckMinterAllowance,
+			EthereumDecimals: 18,
+			ElrondDecimals:   18,
+		},
+		"MEX-455c57": {
+			Enabled: false,
+			Custody: CustodyLocked,
+		},
+	}
+}
+
+func TestNewTokensPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown custody model should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["WEGLD"]
+		cfg.Custody = "unknown"
+		tokensConfig["WEGLD"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "unknown custody model"))
+	})
+	t.Run("unknown mint limit should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["WEGLD"]
+		cfg.MintLimit = ""
+		tokensConfig["WEGLD"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "unknown mint limit"))
+	})
+	t.Run("mint limit for a locked token should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["MEX-455c57"]
+		cfg.MintLimit = BalanceCheckCap
+		tokensConfig["MEX-455c57"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "the mint limit can be set only"))
+	})
+	t.Run("invalid decimals should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["WEGLD"]
+		cfg.ElrondDecimals = maxDecimals + 1
+		tokensConfig["WEGLD"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["WEGLD"]
+		cfg.MinDepositAmount = "-1"
+		tokensConfig["WEGLD"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "invalid MinDepositAmount"))
+	})
+	t.Run("minimum greater than maximum should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		cfg := tokensConfig["WEGLD"]
+		cfg.MinDepositAmount = "10"
+		cfg.MaxDepositAmount = "9"
+		tokensConfig["WEGLD"] = cfg
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+	})
+	t.Run("invalid ERC20 address should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		tokensConfig["0x1234"] = config.TokenPolicyConfig{
+			Custody: CustodyLocked,
+		}
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "invalid ERC20 address"))
+	})
+	t.Run("same ERC20 address listed twice should error", func(t *testing.T) {
+		tokensConfig := createMockTokensConfig()
+		tokensConfig[testErc20AddressHex] = config.TokenPolicyConfig{
+			Custody: CustodyLocked,
+		}
+
+		tp, err := NewTokensPolicy(tokensConfig)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, errInvalidTokenPolicy))
+		assert.True(t, strings.Contains(err.Error(), "more than once"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		tp, err := NewTokensPolicy(createMockTokensConfig())
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(tp))
+		assert.True(t, tp.IsEnforced())
+	})
+	t.Run("empty config should not enforce", func(t *testing.T) {
+		tp, err := NewTokensPolicy(nil)
+		require.Nil(t, err)
+		assert.False(t, tp.IsEnforced())
+		assert.Nil(t, tp.CheckEthereumDeposit(testErc20Address, nil, big.NewInt(1)))
+		assert.Nil(t, tp.CheckElrondDeposit([]byte("MEX-455c57"), nil, big.NewInt(1)))
+	})
+}
+
+func TestTokensPolicy_CheckEthereumDeposit(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := NewTokensPolicy(createMockTokensConfig())
+	otherAddress, _ := hex.DecodeString(otherErc20Address[2:])
+
+	t.Run("unlisted token should error", func(t *testing.T) {
+		err := tp.CheckEthereumDeposit(otherAddress, []byte("USDC-c76f1f"), big.NewInt(1))
+		assert.True(t, errors.Is(err, errTokenNotListed))
+	})
+	t.Run("unmapped token should error", func(t *testing.T) {
+		err := tp.CheckEthereumDeposit(otherAddress, make([]byte, 0), big.NewInt(1))
+		assert.True(t, errors.Is(err, errTokenNotListed))
+	})
+	t.Run("disabled token should error", func(t *testing.T) {
+		err := tp.CheckEthereumDeposit(otherAddress, []byte("MEX-455c57"), big.NewInt(1))
+		assert.True(t, errors.Is(err, errTokenDisabled))
+	})
+	t.Run("nil amount should error", func(t *testing.T) {
+		err := tp.CheckEthereumDeposit(testErc20Address, []byte("USDC-c76f1f"), nil)
+		assert.True(t, errors.Is(err, errNilAmount))
+	})
+	t.Run("amount limits", func(t *testing.T) {
+		err := tp.CheckEthereumDeposit(testErc20Address, []byte("USDC-c76f1f"), big.NewInt(999999))
+		assert.True(t, errors.Is(err, errAmountBelowMinimum))
+
+		err = tp.CheckEthereumDeposit(testErc20Address, []byte("USDC-c76f1f"), big.NewInt(5000001))
+		assert.True(t, errors.Is(err, errAmountAboveMaximum))
+
+		assert.Nil(t, tp.CheckEthereumDeposit(testErc20Address, []byte("USDC-c76f1f"), big.NewInt(1000000)))
+		assert.Nil(t, tp.CheckEthereumDeposit(testErc20Address, []byte("USDC-c76f1f"), big.NewInt(5000000)))
+	})
+	t.Run("token listed by ticker", func(t *testing.T) {
+		assert.Nil(t, tp.CheckEthereumDeposit(otherAddress, []byte("WEGLD-bd4d79"), big.NewInt(1)))
+	})
+}
+
+func TestTokensPolicy_CheckElrondDeposit(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := NewTokensPolicy(createMockTokensConfig())
+
+	t.Run("unlisted token should error", func(t *testing.T) {
+		err := tp.CheckElrondDeposit([]byte("USDC-c76f1f"), make([]byte, 0), big.NewInt(1))
+		assert.True(t, errors.Is(err, errTokenNotListed))
+	})
+	t.Run("disabled token listed by identifier should error", func(t *testing.T) {
+		err := tp.CheckElrondDeposit([]byte("MEX-455c57"), make([]byte, 0), big.NewInt(1))
+		assert.True(t, errors.Is(err, errTokenDisabled))
+	})
+	t.Run("amount limits should use the decimals on both chains", func(t *testing.T) {
+		oneUnit := big.NewInt(1000000000000)
+
+		belowMinimum := big.NewInt(0).Mul(big.NewInt(1000000), oneUnit)
+		belowMinimum.Sub(belowMinimum, big.NewInt(1))
+		err := tp.CheckElrondDeposit([]byte("USDC-c76f1f"), testErc20Address, belowMinimum)
+		assert.True(t, errors.Is(err, errAmountBelowMinimum))
+
+		aboveMaximum := big.NewInt(0).Mul(big.NewInt(5000000), oneUnit)
+		aboveMaximum.Add(aboveMaximum, big.NewInt(1))
+		err = tp.CheckElrondDeposit([]byte("USDC-c76f1f"), testErc20Address, aboveMaximum)
+		assert.True(t, errors.Is(err, errAmountAboveMaximum))
+
+		maximum := big.NewInt(0).Mul(big.NewInt(5000000), oneUnit)
+		assert.Nil(t, tp.CheckElrondDeposit([]byte("USDC-c76f1f"), testErc20Address, maximum))
+	})
+}
+
+func TestTokensPolicy_BalanceCheckMode(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := NewTokensPolicy(createMockTokensConfig())
+	otherAddress, _ := hex.DecodeString(otherErc20Address[2:])
+
+	assert.Equal(t, BalanceCheckSafeBalance, tp.BalanceCheckMode(testErc20Address, []byte("USDC-c76f1f")))
+	assert.Equal(t, BalanceCheckSafeBalance, tp.BalanceCheckMode(otherAddress, []byte("USDC-c76f1f")))
+	assert.Equal(t, BalanceCheckMinterAllowance, tp.BalanceCheckMode(otherAddress, []byte("WEGLD-bd4d79")))
+
+	emptyPolicy, _ := NewTokensPolicy(nil)
+	assert.Equal(t, BalanceCheckSafeBalance, emptyPolicy.BalanceCheckMode(otherAddress, []byte("WEGLD-bd4d79")))
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/factory"
 	"github.com/ElrondNetwork/elrond-eth-bridge/p2p"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
 	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -106,6 +108,13 @@ func startRelay(ctx *cli.Context, version string) error {
 		return err
 	}
 
+	if len(cfg.Logs.LogLevel) > 0 {
+		err = logger.SetLogLevel(cfg.Logs.LogLevel)
+		if err != nil {
+			return err
+		}
+	}
+
 	apiRoutesConfig, err := loadApiConfig(flagsConfig.ConfigurationApiFile)
 	if err != nil {
 		return err
@@ -126,6 +135,7 @@ func startRelay(ctx *cli.Context, version string) error {
 	}
 
 	metricsHolder := status.NewMetricsHolder()
+	transitionsHistoryHolder := stateMachine.NewTransitionsHistoryHolder()
 	ethClientStatusHandler, err := status.NewStatusHandler(core.EthClientStatusHandlerName, statusStorer)
 	if err != nil {
 		return err
@@ -228,8 +238,10 @@ func startRelay(ctx *cli.Context, version string) error {
 		TimeForBootstrap:          timeForBootstrap,
 		TimeBeforeRepeatJoin:      timeBeforeRepeatJoin,
 		MetricsHolder:             metricsHolder,
+		TransitionsHistoryHolder:  transitionsHistoryHolder,
 		AppStatusHandler:          appStatusHandler.StatusHandler(),
 		ElrondClientStatusHandler: elrondClientStatusHandler,
+		AppVersion:                version,
 	}
 
 	ethToElrondComponents, err := factory.NewEthElrondBridgeComponents(args)
@@ -237,7 +249,7 @@ func startRelay(ctx *cli.Context, version string) error {
 		return err
 	}
 
-	webServer, err := factory.StartWebServer(configs, metricsHolder)
+	webServer, err := factory.StartWebServer(configs, metricsHolder, transitionsHistoryHolder, ethToElrondComponents, ethToElrondComponents, ethToElrondComponents, ethToElrondComponents, ethToElrondComponents)
 	if err != nil {
 		return err
 	}
@@ -249,12 +261,32 @@ func startRelay(ctx *cli.Context, version string) error {
 		return err
 	}
 
+	reloadSigs := make(chan os.Signal, 1)
+	signal.Notify(reloadSigs, syscall.SIGHUP)
+	go reloadConfigOnSignal(reloadSigs, ethToElrondComponents)
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigs
 
-	log.Info("application closing, calling Close on all subcomponents...")
+	go func() {
+		<-sigs
+		log.Warn("second signal received, forcing the application exit")
+		os.Exit(1)
+	}()
+
+	drainTimeout := time.Duration(configs.GeneralConfig.Relayer.Shutdown.DrainTimeoutInSeconds) * time.Second
+	log.Info("application closing, draining all subcomponents...", "timeout", drainTimeout)
+
+	drainContext, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	err = ethToElrondComponents.Drain(drainContext)
+	cancel()
+	if err != nil {
+		log.Warn("drain did not complete, closing anyway", "error", err)
+	}
+
+	log.Info("calling Close on all subcomponents...")
 
 	var lastErr error
 	err = ethToElrondComponents.Close()
@@ -270,6 +302,24 @@ func startRelay(ctx *cli.Context, version string) error {
 	return lastErr
 }
 
+func reloadConfigOnSignal(reloadSigs chan os.Signal, configReloader core.ConfigReloader) {
+	for range reloadSigs {
+		log.Info("SIGHUP received, reloading the config")
+
+		report, err := configReloader.ReloadConfig()
+		for _, change := range report.Rejected {
+			log.Warn("config change rejected", "path", change.Path, "old value", change.OldValue,
+				"new value", change.NewValue, "reason", change.Reason)
+		}
+		if err != nil {
+			log.Error("config reload failed", "error", err)
+			continue
+		}
+
+		log.Info("config reloaded", "num applied changes", len(report.Applied))
+	}
+}
+
 func loadConfig(filepath string) (config.Config, error) {
 	cfg := config.Config{}
 	err := elrondCore.LoadTomlFile(&cfg, filepath)
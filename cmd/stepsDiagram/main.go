@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps/elrondToEth"
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps/ethToElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/urfave/cli"
+)
+
+var log = logger.GetOrCreate("stepsDiagram")
+
+var (
+	// format defines the flag for the output format of the rendered diagrams
+	format = cli.StringFlag{
+		Name:  "format",
+		Usage: "The `format` of the rendered diagrams. Can be " + string(stateMachine.MermaidFormat) + " or " + string(stateMachine.GraphvizFormat),
+		Value: string(stateMachine.MermaidFormat),
+	}
+	// evmChain defines the flag for the EVM compatible chain used to name the state machines
+	evmChain = cli.StringFlag{
+		Name:  "chain",
+		Usage: "The EVM compatible `chain` used to name the state machines",
+		Value: string(chain.Ethereum),
+	}
+)
+
+// placeholderExecutor is used only to build the steps, the diagrams generation does not execute them
+type placeholderExecutor struct {
+	steps.Executor
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *placeholderExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Steps diagram CLI app"
+	app.Usage = "Renders the declared transitions of the bridge state machines as Mermaid or Graphviz diagrams"
+	app.Flags = []cli.Flag{
+		format,
+		evmChain,
+	}
+	app.Action = generateDiagrams
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func generateDiagrams(ctx *cli.Context) error {
+	diagramFormat := stateMachine.DiagramFormat(ctx.GlobalString(format.Name))
+	evmCompatibleChain := chain.Chain(ctx.GlobalString(evmChain.Name))
+	executor := &placeholderExecutor{}
+
+	ethToElrondSteps, err := ethToElrond.CreateSteps(executor)
+	if err != nil {
+		return err
+	}
+	err = printDiagram(evmCompatibleChain.EvmCompatibleChainToElrondName(), ethToElrondSteps, ethToElrond.GettingPendingBatchFromEthereum, diagramFormat)
+	if err != nil {
+		return err
+	}
+
+	elrondToEthSteps, err := elrondToEth.CreateSteps(executor)
+	if err != nil {
+		return err
+	}
+
+	return printDiagram(evmCompatibleChain.ElrondToEvmCompatibleChainName(), elrondToEthSteps, elrondToEth.GettingPendingBatchFromElrond, diagramFormat)
+}
+
+func printDiagram(name string, machineStates core.MachineStates, startStep core.StepIdentifier, diagramFormat stateMachine.DiagramFormat) error {
+	diagram, err := stateMachine.CreateTransitionsDiagram(name, machineStates, startStep, diagramFormat)
+	if err != nil {
+		return fmt.Errorf("%w for state machine %s", err, name)
+	}
+
+	fmt.Println(diagram)
+
+	return nil
+}
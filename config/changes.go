@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+const pathSeparator = "."
+
+// ComputeChanges returns the differences between the two provided configurations, one entry for each changed
+// leaf value. The paths are built from the field names and map keys, separated by dots
+// (e.g. Eth.GasStation.MaximumAllowedGasPrice). The result is sorted by path
+func ComputeChanges(oldConfig Config, newConfig Config) []core.ConfigChange {
+	changes := make([]core.ConfigChange, 0)
+	changes = appendChanges(changes, "", reflect.ValueOf(oldConfig), reflect.ValueOf(newConfig))
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+func appendChanges(changes []core.ConfigChange, path string, oldValue reflect.Value, newValue reflect.Value) []core.ConfigChange {
+	switch oldValue.Kind() {
+	case reflect.Struct:
+		valueType := oldValue.Type()
+		for i := 0; i < valueType.NumField(); i++ {
+			field := valueType.Field(i)
+			if len(field.PkgPath) > 0 {
+				// unexported field
+				continue
+			}
+
+			changes = appendChanges(changes, joinPath(path, field.Name), oldValue.Field(i), newValue.Field(i))
+		}
+
+		return changes
+	case reflect.Map:
+		for _, key := range mapKeys(oldValue, newValue) {
+			oldElement := mapElement(oldValue, key)
+			newElement := mapElement(newValue, key)
+			changes = appendChanges(changes, joinPath(path, fmt.Sprintf("%v", key.Interface())), oldElement, newElement)
+		}
+
+		return changes
+	default:
+		if reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+			return changes
+		}
+
+		return append(changes, core.ConfigChange{
+			Path:     path,
+			OldValue: fmt.Sprintf("%v", oldValue.Interface()),
+			NewValue: fmt.Sprintf("%v", newValue.Interface()),
+		})
+	}
+}
+
+func joinPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+
+	return path + pathSeparator + name
+}
+
+// mapKeys returns the union of the keys of the two maps, sorted by their string representation
+func mapKeys(oldMap reflect.Value, newMap reflect.Value) []reflect.Value {
+	keys := make(map[string]reflect.Value)
+	for _, key := range append(oldMap.MapKeys(), newMap.MapKeys()...) {
+		keys[fmt.Sprintf("%v", key.Interface())] = key
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sortedKeys := make([]reflect.Value, 0, len(names))
+	for _, name := range names {
+		sortedKeys = append(sortedKeys, keys[name])
+	}
+
+	return sortedKeys
+}
+
+// mapElement returns the element found under the provided key or the zero value if the key is missing
+func mapElement(m reflect.Value, key reflect.Value) reflect.Value {
+	element := m.MapIndex(key)
+	if element.IsValid() {
+		return element
+	}
+
+	return reflect.Zero(m.Type().Elem())
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockConfig() Config {
+	return Config{
+		Eth: EthereumConfig{
+			GasStation: GasStationConfig{
+				Enabled:                true,
+				MaximumAllowedGasPrice: 300,
+				GasPriceSelector:       "SafeGasPrice",
+			},
+		},
+		P2P: ConfigP2P{
+			InitialPeerList: []string{"peer1"},
+		},
+		StateMachine: map[string]ConfigStateMachine{
+			"EthereumToElrond": {
+				StepDurationInMillis: 12000,
+				StepsWatchdog: StepsWatchdogConfig{
+					MaxDwellTimesInSeconds: map[string]uint64{
+						"propose transfer": 1800,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestComputeChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same config should not return changes", func(t *testing.T) {
+		t.Parallel()
+
+		changes := ComputeChanges(createMockConfig(), createMockConfig())
+		assert.Empty(t, changes)
+	})
+	t.Run("changed values should be reported sorted by path", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createMockConfig()
+		newConfig.Eth.GasStation.MaximumAllowedGasPrice = 500
+		newConfig.Eth.GasStation.GasPriceSelector = "FastGasPrice"
+		newConfig.P2P.InitialPeerList = []string{"peer1", "peer2"}
+
+		changes := ComputeChanges(createMockConfig(), newConfig)
+		expectedChanges := []core.ConfigChange{
+			{
+				Path:     "Eth.GasStation.GasPriceSelector",
+				OldValue: "SafeGasPrice",
+				NewValue: "FastGasPrice",
+			},
+			{
+				Path:     "Eth.GasStation.MaximumAllowedGasPrice",
+				OldValue: "300",
+				NewValue: "500",
+			},
+			{
+				Path:     "P2P.InitialPeerList",
+				OldValue: "[peer1]",
+				NewValue: "[peer1 peer2]",
+			},
+		}
+		assert.Equal(t, expectedChanges, changes)
+	})
+	t.Run("maps should be compared by keys", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createMockConfig()
+		newConfig.StateMachine = map[string]ConfigStateMachine{
+			"EthereumToElrond": {
+				StepDurationInMillis: 6000,
+				StepsWatchdog: StepsWatchdogConfig{
+					MaxDwellTimesInSeconds: map[string]uint64{
+						"propose transfer": 1800,
+						"perform action":   600,
+					},
+				},
+			},
+			"ElrondToEthereum": {
+				StepDurationInMillis: 12000,
+			},
+		}
+
+		changes := ComputeChanges(createMockConfig(), newConfig)
+		expectedChanges := []core.ConfigChange{
+			{
+				Path:     "StateMachine.ElrondToEthereum.StepDurationInMillis",
+				OldValue: "0",
+				NewValue: "12000",
+			},
+			{
+				Path:     "StateMachine.EthereumToElrond.StepDurationInMillis",
+				OldValue: "12000",
+				NewValue: "6000",
+			},
+			{
+				Path:     "StateMachine.EthereumToElrond.StepsWatchdog.MaxDwellTimesInSeconds.perform action",
+				OldValue: "0",
+				NewValue: "600",
+			},
+		}
+		assert.Equal(t, expectedChanges, changes)
+	})
+}
@@ -15,30 +15,36 @@ type Configs struct {
 
 // Config general configuration struct
 type Config struct {
-	Eth            EthereumConfig
-	Elrond         ElrondConfig
-	P2P            ConfigP2P
-	StateMachine   map[string]ConfigStateMachine
-	Relayer        ConfigRelayer
-	Logs           LogsConfig
-	Antiflood      AntifloodConfig
-	BatchValidator BatchValidatorConfig
+	Eth              EthereumConfig
+	Elrond           ElrondConfig
+	P2P              ConfigP2P
+	StateMachine     map[string]ConfigStateMachine
+	Relayer          ConfigRelayer
+	Logs             LogsConfig
+	Antiflood        AntifloodConfig
+	BatchValidator   BatchValidatorConfig
+	BatchFingerprint BatchFingerprintConfig
+	Alerts           AlertsConfig
+	Health           HealthConfig
+	TokenRegistry    TokenRegistryConfig
+	Tokens           map[string]TokenPolicyConfig
 }
 
 // EthereumConfig represents the Ethereum Config parameters
 type EthereumConfig struct {
-	Chain                              chain.Chain
-	NetworkAddress                     string
-	MultisigContractAddress            string
-	SafeContractAddress                string
-	PrivateKeyFile                     string
-	IntervalToResendTxsInSeconds       uint64
-	GasLimitBase                       uint64
-	GasLimitForEach                    uint64
-	GasStation                         GasStationConfig
-	MaxRetriesOnQuorumReached          uint64
-	IntervalToWaitForTransferInSeconds uint64
-	MaxBlocksDelta                     uint64
+	Chain                               chain.Chain
+	NetworkAddress                      string
+	MultisigContractAddress             string
+	SafeContractAddress                 string
+	PrivateKeyFile                      string
+	IntervalToResendTxsInSeconds        uint64
+	GasLimitBase                        uint64
+	GasLimitForEach                     uint64
+	GasStation                          GasStationConfig
+	MaxRetriesOnQuorumReached           uint64
+	IntervalToWaitForTransferInSeconds  uint64
+	MaxBlocksDelta                      uint64
+	QuorumChecksBeforeSignaturesRequest uint64
 }
 
 // GasStationConfig represents the configuration for the gas station handler
@@ -56,11 +62,41 @@ type GasStationConfig struct {
 
 // ConfigP2P configuration for the P2P communication
 type ConfigP2P struct {
-	Port            string
-	Seed            string
-	InitialPeerList []string
-	ProtocolID      string
-	AntifloodConfig config.AntifloodConfig
+	Port               string
+	Seed               string
+	InitialPeerList    []string
+	ProtocolID         string
+	AntifloodConfig    config.AntifloodConfig
+	Messages           P2PMessagesConfig
+	SignaturesRequests P2PSignaturesRequestsConfig
+	PeerScoring        P2PPeerScoringConfig
+	Heartbeat          P2PHeartbeatConfig
+}
+
+// P2PMessagesConfig will hold the configuration for the encoding of the relayer p2p messages
+type P2PMessagesConfig struct {
+	Marshalizer         string
+	AcceptBothEncodings bool
+}
+
+// P2PSignaturesRequestsConfig will hold the configuration for the signatures requests sent between the relayers
+type P2PSignaturesRequestsConfig struct {
+	ResponsesIntervalInMillis uint64
+	RequestTimeoutInSeconds   uint64
+}
+
+// P2PPeerScoringConfig will hold the configuration for the penalization of the peers sending invalid messages
+type P2PPeerScoringConfig struct {
+	BanThreshold             uint32
+	BaseBanDurationInSeconds uint64
+	MaxBanDurationInSeconds  uint64
+	ScoreRetentionInSeconds  uint64
+}
+
+// P2PHeartbeatConfig will hold the configuration for the heartbeats exchanged by the relayers
+type P2PHeartbeatConfig struct {
+	IntervalInSeconds         uint64
+	SilenceThresholdInSeconds uint64
 }
 
 // ConfigRelayer configuration for general relayer configuration
@@ -68,12 +104,49 @@ type ConfigRelayer struct {
 	Marshalizer          config.MarshalizerConfig
 	RoleProvider         RoleProviderConfig
 	StatusMetricsStorage config.StorageConfig
+	BalanceMonitor       BalanceMonitorConfig
+	Shutdown             ShutdownConfig
+	SignaturesHolder     SignaturesHolderConfig
+}
+
+// ShutdownConfig the configuration for the graceful shutdown of the relayer
+type ShutdownConfig struct {
+	DrainTimeoutInSeconds uint64
+}
+
+// SignaturesHolderConfig represents the configuration for the component holding the signatures received from the
+// other relayers
+type SignaturesHolderConfig struct {
+	TimeToLiveInSeconds     uint64
+	MaxMessageHashes        int
+	MaxSignaturesPerHash    int
+	MaxHashesPerPublicKey   int
+	CleanupIntervalInMillis uint64
 }
 
 // ConfigStateMachine the configuration for the state machine
 type ConfigStateMachine struct {
 	StepDurationInMillis       uint64
 	IntervalForLeaderInSeconds uint64
+	TransitionsHistorySize     int
+	StepsWatchdog              StepsWatchdogConfig
+	Scheduling                 SchedulingConfig
+}
+
+// StepsWatchdogConfig the configuration for the state machine's stuck steps watchdog
+type StepsWatchdogConfig struct {
+	Enabled                bool
+	Action                 string
+	MaxDwellTimesInSeconds map[string]uint64
+}
+
+// SchedulingConfig the configuration for the adaptive scheduling of the state machine's steps
+type SchedulingConfig struct {
+	Enabled                     bool
+	FastIntervalInMillis        uint64
+	IdleIntervalInMillis        uint64
+	ErrorBackoffInitialInMillis uint64
+	ErrorBackoffMaxInMillis     uint64
 }
 
 // ContextFlagsConfig the configuration for flags
@@ -102,19 +175,136 @@ type AntifloodConfig struct {
 	WebServer WebServerAntifloodConfig
 }
 
-// BatchValidatorConfig represents the configuration for the batch validator
+// BatchValidatorConfig represents the configuration for the batch validators. The values up to ResponsePublicKey
+// configure the HTTP validator, the Composition ("and" or "or") defines how the verdicts of all the enabled
+// validators are combined
 type BatchValidatorConfig struct {
-	Enabled              bool
-	URL                  string
-	RequestTimeInSeconds int
+	Enabled                         bool
+	URL                             string
+	RequestTimeInSeconds            int
+	MaxRetries                      int
+	RetryDelayInMillis              int
+	CacheTTLInSeconds               int
+	CircuitBreakerThreshold         int
+	CircuitBreakerOpenTimeInSeconds int
+	ResponsePublicKey               string
+	Composition                     string
+	CrossCheck                      BatchCrossCheckConfig
+	TokensAllowlist                 BatchTokensAllowlistConfig
+	AmountBounds                    BatchAmountBoundsConfig
+}
+
+// BatchCrossCheckConfig represents the configuration for the batch validator that fetches the batch from an
+// independent Ethereum node or Elrond proxy
+type BatchCrossCheckConfig struct {
+	Enabled                bool
+	EthereumNetworkAddress string
+	ElrondNetworkAddress   string
+}
+
+// BatchTokensAllowlistConfig represents the configuration for the batch validator that checks the deposits against
+// the tokens policy
+type BatchTokensAllowlistConfig struct {
+	Enabled bool
+}
+
+// BatchAmountBoundsConfig represents the configuration for the batch validator that checks the batches against
+// sanity bounds. The maximum deposit amount is expressed in the source chain denomination
+type BatchAmountBoundsConfig struct {
+	Enabled             bool
+	MaxDepositsPerBatch int
+	MaxDepositAmount    string
+}
+
+// BatchFingerprintConfig represents the configuration for the batch fingerprint agreement between the relayers
+type BatchFingerprintConfig struct {
+	Enabled          bool
+	MinAgreeingPeers uint32
+	MaxFailedChecks  uint32
+}
+
+// TokenRegistryConfig represents the configuration for the cached and checked ERC20 - ESDT token mappings
+type TokenRegistryConfig struct {
+	CacheTTLInSeconds        uint64
+	RefreshIntervalInSeconds uint64
+	ExpectedMappings         []TokenMappingConfig
+}
+
+// TokenMappingConfig represents an expected mapping between an ERC20 address and an ESDT token identifier
+type TokenMappingConfig struct {
+	Erc20Address string
+	EsdtTokenID  string
+}
+
+// TokenPolicyConfig represents the bridging policy of a token, keyed by its ERC20 address or ESDT ticker. The deposit
+// amounts limits are expressed in the token's Ethereum denomination, an empty value meaning no limit. The MintLimit
+// defines how the amount the Safe can still mint is checked for the mint/burn tokens
+type TokenPolicyConfig struct {
+	Enabled          bool
+	Custody          string
+	MintLimit        string
+	MinDepositAmount string
+	MaxDepositAmount string
+	EthereumDecimals uint32
+	ElrondDecimals   uint32
+}
+
+// HealthConfig represents the configuration for the liveness and readiness probes
+type HealthConfig struct {
+	LivenessWindowInSeconds     uint64
+	ProgressWindowInSeconds     uint64
+	RoleProviderMaxAgeInSeconds uint64
+	MinConnectedPeers           int
+}
+
+// AlertsConfig represents the configuration for the alerts subsystem
+type AlertsConfig struct {
+	Enabled                         bool
+	QueueSize                       int
+	RequestTimeInSeconds            int
+	DefaultReAlertIntervalInSeconds uint64
+	ReAlertIntervalsInSeconds       map[string]uint64
+	Webhooks                        []WebhookNotifierConfig
+	File                            FileNotifierConfig
+}
+
+// WebhookNotifierConfig represents the configuration for a webhook alerts notifier
+type WebhookNotifierConfig struct {
+	Name   string
+	URL    string
+	Format string
+}
+
+// FileNotifierConfig represents the configuration for the file alerts notifier
+type FileNotifierConfig struct {
+	Enabled bool
+	Path    string
 }
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	TLS         ApiTLSConfig
+	Operator    OperatorApiConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiTLSConfig holds the configuration related to serving the Rest API over TLS
+type ApiTLSConfig struct {
+	Enabled                   bool
+	CertificateFile           string
+	KeyFile                   string
+	ClientCAFile              string
+	RequireClientCertificates bool
+}
+
+// OperatorApiConfig holds the configuration related to the authentication and the auditing of the operator routes
+type OperatorApiConfig struct {
+	TokenFile               string
+	AllowClientCertificates bool
+	AuditLogFile            string
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -123,7 +313,9 @@ type ApiLoggingConfig struct {
 
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
-	Routes []RouteConfig
+	Routes                   []RouteConfig
+	RequireClientCertificate bool
+	AllowedClients           []string
 }
 
 // RouteConfig holds the configuration for a single route
@@ -135,6 +327,7 @@ type RouteConfig struct {
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
+	LogLevel             string
 }
 
 // RoleProviderConfig is the configuration for the role provider component
@@ -142,6 +335,15 @@ type RoleProviderConfig struct {
 	PollingIntervalInMillis uint64
 }
 
+// BalanceMonitorConfig is the configuration for the relayer wallets balance monitor component
+type BalanceMonitorConfig struct {
+	Enabled                    bool
+	PollingIntervalInMillis    uint64
+	NumTransfersPerBatch       uint64
+	WarnThresholdInBatches     uint64
+	CriticalThresholdInBatches uint64
+}
+
 // ElrondConfig represents the Elrond Config parameters
 type ElrondConfig struct {
 	NetworkAddress                  string
@@ -155,6 +357,36 @@ type ElrondConfig struct {
 	ProxyRestAPIEntityType          string
 	ProxyMaxNoncesDelta             int
 	ProxyFinalityCheck              bool
+	TransactionOutcome              ElrondTransactionOutcomeConfig
+	GasEstimation                   ElrondGasEstimationConfig
+	ContractProfile                 string
+	ContractProfiles                []ElrondContractProfileConfig
+}
+
+// ElrondContractProfileConfig represents the endpoint names and the deposit arguments layout of a multisig contract
+// version. The operations not defined in Endpoints use the names of the current contract version
+type ElrondContractProfileConfig struct {
+	Name             string
+	VersionEndpoint  string
+	Version          string
+	DepositArguments []string
+	Endpoints        map[string]string
+}
+
+// ElrondGasEstimationConfig represents the configuration for estimating the gas limit of the Elrond transactions
+// through the proxy's transaction cost endpoint
+type ElrondGasEstimationConfig struct {
+	Enabled            bool
+	GasLimitMultiplier float64
+	MaxGasLimit        uint64
+}
+
+// ElrondTransactionOutcomeConfig represents the configuration for tracking the outcome of the sent Elrond transactions
+type ElrondTransactionOutcomeConfig struct {
+	PollingIntervalInMillis uint64
+	MaxWaitInSeconds        uint64
+	OutOfGasIncreasePercent uint64
+	MaxOutOfGasIncreases    uint64
 }
 
 // ElrondGasMapConfig represents the gas limits for Elrond operations
@@ -0,0 +1,68 @@
+package core
+
+// AlertType defines the type of operational alert
+type AlertType string
+
+const (
+	// AlertClientUnavailable is the alert type emitted when one of the chain clients becomes unavailable
+	AlertClientUnavailable AlertType = "client_unavailable"
+
+	// AlertMaxQuorumRetriesReached is the alert type emitted when the maximum number of quorum retries was reached
+	AlertMaxQuorumRetriesReached AlertType = "max_quorum_retries_reached"
+
+	// AlertInvalidBatch is the alert type emitted when the batch validator rejected a batch
+	AlertInvalidBatch AlertType = "invalid_batch"
+
+	// AlertContractPaused is the alert type emitted when one of the bridge contracts is paused
+	AlertContractPaused AlertType = "contract_paused"
+
+	// AlertNotWhitelisted is the alert type emitted when the relayer is no longer whitelisted
+	AlertNotWhitelisted AlertType = "not_whitelisted"
+
+	// AlertLowFunds is the alert type emitted when one of the relayer's wallets is running out of funds
+	AlertLowFunds AlertType = "low_funds"
+
+	// AlertStuckStep is the alert type emitted when a state machine exceeded the maximum dwell time in a step
+	AlertStuckStep AlertType = "stuck_step"
+
+	// AlertBatchFingerprintMismatch is the alert type emitted when another relayer reported a different fingerprint
+	// for the batch this relayer is about to sign
+	AlertBatchFingerprintMismatch AlertType = "batch_fingerprint_mismatch"
+
+	// AlertTokenMappingMismatch is the alert type emitted when an ERC20 - ESDT token mapping does not round-trip or
+	// differs from the expected mapping
+	AlertTokenMappingMismatch AlertType = "token_mapping_mismatch"
+)
+
+// AlertTypes contains all the defined alert types
+var AlertTypes = []AlertType{AlertClientUnavailable, AlertMaxQuorumRetriesReached, AlertInvalidBatch,
+	AlertContractPaused, AlertNotWhitelisted, AlertLowFunds, AlertStuckStep, AlertBatchFingerprintMismatch,
+	AlertTokenMappingMismatch}
+
+// AlertSeverity defines the severity of an operational alert
+type AlertSeverity string
+
+const (
+	// AlertSeverityInfo is the severity used for informative alerts
+	AlertSeverityInfo AlertSeverity = "info"
+
+	// AlertSeverityWarning is the severity used for alerts that need attention
+	AlertSeverityWarning AlertSeverity = "warning"
+
+	// AlertSeverityCritical is the severity used for alerts that need immediate action
+	AlertSeverityCritical AlertSeverity = "critical"
+)
+
+// Alert is the operational event dispatched towards the configured notifiers
+type Alert struct {
+	Type      AlertType     `json:"type"`
+	Severity  AlertSeverity `json:"severity"`
+	Source    string        `json:"source"`
+	Message   string        `json:"message"`
+	Timestamp int64         `json:"timestamp"`
+}
+
+// DeduplicationKey returns the key used when deciding if the alert was recently dispatched
+func (alert *Alert) DeduplicationKey() string {
+	return string(alert.Type) + "/" + alert.Source
+}
@@ -58,6 +58,93 @@ const (
 
 	// MetricLastBlockNonce represents the last block nonce queried
 	MetricLastBlockNonce = "last block nonce"
+
+	// MetricEthereumRelayerBalance represents the metric used to store the relayer's ethereum wallet balance
+	MetricEthereumRelayerBalance = "ethereum relayer balance"
+
+	// MetricEthereumEstimatedRemainingBatches represents the metric used to store the estimated number of batches
+	// the relayer's ethereum wallet balance can cover
+	MetricEthereumEstimatedRemainingBatches = "ethereum estimated remaining batches"
+
+	// MetricEthereumRelayerFundsLevel represents the metric used to store the funds level of the relayer's ethereum wallet
+	MetricEthereumRelayerFundsLevel = "ethereum relayer funds level"
+
+	// MetricElrondRelayerBalance represents the metric used to store the relayer's elrond wallet balance
+	MetricElrondRelayerBalance = "elrond relayer balance"
+
+	// MetricElrondEstimatedRemainingBatches represents the metric used to store the estimated number of batches
+	// the relayer's elrond wallet balance can cover
+	MetricElrondEstimatedRemainingBatches = "elrond estimated remaining batches"
+
+	// MetricElrondRelayerFundsLevel represents the metric used to store the funds level of the relayer's elrond wallet
+	MetricElrondRelayerFundsLevel = "elrond relayer funds level"
+
+	// MetricCurrentStepDwellTimeInSeconds represents the metric used to store the time spent by the state machine
+	// in the current step
+	MetricCurrentStepDwellTimeInSeconds = "current step dwell time in seconds"
+
+	// MetricCurrentStepNumEntries represents the metric used to store how many times the state machine entered
+	// the current step
+	MetricCurrentStepNumEntries = "current step number of entries"
+
+	// MetricNumStuckStepsDetected represents the metric used to count the number of times a step exceeded its
+	// maximum dwell time
+	MetricNumStuckStepsDetected = "num stuck steps detected"
+
+	// MetricLastStepHint represents the metric used to store the scheduling hint resulted from the last executed step
+	MetricLastStepHint = "last step hint"
+
+	// MetricStateMachinePaused represents the metric used to store whether the state machine was paused by an operator
+	MetricStateMachinePaused = "state machine paused"
+
+	// MetricSignaturesHolderNumMessageHashes represents the metric used to store the number of message hashes
+	// currently held by the signatures holder
+	MetricSignaturesHolderNumMessageHashes = "signatures holder num message hashes"
+
+	// MetricSignaturesHolderNumSignatures represents the metric used to store the number of signatures currently
+	// held by the signatures holder
+	MetricSignaturesHolderNumSignatures = "signatures holder num signatures"
+
+	// MetricSignaturesHolderNumEvictedMessageHashes represents the metric used to count the message hashes evicted
+	// from the signatures holder because they expired or the holder was full
+	MetricSignaturesHolderNumEvictedMessageHashes = "signatures holder num evicted message hashes"
+
+	// MetricSignaturesHolderNumDroppedSignatures represents the metric used to count the signatures dropped because
+	// their message hash already held the maximum number of signatures
+	MetricSignaturesHolderNumDroppedSignatures = "signatures holder num dropped signatures"
+
+	// MetricP2PNumPenalizedMessages represents the metric used to count the invalid messages received from the peers
+	MetricP2PNumPenalizedMessages = "p2p num penalized messages"
+
+	// MetricP2PNumScoredPeers represents the metric used to store the number of peers that sent invalid messages
+	MetricP2PNumScoredPeers = "p2p num scored peers"
+
+	// MetricP2PNumPeerBans represents the metric used to count the peers banned for sending invalid messages
+	MetricP2PNumPeerBans = "p2p num peer bans"
+
+	// MetricElrondLastEstimatedGasLimit represents the metric used to store the gas limit resulted from the last
+	// Elrond transaction cost simulation, multiplier included
+	MetricElrondLastEstimatedGasLimit = "elrond last estimated gas limit"
+
+	// MetricElrondLastConfiguredGasLimit represents the metric used to store the gas limit computed from the gas map
+	// for the last Elrond transaction
+	MetricElrondLastConfiguredGasLimit = "elrond last configured gas limit"
+
+	// MetricElrondNumGasEstimationFailures represents the metric used to count the Elrond transactions sent with the
+	// gas limit from the gas map because the cost simulation failed
+	MetricElrondNumGasEstimationFailures = "elrond num gas estimation failures"
+
+	// MetricEthereumLastBatchBalanceChecks represents the metric used to store, for each ERC20 token of the last
+	// executed batch, how the Safe was checked to cover the transfers
+	MetricEthereumLastBatchBalanceChecks = "ethereum last batch balance checks"
+
+	// MetricBatchValidatorsLastVerdicts represents the metric used to store the verdict of each batch validator for
+	// the last validated batch
+	MetricBatchValidatorsLastVerdicts = "batch validators last verdicts"
+
+	// MetricNumBatchValidatorsRejections represents the metric used to count the batches rejected by the batch
+	// validators, one count for each rejecting validator
+	MetricNumBatchValidatorsRejections = "num batch validators rejections"
 )
 
 // PersistedMetrics represents the array of metrics that should be persisted
@@ -71,4 +158,17 @@ const (
 
 	// ElrondClientStatusHandlerName is the elrond client status handler name
 	ElrondClientStatusHandlerName = "elrond-client"
+
+	// CrossCheckEthClientStatusHandlerName is the status handler name of the ethereum client used by the cross-check
+	// batch validator
+	CrossCheckEthClientStatusHandlerName = "cross-check-eth-client"
+
+	// BalanceMonitorStatusHandlerName is the relayer wallets balance monitor status handler name
+	BalanceMonitorStatusHandlerName = "balance-monitor"
+
+	// SignaturesHolderStatusHandlerName is the signatures holder status handler name
+	SignaturesHolderStatusHandlerName = "signatures-holder"
+
+	// PeerScoringStatusHandlerName is the p2p peer scoring status handler name
+	PeerScoringStatusHandlerName = "peer-scoring"
 )
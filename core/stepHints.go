@@ -0,0 +1,29 @@
+package core
+
+// StepHint defines the scheduling hint resulted from a state machine step execution
+type StepHint string
+
+const (
+	// StepHintNone signals that the step did not provide any scheduling hint
+	StepHintNone StepHint = ""
+
+	// StepHintProgress signals that the state machine advanced to another step and should be ticked fast
+	StepHintProgress StepHint = "progress"
+
+	// StepHintWaiting signals that the step waits for an external event (e.g. a quorum) and should be ticked at the regular pace
+	StepHintWaiting StepHint = "waiting"
+
+	// StepHintRetrySoon signals that the step should be retried as soon as possible
+	StepHintRetrySoon StepHint = "retry soon"
+
+	// StepHintNothingToDo signals that the state machine is idle and should be ticked slowly
+	StepHintNothingToDo StepHint = "nothing to do"
+
+	// StepHintClientError signals that the step failed because of a client error and the ticks should back off
+	StepHintClientError StepHint = "client error"
+)
+
+// StepHintProvider defines a step able to provide a scheduling hint about its last execution
+type StepHintProvider interface {
+	ConsumeHint() StepHint
+}
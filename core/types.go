@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 )
 
 // StepIdentifier defines a step name
@@ -14,9 +15,21 @@ type MachineStates map[StepIdentifier]Step
 type Step interface {
 	Execute(ctx context.Context) StepIdentifier
 	Identifier() StepIdentifier
+	AllowedTransitions() []StepIdentifier
 	IsInterfaceNil() bool
 }
 
+// StepTransition holds the details of a state machine transition between two different steps
+type StepTransition struct {
+	From             StepIdentifier `json:"from"`
+	To               StepIdentifier `json:"to"`
+	Timestamp        int64          `json:"timestamp"`
+	DurationInMillis int64          `json:"durationInMillis"`
+	BatchID          uint64         `json:"batchID"`
+	ActionID         uint64         `json:"actionID"`
+	Forced           bool           `json:"forced"`
+}
+
 // EthGasPriceSelector defines the ethereum gas price selector
 type EthGasPriceSelector string
 
@@ -45,6 +58,110 @@ type BroadcastClient interface {
 	IsInterfaceNil() bool
 }
 
+// ConfigChange holds a difference between the running configuration and the newly loaded one
+type ConfigChange struct {
+	Path     string `json:"path"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ConfigReloadReport holds the outcome of a configuration reload. If any change is rejected, none is applied
+type ConfigReloadReport struct {
+	Applied  []ConfigChange `json:"applied"`
+	Rejected []ConfigChange `json:"rejected"`
+}
+
+// ConfigReloader defines a component able to re-read its configuration and apply the changes while running
+type ConfigReloader interface {
+	ReloadConfig() (ConfigReloadReport, error)
+	IsInterfaceNil() bool
+}
+
+// HealthCheck holds the result of a single health check
+type HealthCheck struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+// HealthReport holds the results of all the health checks of a probe. It is healthy only if all the checks are
+type HealthReport struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []HealthCheck `json:"checks"`
+}
+
+// HealthChecker defines a component able to tell if the relayer is alive and if it is ready to do its job
+type HealthChecker interface {
+	Liveness() HealthReport
+	Readiness() HealthReport
+	IsInterfaceNil() bool
+}
+
+// PeerScore holds the penalties accumulated by a peer ID or by a relayer public key for the invalid messages it sent
+type PeerScore struct {
+	Identifier  string    `json:"identifier"`
+	Type        string    `json:"type"`
+	Score       uint32    `json:"score"`
+	NumBans     uint32    `json:"numBans"`
+	LastReason  string    `json:"lastReason"`
+	LastPenalty time.Time `json:"lastPenalty"`
+	BannedUntil time.Time `json:"bannedUntil,omitempty"`
+}
+
+// PeerScoresProvider defines a component able to provide the current peer scores
+type PeerScoresProvider interface {
+	PeerScores() []PeerScore
+	IsInterfaceNil() bool
+}
+
+// HeartbeatProcessor defines a component that will get notified by the broadcaster when a relayer's heartbeat arrives
+type HeartbeatProcessor interface {
+	ProcessHeartbeat(publicKey []byte, heartbeat *Heartbeat)
+	IsInterfaceNil() bool
+}
+
+// BatchFingerprintProcessor defines a component that will get notified by the broadcaster when a relayer's batch
+// fingerprint arrives
+type BatchFingerprintProcessor interface {
+	ProcessBatchFingerprint(publicKey []byte, fingerprint *BatchFingerprint)
+	IsInterfaceNil() bool
+}
+
+// RelayerStatus holds the state of a whitelisted relayer as reported in its last heartbeat
+type RelayerStatus struct {
+	Address           string                   `json:"address"`
+	IsSelf            bool                     `json:"isSelf"`
+	IsSilent          bool                     `json:"isSilent"`
+	LastSeen          time.Time                `json:"lastSeen"`
+	AppVersion        string                   `json:"appVersion"`
+	ClockSkewInMillis int64                    `json:"clockSkewInMillis"`
+	StateMachines     []*HeartbeatStateMachine `json:"stateMachines"`
+	Clients           []*HeartbeatClient       `json:"clients"`
+}
+
+// ClusterInfoProvider defines a component able to provide the state of all the whitelisted relayers
+type ClusterInfoProvider interface {
+	ClusterInfo() []RelayerStatus
+	IsInterfaceNil() bool
+}
+
+// BridgeOperator defines the operations an operator can manually trigger on the running bridge
+type BridgeOperator interface {
+	PauseStateMachine(name string) error
+	ResumeStateMachine(name string) error
+	ForceStartStep(ctx context.Context, name string) error
+	ClearStoredSignatures()
+	RefreshRoleProviders(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// Flusher defines a component holding pending records that should be flushed before the application closes
+type Flusher interface {
+	Flush(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
 // StatusHandler is able to keep metrics
 type StatusHandler interface {
 	SetIntMetric(metric string, value int)
@@ -63,6 +180,27 @@ type MetricsHolder interface {
 	IsInterfaceNil() bool
 }
 
+// TransitionsHistoryProvider defines the component able to provide its recent state machine transitions
+type TransitionsHistoryProvider interface {
+	Name() string
+	GetTransitionsHistory() []StepTransition
+	IsInterfaceNil() bool
+}
+
+// TransitionsHistoryHolder represents the component that can hold the transitions history of state machines
+type TransitionsHistoryHolder interface {
+	AddTransitionsHistoryProvider(provider TransitionsHistoryProvider) error
+	GetAvailableStateMachines() []string
+	GetTransitionsHistory(name string) ([]StepTransition, error)
+	IsInterfaceNil() bool
+}
+
+// AlertHandler defines the component able to dispatch operational alerts
+type AlertHandler interface {
+	Alert(alert Alert)
+	IsInterfaceNil() bool
+}
+
 // Storer defines a component able to store and load data
 type Storer interface {
 	Put(key, data []byte) error
@@ -4,3 +4,21 @@ import "errors"
 
 // ErrNilMetricsHolder signals that a nil metrics holder was provided
 var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilTransitionsHistoryHolder signals that a nil transitions history holder was provided
+var ErrNilTransitionsHistoryHolder = errors.New("nil transitions history holder")
+
+// ErrNilConfigReloader signals that a nil config reloader was provided
+var ErrNilConfigReloader = errors.New("nil config reloader")
+
+// ErrNilBridgeOperator signals that a nil bridge operator was provided
+var ErrNilBridgeOperator = errors.New("nil bridge operator")
+
+// ErrNilHealthChecker signals that a nil health checker was provided
+var ErrNilHealthChecker = errors.New("nil health checker")
+
+// ErrNilPeerScoresProvider signals that a nil peer scores provider was provided
+var ErrNilPeerScoresProvider = errors.New("nil peer scores provider")
+
+// ErrNilClusterInfoProvider signals that a nil cluster info provider was provided
+var ErrNilClusterInfoProvider = errors.New("nil cluster info provider")
@@ -1,23 +1,38 @@
 package facade
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 const availableMetrics = "available metrics"
 
 // ArgsRelayerFacade represents the DTO struct used in the relayer facade constructor
 type ArgsRelayerFacade struct {
-	MetricsHolder core.MetricsHolder
-	ApiInterface  string
-	PprofEnabled  bool
+	MetricsHolder            core.MetricsHolder
+	TransitionsHistoryHolder core.TransitionsHistoryHolder
+	ConfigReloader           core.ConfigReloader
+	BridgeOperator           core.BridgeOperator
+	HealthChecker            core.HealthChecker
+	PeerScoresProvider       core.PeerScoresProvider
+	ClusterInfoProvider      core.ClusterInfoProvider
+	ApiInterface             string
+	PprofEnabled             bool
 }
 
 type relayerFacade struct {
-	metricsHolder core.MetricsHolder
-	apiInterface  string
-	pprofEnabled  bool
+	metricsHolder            core.MetricsHolder
+	transitionsHistoryHolder core.TransitionsHistoryHolder
+	configReloader           core.ConfigReloader
+	bridgeOperator           core.BridgeOperator
+	healthChecker            core.HealthChecker
+	peerScoresProvider       core.PeerScoresProvider
+	clusterInfoProvider      core.ClusterInfoProvider
+	apiInterface             string
+	pprofEnabled             bool
 }
 
 // NewRelayerFacade is the implementation of the relayer facade
@@ -25,11 +40,35 @@ func NewRelayerFacade(args ArgsRelayerFacade) (*relayerFacade, error) {
 	if check.IfNil(args.MetricsHolder) {
 		return nil, ErrNilMetricsHolder
 	}
+	if check.IfNil(args.TransitionsHistoryHolder) {
+		return nil, ErrNilTransitionsHistoryHolder
+	}
+	if check.IfNil(args.ConfigReloader) {
+		return nil, ErrNilConfigReloader
+	}
+	if check.IfNil(args.BridgeOperator) {
+		return nil, ErrNilBridgeOperator
+	}
+	if check.IfNil(args.HealthChecker) {
+		return nil, ErrNilHealthChecker
+	}
+	if check.IfNil(args.PeerScoresProvider) {
+		return nil, ErrNilPeerScoresProvider
+	}
+	if check.IfNil(args.ClusterInfoProvider) {
+		return nil, ErrNilClusterInfoProvider
+	}
 
 	return &relayerFacade{
-		apiInterface:  args.ApiInterface,
-		pprofEnabled:  args.PprofEnabled,
-		metricsHolder: args.MetricsHolder,
+		apiInterface:             args.ApiInterface,
+		pprofEnabled:             args.PprofEnabled,
+		metricsHolder:            args.MetricsHolder,
+		transitionsHistoryHolder: args.TransitionsHistoryHolder,
+		configReloader:           args.ConfigReloader,
+		bridgeOperator:           args.BridgeOperator,
+		healthChecker:            args.HealthChecker,
+		peerScoresProvider:       args.PeerScoresProvider,
+		clusterInfoProvider:      args.ClusterInfoProvider,
 	}, nil
 }
 
@@ -59,6 +98,67 @@ func (rf *relayerFacade) GetMetricsList() core.GeneralMetrics {
 	return result
 }
 
+// GetStateMachineHistory returns the most recent transitions of the specified state machine. Errors if the
+// state machine is not found
+func (rf *relayerFacade) GetStateMachineHistory(name string) ([]core.StepTransition, error) {
+	return rf.transitionsHistoryHolder.GetTransitionsHistory(name)
+}
+
+// ReloadConfig re-reads the configuration file and applies the changes that do not require a restart
+func (rf *relayerFacade) ReloadConfig() (core.ConfigReloadReport, error) {
+	return rf.configReloader.ReloadConfig()
+}
+
+// PauseStateMachine pauses the specified state machine. Errors if the state machine is not found
+func (rf *relayerFacade) PauseStateMachine(name string) error {
+	return rf.bridgeOperator.PauseStateMachine(name)
+}
+
+// ResumeStateMachine resumes the specified state machine. Errors if the state machine is not found
+func (rf *relayerFacade) ResumeStateMachine(name string) error {
+	return rf.bridgeOperator.ResumeStateMachine(name)
+}
+
+// ForceStartStep forces the specified state machine back to its start step. Errors if the state machine is not found
+func (rf *relayerFacade) ForceStartStep(ctx context.Context, name string) error {
+	return rf.bridgeOperator.ForceStartStep(ctx, name)
+}
+
+// ClearStoredSignatures clears the stored p2p signatures
+func (rf *relayerFacade) ClearStoredSignatures() {
+	rf.bridgeOperator.ClearStoredSignatures()
+}
+
+// RefreshRoleProviders fetches right away the whitelisted relayers from both chains
+func (rf *relayerFacade) RefreshRoleProviders(ctx context.Context) error {
+	return rf.bridgeOperator.RefreshRoleProviders(ctx)
+}
+
+// SetLogLevel changes the log level pattern of the running relayer
+func (rf *relayerFacade) SetLogLevel(logLevel string) error {
+	return logger.SetLogLevel(logLevel)
+}
+
+// GetLiveness returns the liveness report of the relayer
+func (rf *relayerFacade) GetLiveness() core.HealthReport {
+	return rf.healthChecker.Liveness()
+}
+
+// GetReadiness returns the readiness report of the relayer
+func (rf *relayerFacade) GetReadiness() core.HealthReport {
+	return rf.healthChecker.Readiness()
+}
+
+// GetPeerScores returns the scores of the peers that sent invalid messages
+func (rf *relayerFacade) GetPeerScores() []core.PeerScore {
+	return rf.peerScoresProvider.PeerScores()
+}
+
+// GetClusterInfo returns the state of every whitelisted relayer as reported in the received heartbeats
+func (rf *relayerFacade) GetClusterInfo() []core.RelayerStatus {
+	return rf.clusterInfoProvider.ClusterInfo()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rf *relayerFacade) IsInterfaceNil() bool {
 	return rf == nil
@@ -1,10 +1,12 @@
 package facade
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -14,9 +16,15 @@ import (
 
 func createMockArguments() ArgsRelayerFacade {
 	return ArgsRelayerFacade{
-		MetricsHolder: status.NewMetricsHolder(),
-		ApiInterface:  core.WebServerOffString,
-		PprofEnabled:  true,
+		MetricsHolder:            status.NewMetricsHolder(),
+		TransitionsHistoryHolder: stateMachine.NewTransitionsHistoryHolder(),
+		ConfigReloader:           &testsCommon.ConfigReloaderStub{},
+		BridgeOperator:           &testsCommon.BridgeOperatorStub{},
+		HealthChecker:            &testsCommon.HealthCheckerStub{},
+		PeerScoresProvider:       &testsCommon.PeerScoresProviderStub{},
+		ClusterInfoProvider:      &testsCommon.ClusterInfoProviderStub{},
+		ApiInterface:             core.WebServerOffString,
+		PprofEnabled:             true,
 	}
 }
 
@@ -31,6 +39,54 @@ func TestNewRelayerFacade(t *testing.T) {
 		assert.True(t, check.IfNil(facade))
 		assert.True(t, errors.Is(err, ErrNilMetricsHolder))
 	})
+	t.Run("nil transitions history holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.TransitionsHistoryHolder = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilTransitionsHistoryHolder))
+	})
+	t.Run("nil config reloader should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.ConfigReloader = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilConfigReloader))
+	})
+	t.Run("nil bridge operator should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.BridgeOperator = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilBridgeOperator))
+	})
+	t.Run("nil health checker should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.HealthChecker = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilHealthChecker))
+	})
+	t.Run("nil peer scores provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.PeerScoresProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilPeerScoresProvider))
+	})
+	t.Run("nil cluster info provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.ClusterInfoProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilClusterInfoProvider))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArguments()
 
@@ -103,3 +159,163 @@ func TestRelayerFacade_GetMetricsList(t *testing.T) {
 	expected[availableMetrics] = []string{"mock1", "mock2"}
 	assert.Equal(t, expected, response)
 }
+
+func TestRelayerFacade_GetStateMachineHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedHistory := []core.StepTransition{
+		{
+			From: "step1",
+			To:   "step2",
+		},
+	}
+	args := createMockArguments()
+	args.TransitionsHistoryHolder = &testsCommon.TransitionsHistoryHolderStub{
+		GetTransitionsHistoryCalled: func(name string) ([]core.StepTransition, error) {
+			assert.Equal(t, "EthereumToElrond", name)
+			return expectedHistory, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	history, err := facade.GetStateMachineHistory("EthereumToElrond")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHistory, history)
+}
+
+func TestRelayerFacade_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	expectedReport := core.ConfigReloadReport{
+		Rejected: []core.ConfigChange{
+			{
+				Path:     "P2P.Port",
+				OldValue: "10010",
+				NewValue: "10011",
+				Reason:   "requires a restart",
+			},
+		},
+	}
+	args := createMockArguments()
+	args.ConfigReloader = &testsCommon.ConfigReloaderStub{
+		ReloadConfigCalled: func() (core.ConfigReloadReport, error) {
+			return expectedReport, expectedErr
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	report, err := facade.ReloadConfig()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, expectedReport, report)
+}
+
+func TestRelayerFacade_BridgeOperations(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	calls := make([]string, 0)
+	args := createMockArguments()
+	args.BridgeOperator = &testsCommon.BridgeOperatorStub{
+		PauseStateMachineCalled: func(name string) error {
+			calls = append(calls, "pause "+name)
+			return nil
+		},
+		ResumeStateMachineCalled: func(name string) error {
+			calls = append(calls, "resume "+name)
+			return nil
+		},
+		ForceStartStepCalled: func(ctx context.Context, name string) error {
+			calls = append(calls, "force start step "+name)
+			return expectedErr
+		},
+		ClearStoredSignaturesCalled: func() {
+			calls = append(calls, "clear signatures")
+		},
+		RefreshRoleProvidersCalled: func(ctx context.Context) error {
+			calls = append(calls, "refresh role providers")
+			return nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Nil(t, facade.PauseStateMachine("EthereumToElrond"))
+	assert.Nil(t, facade.ResumeStateMachine("EthereumToElrond"))
+	assert.Equal(t, expectedErr, facade.ForceStartStep(context.Background(), "ElrondToEthereum"))
+	facade.ClearStoredSignatures()
+	assert.Nil(t, facade.RefreshRoleProviders(context.Background()))
+
+	expectedCalls := []string{
+		"pause EthereumToElrond",
+		"resume EthereumToElrond",
+		"force start step ElrondToEthereum",
+		"clear signatures",
+		"refresh role providers",
+	}
+	assert.Equal(t, expectedCalls, calls)
+}
+
+func TestRelayerFacade_GetLivenessAndReadiness(t *testing.T) {
+	t.Parallel()
+
+	livenessReport := core.HealthReport{
+		Healthy: true,
+		Checks: []core.HealthCheck{
+			{Name: "EthereumToElrond execution", Healthy: true},
+		},
+	}
+	readinessReport := core.HealthReport{
+		Healthy: false,
+		Checks: []core.HealthCheck{
+			{Name: "p2p peers", Healthy: false, Message: "0 connected peer(s), minimum 1"},
+		},
+	}
+	args := createMockArguments()
+	args.HealthChecker = &testsCommon.HealthCheckerStub{
+		LivenessCalled: func() core.HealthReport {
+			return livenessReport
+		},
+		ReadinessCalled: func() core.HealthReport {
+			return readinessReport
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, livenessReport, facade.GetLiveness())
+	assert.Equal(t, readinessReport, facade.GetReadiness())
+}
+
+func TestRelayerFacade_GetPeerScores(t *testing.T) {
+	t.Parallel()
+
+	scores := []core.PeerScore{
+		{Identifier: "pid 1", Type: "peer ID", Score: 5},
+	}
+	args := createMockArguments()
+	args.PeerScoresProvider = &testsCommon.PeerScoresProviderStub{
+		PeerScoresCalled: func() []core.PeerScore {
+			return scores
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, scores, facade.GetPeerScores())
+}
+
+func TestRelayerFacade_GetClusterInfo(t *testing.T) {
+	t.Parallel()
+
+	relayers := []core.RelayerStatus{
+		{Address: "erd1", IsSelf: true, AppVersion: "v1.0.0"},
+		{Address: "erd2", IsSilent: true},
+	}
+	args := createMockArguments()
+	args.ClusterInfoProvider = &testsCommon.ClusterInfoProviderStub{
+		ClusterInfoCalled: func() []core.RelayerStatus {
+			return relayers
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, relayers, facade.GetClusterInfo())
+}
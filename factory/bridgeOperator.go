@@ -0,0 +1,74 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+)
+
+// PauseStateMachine pauses the state machine with the provided name. The step in progress, if any, is completed
+// but no new steps are executed until the state machine is resumed
+func (components *ethElrondBridgeComponents) PauseStateMachine(name string) error {
+	sm, err := components.getStateMachine(name)
+	if err != nil {
+		return err
+	}
+
+	sm.Pause()
+
+	return nil
+}
+
+// ResumeStateMachine resumes the state machine with the provided name
+func (components *ethElrondBridgeComponents) ResumeStateMachine(name string) error {
+	sm, err := components.getStateMachine(name)
+	if err != nil {
+		return err
+	}
+
+	sm.Resume()
+
+	return nil
+}
+
+// ForceStartStep forces the state machine with the provided name back to its start step, dropping the
+// batch in progress
+func (components *ethElrondBridgeComponents) ForceStartStep(ctx context.Context, name string) error {
+	sm, err := components.getStateMachine(name)
+	if err != nil {
+		return err
+	}
+
+	return sm.ForceStartStep(ctx)
+}
+
+// ClearStoredSignatures clears the stored p2p signatures used in the Elrond to Ethereum direction
+func (components *ethElrondBridgeComponents) ClearStoredSignatures() {
+	components.elrondToEthBridge.ClearStoredP2PSignaturesForEthereum()
+}
+
+// RefreshRoleProviders fetches right away the whitelisted relayers from both chains, without waiting for the
+// next polling round
+func (components *ethElrondBridgeComponents) RefreshRoleProviders(ctx context.Context) error {
+	err := components.elrondRoleProvider.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("%w while refreshing the Elrond role provider", err)
+	}
+
+	err = components.ethereumRoleProvider.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("%w while refreshing the Ethereum role provider", err)
+	}
+
+	return nil
+}
+
+func (components *ethElrondBridgeComponents) getStateMachine(name string) (StateMachine, error) {
+	switch name {
+	case components.evmCompatibleChain.EvmCompatibleChainToElrondName():
+		return components.ethToElrondStateMachine, nil
+	case components.evmCompatibleChain.ElrondToEvmCompatibleChainName():
+		return components.elrondToEthStateMachine, nil
+	default:
+		return nil, fmt.Errorf("%w %q", errUnknownStateMachine, name)
+	}
+}
@@ -0,0 +1,104 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	stateMachineMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/stateMachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createComponentsWithStateMachineStubs(t *testing.T) (*ethElrondBridgeComponents, *stateMachineMocks.StateMachineStub, *stateMachineMocks.StateMachineStub) {
+	components, err := NewEthElrondBridgeComponents(createMockEthElrondBridgeArgs())
+	require.Nil(t, err)
+
+	ethToElrond := &stateMachineMocks.StateMachineStub{}
+	elrondToEth := &stateMachineMocks.StateMachineStub{}
+	components.ethToElrondStateMachine = ethToElrond
+	components.elrondToEthStateMachine = elrondToEth
+
+	return components, ethToElrond, elrondToEth
+}
+
+func TestEthElrondBridgeComponents_PauseResumeStateMachine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown state machine should error", func(t *testing.T) {
+		t.Parallel()
+
+		components, _, _ := createComponentsWithStateMachineStubs(t)
+
+		err := components.PauseStateMachine("unknown")
+		assert.True(t, errors.Is(err, errUnknownStateMachine))
+
+		err = components.ResumeStateMachine("unknown")
+		assert.True(t, errors.Is(err, errUnknownStateMachine))
+	})
+	t.Run("should pause and resume the named state machine", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make([]string, 0)
+		components, ethToElrond, elrondToEth := createComponentsWithStateMachineStubs(t)
+		ethToElrond.PauseCalled = func() {
+			calls = append(calls, "pause eth to elrond")
+		}
+		elrondToEth.PauseCalled = func() {
+			calls = append(calls, "pause elrond to eth")
+		}
+		elrondToEth.ResumeCalled = func() {
+			calls = append(calls, "resume elrond to eth")
+		}
+
+		err := components.PauseStateMachine("ElrondToEthereum")
+		assert.Nil(t, err)
+		err = components.ResumeStateMachine("ElrondToEthereum")
+		assert.Nil(t, err)
+		err = components.PauseStateMachine("EthereumToElrond")
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"pause elrond to eth", "resume elrond to eth", "pause eth to elrond"}, calls)
+	})
+}
+
+func TestEthElrondBridgeComponents_ForceStartStep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown state machine should error", func(t *testing.T) {
+		t.Parallel()
+
+		components, _, _ := createComponentsWithStateMachineStubs(t)
+
+		err := components.ForceStartStep(context.Background(), "unknown")
+		assert.True(t, errors.Is(err, errUnknownStateMachine))
+	})
+	t.Run("should return the state machine error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		components, ethToElrond, _ := createComponentsWithStateMachineStubs(t)
+		ethToElrond.ForceStartStepCalled = func(ctx context.Context) error {
+			return expectedErr
+		}
+
+		err := components.ForceStartStep(context.Background(), "EthereumToElrond")
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestEthElrondBridgeComponents_ClearStoredSignatures(t *testing.T) {
+	t.Parallel()
+
+	components, _, _ := createComponentsWithStateMachineStubs(t)
+	cleared := false
+	bridgeStub := bridgeTests.NewBridgeExecutorStub()
+	bridgeStub.ClearStoredP2PSignaturesForEthereumCalled = func() {
+		cleared = true
+	}
+	components.elrondToEthBridge = bridgeStub
+
+	components.ClearStoredSignatures()
+	assert.True(t, cleared)
+}
@@ -0,0 +1,208 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	batchValidatorManagement "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	gasStationConfigPrefix     = "Eth.GasStation."
+	gasStationEnabledPath      = "Eth.GasStation.Enabled"
+	batchValidatorConfigPrefix = "BatchValidator."
+	stateMachineConfigPrefix   = "StateMachine."
+	stepDurationField          = "StepDurationInMillis"
+	schedulingFieldPrefix      = "Scheduling."
+	schedulingEnabledField     = "Scheduling.Enabled"
+	logLevelPath               = "Logs.LogLevel"
+
+	reasonRequiresRestart      = "requires a restart"
+	reasonNoAdaptiveScheduling = "can be changed while running only if the adaptive scheduling is enabled for this state machine"
+	reasonUnknownStateMachine  = "unknown state machine, requires a restart"
+)
+
+var reloadableBatchValidatorPaths = map[string]struct{}{
+	"BatchValidator.URL":                             {},
+	"BatchValidator.RequestTimeInSeconds":            {},
+	"BatchValidator.MaxRetries":                      {},
+	"BatchValidator.RetryDelayInMillis":              {},
+	"BatchValidator.CacheTTLInSeconds":               {},
+	"BatchValidator.CircuitBreakerThreshold":         {},
+	"BatchValidator.CircuitBreakerOpenTimeInSeconds": {},
+}
+
+// reloadableBatchValidator holds a batch validator along with the chains it was created for
+type reloadableBatchValidator struct {
+	sourceChain      chain.Chain
+	destinationChain chain.Chain
+	validator        batchValidatorManagement.ReloadableBatchValidator
+}
+
+// ReloadConfig re-reads the configuration file and applies the changes that are safe to be applied while running:
+// the gas station settings, the batch validator URL, request time, retries, cache and circuit breaker settings, the
+// state machines timings and the log level.
+// If any other value was changed, nothing is applied and the unsafe changes are reported as rejected
+func (components *ethElrondBridgeComponents) ReloadConfig() (core.ConfigReloadReport, error) {
+	components.mutConfigs.Lock()
+	configFile := components.configs.FlagsConfig.ConfigurationFile
+	components.mutConfigs.Unlock()
+
+	newConfig := config.Config{}
+	err := elrondCore.LoadTomlFile(&newConfig, configFile)
+	if err != nil {
+		return core.ConfigReloadReport{}, err
+	}
+
+	return components.applyConfig(newConfig)
+}
+
+func (components *ethElrondBridgeComponents) applyConfig(newConfig config.Config) (core.ConfigReloadReport, error) {
+	components.mutConfigs.Lock()
+	defer components.mutConfigs.Unlock()
+
+	report := core.ConfigReloadReport{
+		Applied:  make([]core.ConfigChange, 0),
+		Rejected: make([]core.ConfigChange, 0),
+	}
+
+	changes := config.ComputeChanges(components.configs.GeneralConfig, newConfig)
+	for _, change := range changes {
+		change.Reason = components.rejectionReason(change.Path)
+		if len(change.Reason) > 0 {
+			report.Rejected = append(report.Rejected, change)
+		}
+	}
+	if len(report.Rejected) > 0 {
+		return report, fmt.Errorf("%w: %d change(s) rejected, nothing was applied", errUnsafeConfigChanges, len(report.Rejected))
+	}
+
+	// the changes are computed against the last successfully applied config, so a reload that failed midway
+	// is completed by the next one
+	err := components.applyConfigChanges(newConfig, changes)
+	if err != nil {
+		return report, err
+	}
+
+	components.configs.GeneralConfig = newConfig
+	report.Applied = changes
+	for _, change := range changes {
+		components.baseLogger.Info("config change applied", "path", change.Path, "old value", change.OldValue, "new value", change.NewValue)
+	}
+
+	return report, nil
+}
+
+// rejectionReason returns the reason why the value found on the provided path can not be changed while running
+// or an empty string if it can
+func (components *ethElrondBridgeComponents) rejectionReason(path string) string {
+	switch {
+	case path == logLevelPath:
+		return ""
+	case path == gasStationEnabledPath:
+		return reasonRequiresRestart
+	case strings.HasPrefix(path, gasStationConfigPrefix):
+		return ""
+	case strings.HasPrefix(path, batchValidatorConfigPrefix):
+		_, isReloadable := reloadableBatchValidatorPaths[path]
+		if isReloadable {
+			return ""
+		}
+		return reasonRequiresRestart
+	case strings.HasPrefix(path, stateMachineConfigPrefix):
+		return components.stateMachineRejectionReason(strings.TrimPrefix(path, stateMachineConfigPrefix))
+	default:
+		return reasonRequiresRestart
+	}
+}
+
+func (components *ethElrondBridgeComponents) stateMachineRejectionReason(path string) string {
+	parts := strings.SplitN(path, ".", 2)
+	if len(parts) != 2 {
+		return reasonRequiresRestart
+	}
+
+	stateMachineName, field := parts[0], parts[1]
+	_, found := components.configs.GeneralConfig.StateMachine[stateMachineName]
+	if !found {
+		return reasonUnknownStateMachine
+	}
+
+	_, isAdaptive := components.adaptivePollingHandlers[stateMachineName]
+	switch {
+	case field == schedulingEnabledField:
+		return reasonRequiresRestart
+	case strings.HasPrefix(field, schedulingFieldPrefix):
+		// unused values while the adaptive scheduling is disabled, so they can be changed freely
+		return ""
+	case field == stepDurationField:
+		if isAdaptive {
+			return ""
+		}
+		return reasonNoAdaptiveScheduling
+	default:
+		return reasonRequiresRestart
+	}
+}
+
+func (components *ethElrondBridgeComponents) applyConfigChanges(newConfig config.Config, changes []core.ConfigChange) error {
+	if hasChangesWithPrefix(changes, gasStationConfigPrefix) {
+		err := components.ethGasHandler.Reload(createArgsGasStation(newConfig.Eth.GasStation))
+		if err != nil {
+			return fmt.Errorf("%w while reloading the gas station", err)
+		}
+	}
+
+	if hasChangesWithPrefix(changes, batchValidatorConfigPrefix) {
+		for _, holder := range components.batchValidators {
+			args := createArgsBatchValidator(holder.sourceChain, holder.destinationChain, newConfig.BatchValidator)
+			err := holder.validator.Reload(args)
+			if err != nil {
+				return fmt.Errorf("%w while reloading the batch validator %s-%s", err, holder.sourceChain, holder.destinationChain)
+			}
+		}
+	}
+
+	for stateMachineName, handler := range components.adaptivePollingHandlers {
+		if !hasChangesWithPrefix(changes, stateMachineConfigPrefix+stateMachineName+".") {
+			continue
+		}
+
+		stateMachineConfig := newConfig.StateMachine[stateMachineName]
+		stepDuration := time.Duration(stateMachineConfig.StepDurationInMillis) * time.Millisecond
+		err := handler.SetIntervals(createSchedulingIntervals(stepDuration, stateMachineConfig.Scheduling))
+		if err != nil {
+			return fmt.Errorf("%w while reloading the scheduling of %s", err, stateMachineName)
+		}
+	}
+
+	if hasChangesWithPrefix(changes, logLevelPath) {
+		logLevel := newConfig.Logs.LogLevel
+		if len(logLevel) == 0 {
+			logLevel = components.configs.FlagsConfig.LogLevel
+		}
+
+		err := logger.SetLogLevel(logLevel)
+		if err != nil {
+			return fmt.Errorf("%w while setting the log level", err)
+		}
+	}
+
+	return nil
+}
+
+func hasChangesWithPrefix(changes []core.ConfigChange, prefix string) bool {
+	for _, change := range changes {
+		if strings.HasPrefix(change.Path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
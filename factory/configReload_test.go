@@ -0,0 +1,197 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/gasManagement"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func copyConfig(cfg config.Config) config.Config {
+	stateMachines := make(map[string]config.ConfigStateMachine)
+	for name, stateMachineConfig := range cfg.StateMachine {
+		stateMachines[name] = stateMachineConfig
+	}
+	cfg.StateMachine = stateMachines
+
+	return cfg
+}
+
+func TestEthElrondBridgeComponents_ApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same config should not apply anything", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthElrondBridgeArgs()
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+
+		report, err := components.applyConfig(copyConfig(args.Configs.GeneralConfig))
+		assert.Nil(t, err)
+		assert.Empty(t, report.Applied)
+		assert.Empty(t, report.Rejected)
+	})
+	t.Run("unsafe changes should be rejected and nothing applied", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthElrondBridgeArgs()
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+
+		newConfig := copyConfig(args.Configs.GeneralConfig)
+		newConfig.P2P.Port = "10011"
+		newConfig.BatchValidator.ResponsePublicKey = "aa"
+		newConfig.Eth.GasStation.Enabled = false
+		newConfig.Eth.GasStation.MaximumAllowedGasPrice = 200
+		ethToElrondConfig := newConfig.StateMachine["EthereumToElrond"]
+		ethToElrondConfig.Scheduling.Enabled = false
+		newConfig.StateMachine["EthereumToElrond"] = ethToElrondConfig
+
+		report, err := components.applyConfig(newConfig)
+		assert.True(t, errors.Is(err, errUnsafeConfigChanges))
+		assert.Empty(t, report.Applied)
+		expectedRejected := []core.ConfigChange{
+			{
+				Path:     "BatchValidator.ResponsePublicKey",
+				OldValue: "",
+				NewValue: "aa",
+				Reason:   reasonRequiresRestart,
+			},
+			{
+				Path:     "Eth.GasStation.Enabled",
+				OldValue: "true",
+				NewValue: "false",
+				Reason:   reasonRequiresRestart,
+			},
+			{
+				Path:     "P2P.Port",
+				OldValue: "",
+				NewValue: "10011",
+				Reason:   reasonRequiresRestart,
+			},
+			{
+				Path:     "StateMachine.EthereumToElrond.Scheduling.Enabled",
+				OldValue: "true",
+				NewValue: "false",
+				Reason:   reasonRequiresRestart,
+			},
+		}
+		assert.Equal(t, expectedRejected, report.Rejected)
+		assert.Equal(t, args.Configs.GeneralConfig, components.configs.GeneralConfig)
+	})
+	t.Run("step duration change without adaptive scheduling should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthElrondBridgeArgs()
+		elrondToEthConfig := args.Configs.GeneralConfig.StateMachine["ElrondToEthereum"]
+		elrondToEthConfig.Scheduling.Enabled = false
+		args.Configs.GeneralConfig.StateMachine["ElrondToEthereum"] = elrondToEthConfig
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+
+		newConfig := copyConfig(args.Configs.GeneralConfig)
+		elrondToEthConfig.StepDurationInMillis = 2000
+		elrondToEthConfig.Scheduling.IdleIntervalInMillis = 60000
+		newConfig.StateMachine["ElrondToEthereum"] = elrondToEthConfig
+
+		report, err := components.applyConfig(newConfig)
+		assert.True(t, errors.Is(err, errUnsafeConfigChanges))
+		require.Equal(t, 1, len(report.Rejected))
+		assert.Equal(t, "StateMachine.ElrondToEthereum.StepDurationInMillis", report.Rejected[0].Path)
+		assert.Equal(t, reasonNoAdaptiveScheduling, report.Rejected[0].Reason)
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthElrondBridgeArgs()
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+
+		newConfig := copyConfig(args.Configs.GeneralConfig)
+		newConfig.Eth.GasStation.GasPriceSelector = "invalid"
+
+		report, err := components.applyConfig(newConfig)
+		assert.True(t, errors.Is(err, gasManagement.ErrInvalidGasPriceSelector))
+		assert.Empty(t, report.Applied)
+		assert.Equal(t, args.Configs.GeneralConfig, components.configs.GeneralConfig)
+	})
+	t.Run("safe changes should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthElrondBridgeArgs()
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+
+		newConfig := copyConfig(args.Configs.GeneralConfig)
+		newConfig.Eth.GasStation.MaximumAllowedGasPrice = 200
+		newConfig.BatchValidator.URL = "http://127.0.0.1:8080"
+		newConfig.BatchValidator.RequestTimeInSeconds = 5
+		newConfig.BatchValidator.CacheTTLInSeconds = 30
+		ethToElrondConfig := newConfig.StateMachine["EthereumToElrond"]
+		ethToElrondConfig.StepDurationInMillis = 2000
+		ethToElrondConfig.Scheduling.IdleIntervalInMillis = 60000
+		newConfig.StateMachine["EthereumToElrond"] = ethToElrondConfig
+
+		report, err := components.applyConfig(newConfig)
+		assert.Nil(t, err)
+		assert.Empty(t, report.Rejected)
+		expectedApplied := []core.ConfigChange{
+			{
+				Path:     "BatchValidator.CacheTTLInSeconds",
+				OldValue: "0",
+				NewValue: "30",
+			},
+			{
+				Path:     "BatchValidator.RequestTimeInSeconds",
+				OldValue: "0",
+				NewValue: "5",
+			},
+			{
+				Path:     "BatchValidator.URL",
+				OldValue: "",
+				NewValue: "http://127.0.0.1:8080",
+			},
+			{
+				Path:     "Eth.GasStation.MaximumAllowedGasPrice",
+				OldValue: "100",
+				NewValue: "200",
+			},
+			{
+				Path:     "StateMachine.EthereumToElrond.Scheduling.IdleIntervalInMillis",
+				OldValue: "5000",
+				NewValue: "60000",
+			},
+			{
+				Path:     "StateMachine.EthereumToElrond.StepDurationInMillis",
+				OldValue: "1000",
+				NewValue: "2000",
+			},
+		}
+		assert.Equal(t, expectedApplied, report.Applied)
+		assert.Equal(t, newConfig, components.configs.GeneralConfig)
+
+		// applying the same config again should not find any change
+		report, err = components.applyConfig(copyConfig(newConfig))
+		assert.Nil(t, err)
+		assert.Empty(t, report.Applied)
+	})
+}
+
+func TestEthElrondBridgeComponents_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthElrondBridgeArgs()
+	args.Configs.FlagsConfig.ConfigurationFile = "missing.toml"
+	components, err := NewEthElrondBridgeComponents(args)
+	require.Nil(t, err)
+
+	report, err := components.ReloadConfig()
+	assert.NotNil(t, err)
+	assert.Empty(t, report.Applied)
+	assert.Empty(t, report.Rejected)
+}
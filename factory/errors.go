@@ -13,4 +13,8 @@ var (
 	errInvalidValue            = errors.New("invalid value")
 	errNilMetricsHolder        = errors.New("nil metrics holder")
 	errNilStatusHandler        = errors.New("nil status handler")
+	errUnknownStep             = errors.New("unknown step")
+	errNilTransitionsHistory   = errors.New("nil transitions history holder")
+	errUnsafeConfigChanges     = errors.New("config changes that can not be applied while running")
+	errUnknownStateMachine     = errors.New("unknown state machine")
 )
@@ -9,27 +9,38 @@ import (
 	"sync"
 	"time"
 
+	alertsFactory "github.com/ElrondNetwork/elrond-eth-bridge/alerts/factory"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/disabled"
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/fingerprint"
 	elrondToEthSteps "github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps/elrondToEth"
 	ethToElrondSteps "github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps/ethToElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/topology"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/balanceMonitor"
 	batchValidatorManagement "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator"
 	batchManagementFactory "github.com/ElrondNetwork/elrond-eth-bridge/clients/batchValidator/factory"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/elrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/elrond/mappers"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum/contract"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum/wrappers"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/gasManagement"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/gasManagement/factory"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/roleProviders"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/tokensPolicy"
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core/converters"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core/timer"
+	"github.com/ElrondNetwork/elrond-eth-bridge/health"
+	"github.com/ElrondNetwork/elrond-eth-bridge/heartbeat"
 	"github.com/ElrondNetwork/elrond-eth-bridge/p2p"
 	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
+	stateMachineDisabled "github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/disabled"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/scheduler"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/watchdog"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
 	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -40,18 +51,27 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	elrondConfig "github.com/ElrondNetwork/elrond-go/config"
 	antifloodFactory "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
 	erdgoCore "github.com/ElrondNetwork/elrond-sdk-erdgo/core"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/core/polling"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/interactors"
 	"github.com/ethereum/go-ethereum/common"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 const (
 	minTimeForBootstrap     = time.Millisecond * 100
 	minTimeBeforeRepeatJoin = time.Second * 30
 	pollingDurationOnError  = time.Second * 5
+
+	contractProfileDetectionTimeout = time.Second * 30
+
+	httpBatchValidatorName            = "http"
+	crossCheckBatchValidatorName      = "crossCheck"
+	tokensAllowlistBatchValidatorName = "tokensAllowlist"
+	amountBoundsBatchValidatorName    = "amountBounds"
 )
 
 var suite = ed25519.NewEd25519()
@@ -70,7 +90,9 @@ type ArgsEthereumToElrondBridge struct {
 	TimeForBootstrap          time.Duration
 	TimeBeforeRepeatJoin      time.Duration
 	MetricsHolder             core.MetricsHolder
+	TransitionsHistoryHolder  core.TransitionsHistoryHolder
 	AppStatusHandler          elrondCore.AppStatusHandler
+	AppVersion                string
 }
 
 type ethElrondBridgeComponents struct {
@@ -85,28 +107,46 @@ type ethElrondBridgeComponents struct {
 	elrondRelayerAddress          erdgoCore.AddressHandler
 	ethereumRelayerAddress        common.Address
 	dataGetter                    dataGetter
+	tokenRegistry                 tokenRegistry
+	tokensPolicy                  tokensPolicyHandler
+	contractProfile               elrond.ContractProfile
 	proxy                         elrond.ElrondProxy
 	elrondRoleProvider            ElrondRoleProvider
 	ethereumRoleProvider          EthereumRoleProvider
 	broadcaster                   Broadcaster
+	activePublicKeysProvider      topology.PublicKeysProvider
 	timer                         core.Timer
+	alertHandler                  core.AlertHandler
 	timeForBootstrap              time.Duration
 	metricsHolder                 core.MetricsHolder
+	transitionsHistoryHolder      core.TransitionsHistoryHolder
 	addressConverter              core.AddressConverter
+	ethGasHandler                 gasManagement.ReloadableGasHandler
+	batchValidators               []*reloadableBatchValidator
+	adaptivePollingHandlers       map[string]intervalsSetter
+	healthChecker                 core.HealthChecker
+	clusterView                   core.ClusterInfoProvider
+
+	mutConfigs sync.Mutex
+	configs    config.Configs
 
 	ethToElrondMachineStates    core.MachineStates
 	ethToElrondStepDuration     time.Duration
 	ethToElrondStatusHandler    core.StatusHandler
 	ethToElrondStateMachine     StateMachine
+	ethToElrondBridge           stateMachine.BatchInfoProvider
 	ethToElrondSignaturesHolder ethElrond.SignaturesHolder
+	peerScorer                  peerScorer
 
 	elrondToEthMachineStates core.MachineStates
 	elrondToEthStepDuration  time.Duration
 	elrondToEthStatusHandler core.StatusHandler
 	elrondToEthStateMachine  StateMachine
+	elrondToEthBridge        p2pSignaturesCleaner
 
 	mutClosableHandlers sync.RWMutex
 	closableHandlers    []io.Closer
+	flushableHandlers   []core.Flusher
 
 	pollingHandlers []PollingHandler
 
@@ -125,17 +165,20 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 	ethToElrondName := evmCompatibleChain.EvmCompatibleChainToElrondName()
 	baseLogId := evmCompatibleChain.BaseLogId()
 	components := &ethElrondBridgeComponents{
-		baseLogger:           core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToElrondName), baseLogId),
-		evmCompatibleChain:   evmCompatibleChain,
-		messenger:            args.Messenger,
-		statusStorer:         args.StatusStorer,
-		closableHandlers:     make([]io.Closer, 0),
-		proxy:                args.Proxy,
-		timer:                timer.NewNTPTimer(),
-		timeForBootstrap:     args.TimeForBootstrap,
-		timeBeforeRepeatJoin: args.TimeBeforeRepeatJoin,
-		metricsHolder:        args.MetricsHolder,
-		appStatusHandler:     args.AppStatusHandler,
+		baseLogger:               core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToElrondName), baseLogId),
+		evmCompatibleChain:       evmCompatibleChain,
+		messenger:                args.Messenger,
+		statusStorer:             args.StatusStorer,
+		closableHandlers:         make([]io.Closer, 0),
+		proxy:                    args.Proxy,
+		timer:                    timer.NewNTPTimer(),
+		timeForBootstrap:         args.TimeForBootstrap,
+		timeBeforeRepeatJoin:     args.TimeBeforeRepeatJoin,
+		metricsHolder:            args.MetricsHolder,
+		transitionsHistoryHolder: args.TransitionsHistoryHolder,
+		appStatusHandler:         args.AppStatusHandler,
+		adaptivePollingHandlers:  make(map[string]intervalsSetter),
+		configs:                  args.Configs,
 	}
 
 	addressConverter, err := converters.NewAddressConverter()
@@ -146,12 +189,27 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 
 	components.addClosableComponent(components.timer)
 
+	err = components.createAlertHandler(args.Configs.GeneralConfig.Alerts)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createElrondKeysAndAddresses(args.Configs.GeneralConfig.Elrond)
 	if err != nil {
 		return nil, err
 	}
 
-	err = components.createDataGetter()
+	err = components.createDataGetter(args.Configs.GeneralConfig.Elrond)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createTokenRegistry(args.Configs.GeneralConfig.TokenRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createTokensPolicy(args.Configs.GeneralConfig.Tokens)
 	if err != nil {
 		return nil, err
 	}
@@ -176,12 +234,17 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 		return nil, err
 	}
 
+	err = components.createBalanceMonitor(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumToElrondBridge(args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = components.createEthereumToElrondStateMachine()
+	err = components.createEthereumToElrondStateMachine(args)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +254,17 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 		return nil, err
 	}
 
-	err = components.createElrondToEthereumStateMachine()
+	err = components.createElrondToEthereumStateMachine(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createHealthChecker(args.Configs.GeneralConfig.Health)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createHeartbeatComponents(args)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +272,25 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 	return components, nil
 }
 
+func (components *ethElrondBridgeComponents) createAlertHandler(alertsConfig config.AlertsConfig) error {
+	alertHandler, err := alertsFactory.CreateAlertHandler(alertsConfig, components.baseLogger, components.timer)
+	if err != nil {
+		return err
+	}
+
+	components.alertHandler = alertHandler
+	components.addFlushableComponent(alertHandler)
+	components.addClosableComponent(alertHandler)
+
+	return nil
+}
+
+func (components *ethElrondBridgeComponents) addFlushableComponent(flushable core.Flusher) {
+	components.mutClosableHandlers.Lock()
+	components.flushableHandlers = append(components.flushableHandlers, flushable)
+	components.mutClosableHandlers.Unlock()
+}
+
 func (components *ethElrondBridgeComponents) addClosableComponent(closable io.Closer) {
 	components.mutClosableHandlers.Lock()
 	components.closableHandlers = append(components.closableHandlers, closable)
@@ -230,6 +322,9 @@ func checkArgsEthereumToElrondBridge(args ArgsEthereumToElrondBridge) error {
 	if check.IfNil(args.MetricsHolder) {
 		return errNilMetricsHolder
 	}
+	if check.IfNil(args.TransitionsHistoryHolder) {
+		return errNilTransitionsHistory
+	}
 	if check.IfNil(args.AppStatusHandler) {
 		return errNilStatusHandler
 	}
@@ -262,24 +357,93 @@ func (components *ethElrondBridgeComponents) createElrondKeysAndAddresses(elrond
 	return nil
 }
 
-func (components *ethElrondBridgeComponents) createDataGetter() error {
+func (components *ethElrondBridgeComponents) createDataGetter(elrondConfigs config.ElrondConfig) error {
 	elrondDataGetterLogId := components.evmCompatibleChain.ElrondDataGetterLogId()
+	dataGetterLogger := core.NewLoggerWithIdentifier(logger.GetOrCreate(elrondDataGetterLogId), elrondDataGetterLogId)
+
+	argsContractProfileSelector := elrond.ArgsContractProfileSelector{
+		Proxy:                   components.proxy,
+		Log:                     dataGetterLogger,
+		MultisigContractAddress: components.elrondMultisigContractAddress,
+		ProfileName:             elrondConfigs.ContractProfile,
+		Profiles:                elrondConfigs.ContractProfiles,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), contractProfileDetectionTimeout)
+	defer cancel()
+
+	var err error
+	components.contractProfile, err = elrond.SelectContractProfile(ctx, argsContractProfileSelector)
+	if err != nil {
+		return err
+	}
+
 	argsDataGetter := elrond.ArgsDataGetter{
 		MultisigContractAddress: components.elrondMultisigContractAddress,
 		RelayerAddress:          components.elrondRelayerAddress,
 		Proxy:                   components.proxy,
-		Log:                     core.NewLoggerWithIdentifier(logger.GetOrCreate(elrondDataGetterLogId), elrondDataGetterLogId),
+		Log:                     dataGetterLogger,
+		ContractProfile:         components.contractProfile,
 	}
 
-	var err error
 	components.dataGetter, err = elrond.NewDataGetter(argsDataGetter)
 
 	return err
 }
 
+func (components *ethElrondBridgeComponents) createTokenRegistry(tokenRegistryConfig config.TokenRegistryConfig) error {
+	tokenRegistryLogId := components.evmCompatibleChain.TokenRegistryLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(tokenRegistryLogId), tokenRegistryLogId)
+
+	argsTokenRegistry := mappers.ArgsTokenRegistry{
+		DataGetter:       components.dataGetter,
+		Log:              log,
+		AlertHandler:     components.alertHandler,
+		CacheTTL:         time.Duration(tokenRegistryConfig.CacheTTLInSeconds) * time.Second,
+		ExpectedMappings: tokenRegistryConfig.ExpectedMappings,
+	}
+
+	registry, err := mappers.NewTokenRegistry(argsTokenRegistry)
+	if err != nil {
+		return err
+	}
+	components.tokenRegistry = registry
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "Token registry",
+		PollingInterval:  time.Duration(tokenRegistryConfig.RefreshIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         registry,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethElrondBridgeComponents) createTokensPolicy(tokensConfig map[string]config.TokenPolicyConfig) error {
+	policy, err := tokensPolicy.NewTokensPolicy(tokensConfig)
+	if err != nil {
+		return err
+	}
+	components.tokensPolicy = policy
+
+	components.baseLogger.Info("created the tokens policy", "num listed tokens", len(tokensConfig),
+		"enforced", policy.IsEnforced())
+
+	return nil
+}
+
 func (components *ethElrondBridgeComponents) createElrondClient(args ArgsEthereumToElrondBridge) error {
 	elrondConfigs := args.Configs.GeneralConfig.Elrond
-	tokensMapper, err := mappers.NewElrondToErc20Mapper(components.dataGetter)
+	tokensMapper, err := mappers.NewElrondToErc20Mapper(components.tokenRegistry)
 	if err != nil {
 		return err
 	}
@@ -295,7 +459,12 @@ func (components *ethElrondBridgeComponents) createElrondClient(args ArgsEthereu
 		TokensMapper:                 tokensMapper,
 		RoleProvider:                 components.elrondRoleProvider,
 		StatusHandler:                args.ElrondClientStatusHandler,
+		AlertHandler:                 components.alertHandler,
 		AllowDelta:                   uint64(elrondConfigs.ProxyMaxNoncesDelta),
+		TransactionOutcomeConfig:     elrondConfigs.TransactionOutcome,
+		GasEstimationConfig:          elrondConfigs.GasEstimation,
+		ContractProfile:              components.contractProfile,
+		TokensPolicy:                 components.tokensPolicy,
 	}
 
 	components.elrondClient, err = elrond.NewClient(clientArgs)
@@ -308,21 +477,11 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 	ethereumConfigs := args.Configs.GeneralConfig.Eth
 
 	gasStationConfig := ethereumConfigs.GasStation
-	argsGasStation := gasManagement.ArgsGasStation{
-		RequestURL:             gasStationConfig.URL,
-		RequestPollingInterval: time.Duration(gasStationConfig.PollingIntervalInSeconds) * time.Second,
-		RequestRetryDelay:      time.Duration(gasStationConfig.RequestRetryDelayInSeconds) * time.Second,
-		MaximumFetchRetries:    gasStationConfig.MaxFetchRetries,
-		RequestTime:            time.Duration(gasStationConfig.RequestTimeInSeconds) * time.Second,
-		MaximumGasPrice:        gasStationConfig.MaximumAllowedGasPrice,
-		GasPriceSelector:       core.EthGasPriceSelector(gasStationConfig.GasPriceSelector),
-		GasPriceMultiplier:     gasStationConfig.GasPriceMultiplier,
-	}
-
-	gs, err := factory.CreateGasStation(argsGasStation, gasStationConfig.Enabled)
+	gs, err := factory.CreateGasStation(createArgsGasStation(gasStationConfig), gasStationConfig.Enabled)
 	if err != nil {
 		return err
 	}
+	components.ethGasHandler = gs
 
 	antifloodComponents, err := components.createAntifloodComponents(args.Configs.GeneralConfig.P2P.AntifloodConfig)
 	if err != nil {
@@ -339,10 +498,27 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 	}
 
 	broadcasterLogId := components.evmCompatibleChain.BroadcasterLogId()
+	broadcasterLog := core.NewLoggerWithIdentifier(logger.GetOrCreate(broadcasterLogId), broadcasterLogId)
+	err = components.createPeerScorer(args.Configs.GeneralConfig.P2P.PeerScoring, peerDenialEvaluator, broadcasterLog)
+	if err != nil {
+		return err
+	}
+
 	ethToElrondName := components.evmCompatibleChain.EvmCompatibleChainToElrondName()
+	messagesConfig := args.Configs.GeneralConfig.P2P.Messages
+	argsMessagesMarshalizer := p2p.ArgsMessagesMarshalizer{
+		Type:                messagesConfig.Marshalizer,
+		AcceptBothEncodings: messagesConfig.AcceptBothEncodings,
+	}
+	messagesMarshalizer, err := p2p.NewMessagesMarshalizer(argsMessagesMarshalizer)
+	if err != nil {
+		return err
+	}
+
 	argsBroadcaster := p2p.ArgsBroadcaster{
 		Messenger:           args.Messenger,
-		Log:                 core.NewLoggerWithIdentifier(logger.GetOrCreate(broadcasterLogId), broadcasterLogId),
+		Marshalizer:         messagesMarshalizer,
+		Log:                 broadcasterLog,
 		ElrondRoleProvider:  components.elrondRoleProvider,
 		SignatureProcessor:  components.ethereumRoleProvider,
 		KeyGen:              keyGen,
@@ -350,6 +526,10 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 		PrivateKey:          components.elrondRelayerPrivateKey,
 		Name:                ethToElrondName,
 		AntifloodComponents: antifloodComponents,
+		PeerScorer:          components.peerScorer,
+
+		SignaturesResponsesInterval: time.Millisecond * time.Duration(args.Configs.GeneralConfig.P2P.SignaturesRequests.ResponsesIntervalInMillis),
+		SignaturesRequestTimeout:    time.Second * time.Duration(args.Configs.GeneralConfig.P2P.SignaturesRequests.RequestTimeoutInSeconds),
 	}
 
 	components.broadcaster, err = p2p.NewBroadcaster(argsBroadcaster)
@@ -357,6 +537,11 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 		return err
 	}
 
+	components.activePublicKeysProvider, err = topology.NewActivePublicKeysProvider(components.elrondRoleProvider, components.broadcaster)
+	if err != nil {
+		return err
+	}
+
 	privateKeyBytes, err := ioutil.ReadFile(ethereumConfigs.PrivateKeyFile)
 	if err != nil {
 		return err
@@ -374,12 +559,15 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 	}
 	components.ethereumRelayerAddress = ethCrypto.PubkeyToAddress(*publicKeyECDSA)
 
-	tokensMapper, err := mappers.NewErc20ToElrondMapper(components.dataGetter)
+	tokensMapper, err := mappers.NewErc20ToElrondMapper(components.tokenRegistry)
 	if err != nil {
 		return err
 	}
 
-	signaturesHolder := ethElrond.NewSignatureHolder()
+	signaturesHolder, err := components.createSignaturesHolder(args.Configs.GeneralConfig.Relayer.SignaturesHolder)
+	if err != nil {
+		return err
+	}
 	components.ethToElrondSignaturesHolder = signaturesHolder
 	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
 	if err != nil {
@@ -403,6 +591,8 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 		TransferGasLimitBase:    ethereumConfigs.GasLimitBase,
 		TransferGasLimitForEach: ethereumConfigs.GasLimitForEach,
 		AllowDelta:              ethereumConfigs.MaxBlocksDelta,
+		AlertHandler:            components.alertHandler,
+		TokensPolicy:            components.tokensPolicy,
 	}
 
 	components.ethClient, err = ethereum.NewEthereumClient(argsEthClient)
@@ -479,6 +669,140 @@ func (components *ethElrondBridgeComponents) createEthereumRoleProvider(args Arg
 	return nil
 }
 
+func (components *ethElrondBridgeComponents) createPeerScorer(
+	peerScoringConfig config.P2PPeerScoringConfig,
+	peerDenialEvaluator p2p.PeerDenialEvaluator,
+	log logger.Logger,
+) error {
+	peerScoringStatusHandler, err := status.NewStatusHandler(core.PeerScoringStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(peerScoringStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsPeerScorer := p2p.ArgsPeerScorer{
+		Log:                 log,
+		PeerDenialEvaluator: peerDenialEvaluator,
+		StatusHandler:       peerScoringStatusHandler,
+		BanThreshold:        peerScoringConfig.BanThreshold,
+		BaseBanDuration:     time.Duration(peerScoringConfig.BaseBanDurationInSeconds) * time.Second,
+		MaxBanDuration:      time.Duration(peerScoringConfig.MaxBanDurationInSeconds) * time.Second,
+		ScoreRetention:      time.Duration(peerScoringConfig.ScoreRetentionInSeconds) * time.Second,
+	}
+
+	components.peerScorer, err = p2p.NewPeerScorer(argsPeerScorer)
+
+	return err
+}
+
+func (components *ethElrondBridgeComponents) createSignaturesHolder(signaturesHolderConfig config.SignaturesHolderConfig) (p2pSignaturesHolder, error) {
+	signaturesHolderStatusHandler, err := status.NewStatusHandler(core.SignaturesHolderStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(signaturesHolderStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	argsSignaturesHolder := ethElrond.ArgsSignaturesHolder{
+		StatusHandler:         signaturesHolderStatusHandler,
+		TimeToLive:            time.Duration(signaturesHolderConfig.TimeToLiveInSeconds) * time.Second,
+		MaxMessageHashes:      signaturesHolderConfig.MaxMessageHashes,
+		MaxSignaturesPerHash:  signaturesHolderConfig.MaxSignaturesPerHash,
+		MaxHashesPerPublicKey: signaturesHolderConfig.MaxHashesPerPublicKey,
+	}
+
+	signaturesHolder, err := ethElrond.NewSignatureHolder(argsSignaturesHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              components.baseLogger,
+		Name:             "Signatures holder cleaner",
+		PollingInterval:  time.Duration(signaturesHolderConfig.CleanupIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         signaturesHolder,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return signaturesHolder, nil
+}
+
+func (components *ethElrondBridgeComponents) createBalanceMonitor(args ArgsEthereumToElrondBridge) error {
+	configs := args.Configs.GeneralConfig
+	balanceMonitorConfig := configs.Relayer.BalanceMonitor
+	if !balanceMonitorConfig.Enabled {
+		return nil
+	}
+
+	balanceMonitorLogId := components.evmCompatibleChain.BalanceMonitorLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(balanceMonitorLogId), balanceMonitorLogId)
+
+	balanceMonitorStatusHandler, err := status.NewStatusHandler(core.BalanceMonitorStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(balanceMonitorStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsBalanceMonitor := balanceMonitor.ArgsBalanceMonitor{
+		Log:                        log,
+		StatusHandler:              balanceMonitorStatusHandler,
+		AlertHandler:               components.alertHandler,
+		EthereumChainInteractor:    args.ClientWrapper,
+		EthereumAddress:            components.ethereumRelayerAddress,
+		GasHandler:                 components.ethGasHandler,
+		EthereumGasLimitBase:       configs.Eth.GasLimitBase,
+		EthereumGasLimitForEach:    configs.Eth.GasLimitForEach,
+		ElrondProxy:                args.Proxy,
+		ElrondAddress:              components.elrondRelayerAddress,
+		ElrondGasMap:               configs.Elrond.GasMap,
+		NumTransfersPerBatch:       balanceMonitorConfig.NumTransfersPerBatch,
+		WarnThresholdInBatches:     balanceMonitorConfig.WarnThresholdInBatches,
+		CriticalThresholdInBatches: balanceMonitorConfig.CriticalThresholdInBatches,
+	}
+
+	monitor, err := balanceMonitor.NewBalanceMonitor(argsBalanceMonitor)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "Relayer balance monitor",
+		PollingInterval:  time.Duration(balanceMonitorConfig.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         monitor,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
 func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args ArgsEthereumToElrondBridge) error {
 	ethToElrondName := components.evmCompatibleChain.EvmCompatibleChainToElrondName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToElrondName), ethToElrondName)
@@ -491,7 +815,7 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 	components.ethToElrondStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
 
 	argsTopologyHandler := topology.ArgsTopologyHandler{
-		PublicKeysProvider: components.elrondRoleProvider,
+		PublicKeysProvider: components.activePublicKeysProvider,
 		Timer:              components.timer,
 		IntervalForLeader:  time.Second * time.Duration(configs.IntervalForLeaderInSeconds),
 		AddressBytes:       components.elrondRelayerAddress.AddressBytes(),
@@ -516,7 +840,12 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 
 	timeForTransferExecution := time.Second * time.Duration(args.Configs.GeneralConfig.Eth.IntervalToWaitForTransferInSeconds)
 
-	batchValidator, err := components.createBatchValidator(components.evmCompatibleChain, chain.Elrond, args.Configs.GeneralConfig.BatchValidator)
+	batchValidator, err := components.createBatchValidator(components.evmCompatibleChain, chain.Elrond, log, components.ethToElrondStatusHandler, args.Configs.GeneralConfig.BatchValidator)
+	if err != nil {
+		return err
+	}
+
+	fingerprintChecker, err := components.createBatchFingerprintChecker(ethToElrondName, log, args.Configs.GeneralConfig.BatchFingerprint)
 	if err != nil {
 		return err
 	}
@@ -527,12 +856,16 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 		ElrondClient:               components.elrondClient,
 		EthereumClient:             components.ethClient,
 		StatusHandler:              components.ethToElrondStatusHandler,
+		AlertHandler:               components.alertHandler,
 		TimeForWaitOnEthereum:      timeForTransferExecution,
 		SignaturesHolder:           disabled.NewDisabledSignaturesHolder(),
 		BatchValidator:             batchValidator,
+		FingerprintChecker:         fingerprintChecker,
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnElrond:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnWasTransferProposed,
+
+		QuorumChecksBeforeSignaturesRequest: args.Configs.GeneralConfig.Eth.QuorumChecksBeforeSignaturesRequest,
 	}
 
 	bridge, err := ethElrond.NewBridgeExecutor(argsBridgeExecutor)
@@ -544,6 +877,7 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 	if err != nil {
 		return err
 	}
+	components.ethToElrondBridge = bridge
 
 	return nil
 }
@@ -559,7 +893,7 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 
 	components.elrondToEthStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
 	argsTopologyHandler := topology.ArgsTopologyHandler{
-		PublicKeysProvider: components.elrondRoleProvider,
+		PublicKeysProvider: components.activePublicKeysProvider,
 		Timer:              components.timer,
 		IntervalForLeader:  time.Second * time.Duration(configs.IntervalForLeaderInSeconds),
 		AddressBytes:       components.elrondRelayerAddress.AddressBytes(),
@@ -584,7 +918,12 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 
 	timeForWaitOnEthereum := time.Second * time.Duration(args.Configs.GeneralConfig.Eth.IntervalToWaitForTransferInSeconds)
 
-	batchValidator, err := components.createBatchValidator(chain.Elrond, components.evmCompatibleChain, args.Configs.GeneralConfig.BatchValidator)
+	batchValidator, err := components.createBatchValidator(chain.Elrond, components.evmCompatibleChain, log, components.elrondToEthStatusHandler, args.Configs.GeneralConfig.BatchValidator)
+	if err != nil {
+		return err
+	}
+
+	fingerprintChecker, err := components.createBatchFingerprintChecker(elrondToEthName, log, args.Configs.GeneralConfig.BatchFingerprint)
 	if err != nil {
 		return err
 	}
@@ -595,12 +934,16 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 		ElrondClient:               components.elrondClient,
 		EthereumClient:             components.ethClient,
 		StatusHandler:              components.elrondToEthStatusHandler,
+		AlertHandler:               components.alertHandler,
 		TimeForWaitOnEthereum:      timeForWaitOnEthereum,
 		SignaturesHolder:           components.ethToElrondSignaturesHolder,
 		BatchValidator:             batchValidator,
+		FingerprintChecker:         fingerprintChecker,
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnElrond:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnWasTransferProposed,
+
+		QuorumChecksBeforeSignaturesRequest: args.Configs.GeneralConfig.Eth.QuorumChecksBeforeSignaturesRequest,
 	}
 
 	bridge, err := ethElrond.NewBridgeExecutor(argsBridgeExecutor)
@@ -612,6 +955,7 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 	if err != nil {
 		return err
 	}
+	components.elrondToEthBridge = bridge
 
 	return nil
 }
@@ -654,82 +998,499 @@ func (components *ethElrondBridgeComponents) Start() error {
 	return nil
 }
 
-func (components *ethElrondBridgeComponents) createBatchValidator(sourceChain chain.Chain, destinationChain chain.Chain, args config.BatchValidatorConfig) (clients.BatchValidator, error) {
-	argsBatchValidator := batchValidatorManagement.ArgsBatchValidator{
-		SourceChain:      sourceChain,
-		DestinationChain: destinationChain,
-		RequestURL:       args.URL,
-		RequestTime:      time.Second * time.Duration(args.RequestTimeInSeconds),
+func createArgsGasStation(gasStationConfig config.GasStationConfig) gasManagement.ArgsGasStation {
+	return gasManagement.ArgsGasStation{
+		RequestURL:             gasStationConfig.URL,
+		RequestPollingInterval: time.Duration(gasStationConfig.PollingIntervalInSeconds) * time.Second,
+		RequestRetryDelay:      time.Duration(gasStationConfig.RequestRetryDelayInSeconds) * time.Second,
+		MaximumFetchRetries:    gasStationConfig.MaxFetchRetries,
+		RequestTime:            time.Duration(gasStationConfig.RequestTimeInSeconds) * time.Second,
+		MaximumGasPrice:        gasStationConfig.MaximumAllowedGasPrice,
+		GasPriceSelector:       core.EthGasPriceSelector(gasStationConfig.GasPriceSelector),
+		GasPriceMultiplier:     gasStationConfig.GasPriceMultiplier,
+	}
+}
+
+func (components *ethElrondBridgeComponents) createBatchValidator(
+	sourceChain chain.Chain,
+	destinationChain chain.Chain,
+	log logger.Logger,
+	statusHandler core.StatusHandler,
+	args config.BatchValidatorConfig,
+) (clients.BatchValidator, error) {
+	validators, err := components.createBatchValidators(sourceChain, destinationChain, log, args)
+	if err != nil {
+		return nil, err
 	}
 
-	batchValidator, err := batchManagementFactory.CreateBatchValidator(argsBatchValidator, args.Enabled)
+	argsChain := batchValidatorManagement.ArgsBatchValidatorChain{
+		Log:           log,
+		StatusHandler: statusHandler,
+		Composition:   args.Composition,
+		Validators:    validators,
+	}
+	batchValidator, err := batchManagementFactory.CreateBatchValidator(argsChain)
 	if err != nil {
 		return nil, err
 	}
+
+	log.Info("created the batch validators", "num validators", len(validators), "composition", args.Composition)
+
+	components.batchValidators = append(components.batchValidators, &reloadableBatchValidator{
+		sourceChain:      sourceChain,
+		destinationChain: destinationChain,
+		validator:        batchValidator,
+	})
+
 	return batchValidator, err
 }
 
-func (components *ethElrondBridgeComponents) createEthereumToElrondStateMachine() error {
+func (components *ethElrondBridgeComponents) createBatchValidators(
+	sourceChain chain.Chain,
+	destinationChain chain.Chain,
+	log logger.Logger,
+	args config.BatchValidatorConfig,
+) ([]batchValidatorManagement.NamedBatchValidator, error) {
+	validators := make([]batchValidatorManagement.NamedBatchValidator, 0)
+	if args.Enabled {
+		httpValidator, err := batchValidatorManagement.NewBatchValidator(createArgsBatchValidator(sourceChain, destinationChain, args))
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, batchValidatorManagement.NamedBatchValidator{
+			Name:      httpBatchValidatorName,
+			Validator: httpValidator,
+		})
+	}
+
+	if args.CrossCheck.Enabled {
+		batchFetcher, err := components.createCrossCheckBatchFetcher(sourceChain, log, args.CrossCheck)
+		if err != nil {
+			return nil, err
+		}
+		argsCrossCheckValidator := batchValidatorManagement.ArgsCrossCheckValidator{
+			Log:          log,
+			BatchFetcher: batchFetcher,
+		}
+		crossCheckValidator, err := batchValidatorManagement.NewCrossCheckValidator(argsCrossCheckValidator)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, batchValidatorManagement.NamedBatchValidator{
+			Name:      crossCheckBatchValidatorName,
+			Validator: crossCheckValidator,
+		})
+	}
+
+	if args.TokensAllowlist.Enabled {
+		if !components.tokensPolicy.IsEnforced() {
+			log.Warn("the tokens allowlist batch validator is enabled but no token is listed, all the batches will be approved")
+		}
+		argsTokensAllowlistValidator := batchValidatorManagement.ArgsTokensAllowlistValidator{
+			Log:          log,
+			SourceChain:  sourceChain,
+			TokensPolicy: components.tokensPolicy,
+		}
+		tokensAllowlistValidator, err := batchValidatorManagement.NewTokensAllowlistValidator(argsTokensAllowlistValidator)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, batchValidatorManagement.NamedBatchValidator{
+			Name:      tokensAllowlistBatchValidatorName,
+			Validator: tokensAllowlistValidator,
+		})
+	}
+
+	if args.AmountBounds.Enabled {
+		argsAmountBoundsValidator := batchValidatorManagement.ArgsAmountBoundsValidator{
+			Log:                 log,
+			MaxDepositsPerBatch: args.AmountBounds.MaxDepositsPerBatch,
+			MaxDepositAmount:    args.AmountBounds.MaxDepositAmount,
+		}
+		amountBoundsValidator, err := batchValidatorManagement.NewAmountBoundsValidator(argsAmountBoundsValidator)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, batchValidatorManagement.NamedBatchValidator{
+			Name:      amountBoundsBatchValidatorName,
+			Validator: amountBoundsValidator,
+		})
+	}
+
+	return validators, nil
+}
+
+// createCrossCheckBatchFetcher creates the component fetching the batches from an independent node of the source chain
+func (components *ethElrondBridgeComponents) createCrossCheckBatchFetcher(
+	sourceChain chain.Chain,
+	log logger.Logger,
+	crossCheckConfig config.BatchCrossCheckConfig,
+) (batchValidatorManagement.BatchFetcher, error) {
+	if sourceChain == chain.Elrond {
+		return components.createElrondCrossCheckBatchFetcher(log, crossCheckConfig.ElrondNetworkAddress)
+	}
+
+	return components.createEthereumCrossCheckBatchFetcher(crossCheckConfig.EthereumNetworkAddress)
+}
+
+func (components *ethElrondBridgeComponents) createElrondCrossCheckBatchFetcher(log logger.Logger, networkAddress string) (batchValidatorManagement.BatchFetcher, error) {
+	if len(networkAddress) == 0 {
+		return nil, fmt.Errorf("%w, empty BatchValidator.CrossCheck.ElrondNetworkAddress", errMissingConfig)
+	}
+
+	elrondConfigs := components.configs.GeneralConfig.Elrond
+	argsProxy := blockchain.ArgsElrondProxy{
+		ProxyURL:            networkAddress,
+		SameScState:         false,
+		ShouldBeSynced:      false,
+		FinalityCheck:       elrondConfigs.ProxyFinalityCheck,
+		AllowedDeltaToFinal: elrondConfigs.ProxyMaxNoncesDelta,
+		CacheExpirationTime: time.Second * time.Duration(elrondConfigs.ProxyCacherExpirationSeconds),
+		EntityType:          erdgoCore.RestAPIEntityType(elrondConfigs.ProxyRestAPIEntityType),
+	}
+	proxy, err := blockchain.NewElrondProxy(argsProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	argsDataGetter := elrond.ArgsDataGetter{
+		MultisigContractAddress: components.elrondMultisigContractAddress,
+		RelayerAddress:          components.elrondRelayerAddress,
+		Proxy:                   proxy,
+		Log:                     log,
+		ContractProfile:         components.contractProfile,
+	}
+	dataGetter, err := elrond.NewDataGetter(argsDataGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBatchFetcher := elrond.ArgsBatchFetcher{
+		DataGetter:       dataGetter,
+		AddressConverter: components.addressConverter,
+	}
+
+	return elrond.NewBatchFetcher(argsBatchFetcher)
+}
+
+func (components *ethElrondBridgeComponents) createEthereumCrossCheckBatchFetcher(networkAddress string) (batchValidatorManagement.BatchFetcher, error) {
+	if len(networkAddress) == 0 {
+		return nil, fmt.Errorf("%w, empty BatchValidator.CrossCheck.EthereumNetworkAddress", errMissingConfig)
+	}
+
+	ethClient, err := ethclient.Dial(networkAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigContractAddress := common.HexToAddress(components.configs.GeneralConfig.Eth.MultisigContractAddress)
+	multiSigInstance, err := contract.NewBridge(multisigContractAddress, ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.CrossCheckEthClientStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return nil, err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	argsClientWrapper := wrappers.ArgsEthereumChainWrapper{
+		StatusHandler:    statusHandler,
+		MultiSigContract: multiSigInstance,
+		BlockchainClient: ethClient,
+	}
+	clientWrapper, err := wrappers.NewEthereumChainWrapper(argsClientWrapper)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBatchFetcher := ethereum.ArgsBatchFetcher{
+		BatchGetter:      clientWrapper,
+		AddressConverter: components.addressConverter,
+	}
+
+	return ethereum.NewBatchFetcher(argsBatchFetcher)
+}
+
+func (components *ethElrondBridgeComponents) createBatchFingerprintChecker(
+	bridgeName string,
+	log logger.Logger,
+	fingerprintConfig config.BatchFingerprintConfig,
+) (ethElrond.BatchFingerprintChecker, error) {
+	if !fingerprintConfig.Enabled {
+		return disabled.NewDisabledBatchFingerprintChecker(), nil
+	}
+
+	argsAgreementChecker := fingerprint.ArgsAgreementChecker{
+		Log:              log,
+		BridgeName:       bridgeName,
+		Broadcaster:      components.broadcaster,
+		AlertHandler:     components.alertHandler,
+		SelfPublicKey:    components.elrondRelayerAddress.AddressBytes(),
+		MinAgreeingPeers: fingerprintConfig.MinAgreeingPeers,
+		MaxFailedChecks:  fingerprintConfig.MaxFailedChecks,
+	}
+	agreementChecker, err := fingerprint.NewAgreementChecker(argsAgreementChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.broadcaster.AddBatchFingerprintProcessor(agreementChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	return agreementChecker, nil
+}
+
+func createArgsBatchValidator(sourceChain chain.Chain, destinationChain chain.Chain, args config.BatchValidatorConfig) batchValidatorManagement.ArgsBatchValidator {
+	return batchValidatorManagement.ArgsBatchValidator{
+		SourceChain:             sourceChain,
+		DestinationChain:        destinationChain,
+		RequestURL:              args.URL,
+		RequestTime:             time.Second * time.Duration(args.RequestTimeInSeconds),
+		MaxRetries:              args.MaxRetries,
+		RetryDelay:              time.Millisecond * time.Duration(args.RetryDelayInMillis),
+		CacheTTL:                time.Second * time.Duration(args.CacheTTLInSeconds),
+		CircuitBreakerThreshold: args.CircuitBreakerThreshold,
+		CircuitBreakerOpenTime:  time.Second * time.Duration(args.CircuitBreakerOpenTimeInSeconds),
+		ResponsePublicKey:       args.ResponsePublicKey,
+	}
+}
+
+func (components *ethElrondBridgeComponents) createEthereumToElrondStateMachine(args ArgsEthereumToElrondBridge) error {
 	ethToElrondName := components.evmCompatibleChain.EvmCompatibleChainToElrondName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToElrondName), ethToElrondName)
 
+	configs := args.Configs.GeneralConfig.StateMachine[ethToElrondName]
+	stepsWatchdog, err := components.createStepsWatchdog(ethToElrondName, components.ethToElrondMachineStates, log, components.ethToElrondStatusHandler, configs.StepsWatchdog)
+	if err != nil {
+		return err
+	}
+
 	argsStateMachine := stateMachine.ArgsStateMachine{
 		StateMachineName:     ethToElrondName,
 		Steps:                components.ethToElrondMachineStates,
 		StartStateIdentifier: ethToElrondSteps.GettingPendingBatchFromEthereum,
 		Log:                  log,
 		StatusHandler:        components.ethToElrondStatusHandler,
+		StepsWatchdog:        stepsWatchdog,
+		BatchInfoProvider:    components.ethToElrondBridge,
+		Timer:                components.timer,
+		HistorySize:          configs.TransitionsHistorySize,
 	}
 
-	var err error
-	components.ethToElrondStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
+	sm, err := stateMachine.NewStateMachine(argsStateMachine)
 	if err != nil {
 		return err
 	}
+	components.ethToElrondStateMachine = sm
 
-	argsPollingHandler := polling.ArgsPollingHandler{
-		Log:              log,
-		Name:             ethToElrondName + " State machine",
-		PollingInterval:  components.ethToElrondStepDuration,
-		PollingWhenError: pollingDurationOnError,
-		Executor:         components.ethToElrondStateMachine,
-	}
-
-	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	err = components.transitionsHistoryHolder.AddTransitionsHistoryProvider(sm)
 	if err != nil {
 		return err
 	}
 
-	components.addClosableComponent(pollingHandler)
-	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
-
-	return nil
+	return components.createStateMachinePollingHandler(ethToElrondName, log, sm, components.ethToElrondStepDuration, configs.Scheduling)
 }
 
-func (components *ethElrondBridgeComponents) createElrondToEthereumStateMachine() error {
+func (components *ethElrondBridgeComponents) createElrondToEthereumStateMachine(args ArgsEthereumToElrondBridge) error {
 	elrondToEthName := components.evmCompatibleChain.ElrondToEvmCompatibleChainName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(elrondToEthName), elrondToEthName)
 
+	configs := args.Configs.GeneralConfig.StateMachine[elrondToEthName]
+	stepsWatchdog, err := components.createStepsWatchdog(elrondToEthName, components.elrondToEthMachineStates, log, components.elrondToEthStatusHandler, configs.StepsWatchdog)
+	if err != nil {
+		return err
+	}
+
 	argsStateMachine := stateMachine.ArgsStateMachine{
 		StateMachineName:     elrondToEthName,
 		Steps:                components.elrondToEthMachineStates,
 		StartStateIdentifier: elrondToEthSteps.GettingPendingBatchFromElrond,
 		Log:                  log,
 		StatusHandler:        components.elrondToEthStatusHandler,
+		StepsWatchdog:        stepsWatchdog,
+		BatchInfoProvider:    components.elrondToEthBridge,
+		Timer:                components.timer,
+		HistorySize:          configs.TransitionsHistorySize,
 	}
 
+	sm, err := stateMachine.NewStateMachine(argsStateMachine)
+	if err != nil {
+		return err
+	}
+	components.elrondToEthStateMachine = sm
+
+	err = components.transitionsHistoryHolder.AddTransitionsHistoryProvider(sm)
+	if err != nil {
+		return err
+	}
+
+	return components.createStateMachinePollingHandler(elrondToEthName, log, sm, components.elrondToEthStepDuration, configs.Scheduling)
+}
+
+func (components *ethElrondBridgeComponents) createStateMachinePollingHandler(
+	stateMachineName string,
+	log logger.Logger,
+	executor scheduler.HintedExecutor,
+	stepDuration time.Duration,
+	cfg config.SchedulingConfig,
+) error {
+	var pollingHandler closablePollingHandler
 	var err error
-	components.elrondToEthStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
+	if cfg.Enabled {
+		intervals := createSchedulingIntervals(stepDuration, cfg)
+		argsPollingHandler := scheduler.ArgsAdaptivePollingHandler{
+			Log:                  log,
+			Name:                 stateMachineName + " State machine",
+			Executor:             executor,
+			FastInterval:         intervals.Fast,
+			RegularInterval:      intervals.Regular,
+			IdleInterval:         intervals.Idle,
+			ErrorInitialInterval: intervals.ErrorInitial,
+			ErrorMaxInterval:     intervals.ErrorMax,
+		}
+		var adaptivePollingHandler adaptiveClosablePollingHandler
+		adaptivePollingHandler, err = scheduler.NewAdaptivePollingHandler(argsPollingHandler)
+		if err != nil {
+			return err
+		}
+
+		components.adaptivePollingHandlers[stateMachineName] = adaptivePollingHandler
+		pollingHandler = adaptivePollingHandler
+	} else {
+		argsPollingHandler := polling.ArgsPollingHandler{
+			Log:              log,
+			Name:             stateMachineName + " State machine",
+			PollingInterval:  stepDuration,
+			PollingWhenError: pollingDurationOnError,
+			Executor:         executor,
+		}
+		pollingHandler, err = polling.NewPollingHandler(argsPollingHandler)
+	}
 	if err != nil {
 		return err
 	}
 
-	argsPollingHandler := polling.ArgsPollingHandler{
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func createSchedulingIntervals(stepDuration time.Duration, cfg config.SchedulingConfig) scheduler.Intervals {
+	return scheduler.Intervals{
+		Fast:         time.Duration(cfg.FastIntervalInMillis) * time.Millisecond,
+		Regular:      stepDuration,
+		Idle:         time.Duration(cfg.IdleIntervalInMillis) * time.Millisecond,
+		ErrorInitial: time.Duration(cfg.ErrorBackoffInitialInMillis) * time.Millisecond,
+		ErrorMax:     time.Duration(cfg.ErrorBackoffMaxInMillis) * time.Millisecond,
+	}
+}
+
+func (components *ethElrondBridgeComponents) createStepsWatchdog(
+	stateMachineName string,
+	steps core.MachineStates,
+	log logger.Logger,
+	statusHandler core.StatusHandler,
+	watchdogConfig config.StepsWatchdogConfig,
+) (stateMachine.StepsWatchdog, error) {
+	if !watchdogConfig.Enabled {
+		return &stateMachineDisabled.DisabledStepsWatchdog{}, nil
+	}
+
+	maxDwellTimes := make(map[core.StepIdentifier]time.Duration)
+	for identifier, maxDwellTimeInSeconds := range watchdogConfig.MaxDwellTimesInSeconds {
+		stepIdentifier := core.StepIdentifier(identifier)
+		_, found := steps[stepIdentifier]
+		if !found {
+			return nil, fmt.Errorf("%w %q in the steps watchdog config of %s", errUnknownStep, identifier, stateMachineName)
+		}
+
+		maxDwellTimes[stepIdentifier] = time.Duration(maxDwellTimeInSeconds) * time.Second
+	}
+
+	argsStepsWatchdog := watchdog.ArgsStepsWatchdog{
+		StateMachineName: stateMachineName,
 		Log:              log,
-		Name:             elrondToEthName + " State machine",
-		PollingInterval:  components.elrondToEthStepDuration,
+		StatusHandler:    statusHandler,
+		AlertHandler:     components.alertHandler,
+		Timer:            components.timer,
+		Action:           watchdog.Action(watchdogConfig.Action),
+		MaxDwellTimes:    maxDwellTimes,
+	}
+
+	return watchdog.NewStepsWatchdog(argsStepsWatchdog)
+}
+
+func (components *ethElrondBridgeComponents) createHealthChecker(healthConfig config.HealthConfig) error {
+	argsHealthChecker := health.ArgsHealthChecker{
+		Config:                 healthConfig,
+		MetricsHolder:          components.metricsHolder,
+		PeersProvider:          components.messenger,
+		ElrondRoleProvider:     components.elrondRoleProvider,
+		EthereumRoleProvider:   components.ethereumRoleProvider,
+		ElrondRelayerAddress:   components.elrondRelayerAddress,
+		EthereumRelayerAddress: components.ethereumRelayerAddress,
+		StateMachines:          []health.StateMachine{components.ethToElrondStateMachine, components.elrondToEthStateMachine},
+		Timer:                  components.timer,
+	}
+
+	var err error
+	components.healthChecker, err = health.NewHealthChecker(argsHealthChecker)
+
+	return err
+}
+
+func (components *ethElrondBridgeComponents) createHeartbeatComponents(args ArgsEthereumToElrondBridge) error {
+	heartbeatConfig := args.Configs.GeneralConfig.P2P.Heartbeat
+	argsClusterView := heartbeat.ArgsClusterView{
+		ElrondRoleProvider: components.elrondRoleProvider,
+		SelfPublicKey:      components.elrondRelayerAddress.AddressBytes(),
+		SilenceThreshold:   time.Duration(heartbeatConfig.SilenceThresholdInSeconds) * time.Second,
+	}
+	clusterView, err := heartbeat.NewClusterView(argsClusterView)
+	if err != nil {
+		return err
+	}
+	components.clusterView = clusterView
+
+	err = components.broadcaster.AddHeartbeatProcessor(clusterView)
+	if err != nil {
+		return err
+	}
+
+	argsHeartbeatSender := heartbeat.ArgsHeartbeatSender{
+		Broadcaster:   components.broadcaster,
+		MetricsHolder: components.metricsHolder,
+		StateMachines: []heartbeat.MonitoredStateMachine{
+			{
+				Name:              components.evmCompatibleChain.EvmCompatibleChainToElrondName(),
+				BatchInfoProvider: components.ethToElrondBridge,
+			},
+			{
+				Name:              components.evmCompatibleChain.ElrondToEvmCompatibleChainName(),
+				BatchInfoProvider: components.elrondToEthBridge,
+			},
+		},
+		AppVersion: args.AppVersion,
+	}
+	heartbeatSender, err := heartbeat.NewHeartbeatSender(argsHeartbeatSender)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              components.baseLogger,
+		Name:             "Heartbeat sender",
+		PollingInterval:  time.Duration(heartbeatConfig.IntervalInSeconds) * time.Second,
 		PollingWhenError: pollingDurationOnError,
-		Executor:         components.elrondToEthStateMachine,
+		Executor:         heartbeatSender,
 	}
 
 	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
@@ -783,6 +1544,42 @@ func (components *ethElrondBridgeComponents) startBroadcastJoinRetriesLoop() {
 	}
 }
 
+// Drain prepares the sub-components for closing: the state machines stop executing new steps and wait for the
+// steps in progress, the pending records are flushed and the other relayers are notified that this relayer is leaving.
+// The provided context bounds the waiting time
+func (components *ethElrondBridgeComponents) Drain(ctx context.Context) error {
+	components.mutClosableHandlers.RLock()
+	defer components.mutClosableHandlers.RUnlock()
+
+	if components.cancelFunc != nil {
+		components.cancelFunc()
+	}
+
+	var lastError error
+	stateMachines := []StateMachine{components.ethToElrondStateMachine, components.elrondToEthStateMachine}
+	for _, sm := range stateMachines {
+		err := sm.Drain(ctx)
+		if err != nil {
+			lastError = err
+
+			components.baseLogger.Error("error draining state machine", "error", err)
+		}
+	}
+
+	for _, flushable := range components.flushableHandlers {
+		err := flushable.Flush(ctx)
+		if err != nil {
+			lastError = err
+
+			components.baseLogger.Error("error flushing component", "error", err)
+		}
+	}
+
+	components.broadcaster.BroadcastLeaving()
+
+	return lastError
+}
+
 // Close will close any sub-components started
 func (components *ethElrondBridgeComponents) Close() error {
 	components.mutClosableHandlers.RLock()
@@ -810,6 +1607,26 @@ func (components *ethElrondBridgeComponents) Close() error {
 	return lastError
 }
 
+// Liveness returns the liveness report of this relayer
+func (components *ethElrondBridgeComponents) Liveness() core.HealthReport {
+	return components.healthChecker.Liveness()
+}
+
+// Readiness returns the readiness report of this relayer
+func (components *ethElrondBridgeComponents) Readiness() core.HealthReport {
+	return components.healthChecker.Readiness()
+}
+
+// PeerScores returns the scores of the peers that sent invalid messages
+func (components *ethElrondBridgeComponents) PeerScores() []core.PeerScore {
+	return components.peerScorer.PeerScores()
+}
+
+// ClusterInfo returns the state of every whitelisted relayer as reported in the received heartbeats
+func (components *ethElrondBridgeComponents) ClusterInfo() []core.RelayerStatus {
+	return components.clusterView.ClusterInfo()
+}
+
 // ElrondRelayerAddress returns the Elrond's address associated to this relayer
 func (components *ethElrondBridgeComponents) ElrondRelayerAddress() erdgoCore.AddressHandler {
 	return components.elrondRelayerAddress
@@ -819,3 +1636,8 @@ func (components *ethElrondBridgeComponents) ElrondRelayerAddress() erdgoCore.Ad
 func (components *ethElrondBridgeComponents) EthereumRelayerAddress() common.Address {
 	return components.ethereumRelayerAddress
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (components *ethElrondBridgeComponents) IsInterfaceNil() bool {
+	return components == nil
+}
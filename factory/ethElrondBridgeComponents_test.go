@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,13 +9,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/alerts"
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/fingerprint"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/health"
+	"github.com/ElrondNetwork/elrond-eth-bridge/heartbeat"
+	"github.com/ElrondNetwork/elrond-eth-bridge/p2p"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/scheduler"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/watchdog"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	p2pMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/p2p"
+	stateMachineMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/stateMachine"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
@@ -28,6 +39,21 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 	stateMachineConfig := config.ConfigStateMachine{
 		StepDurationInMillis:       1000,
 		IntervalForLeaderInSeconds: 60,
+		TransitionsHistorySize:     100,
+		StepsWatchdog: config.StepsWatchdogConfig{
+			Enabled: true,
+			Action:  "reset",
+			MaxDwellTimesInSeconds: map[string]uint64{
+				"sign proposed transfer": 600,
+			},
+		},
+		Scheduling: config.SchedulingConfig{
+			Enabled:                     true,
+			FastIntervalInMillis:        100,
+			IdleIntervalInMillis:        5000,
+			ErrorBackoffInitialInMillis: 1000,
+			ErrorBackoffMaxInMillis:     10000,
+		},
 	}
 
 	cfg := config.Config{
@@ -60,19 +86,74 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 			NetworkAddress:                  "http://127.0.0.1:8079",
 			MultisigContractAddress:         "erd1qqqqqqqqqqqqqpgqgftcwj09u0nhmskrw7xxqcqh8qmzwyexd8ss7ftcxx",
 			GasMap:                          testsCommon.CreateTestElrondGasMap(),
+			TransactionOutcome:              testsCommon.CreateTestElrondTransactionOutcomeConfig(),
 			MaxRetriesOnQuorumReached:       1,
 			MaxRetriesOnWasTransferProposed: 1,
 			ProxyMaxNoncesDelta:             5,
+			GasEstimation: config.ElrondGasEstimationConfig{
+				Enabled:            true,
+				GasLimitMultiplier: 1.2,
+				MaxGasLimit:        600000000,
+			},
+		},
+		P2P: config.ConfigP2P{
+			Messages: config.P2PMessagesConfig{
+				Marshalizer:         "json",
+				AcceptBothEncodings: true,
+			},
+			SignaturesRequests: config.P2PSignaturesRequestsConfig{
+				ResponsesIntervalInMillis: 1000,
+				RequestTimeoutInSeconds:   60,
+			},
+			PeerScoring: config.P2PPeerScoringConfig{
+				BanThreshold:             100,
+				BaseBanDurationInSeconds: 60,
+				MaxBanDurationInSeconds:  3600,
+				ScoreRetentionInSeconds:  3600,
+			},
+			Heartbeat: config.P2PHeartbeatConfig{
+				IntervalInSeconds:         30,
+				SilenceThresholdInSeconds: 120,
+			},
 		},
 		Relayer: config.ConfigRelayer{
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			BalanceMonitor: config.BalanceMonitorConfig{
+				Enabled:                    true,
+				PollingIntervalInMillis:    1000,
+				NumTransfersPerBatch:       10,
+				WarnThresholdInBatches:     50,
+				CriticalThresholdInBatches: 10,
+			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				TimeToLiveInSeconds:     3600,
+				MaxMessageHashes:        100,
+				MaxSignaturesPerHash:    100,
+				MaxHashesPerPublicKey:   5,
+				CleanupIntervalInMillis: 1000,
+			},
 		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToElrond": stateMachineConfig,
 			"ElrondToEthereum": stateMachineConfig,
 		},
+		BatchFingerprint: config.BatchFingerprintConfig{
+			Enabled:          true,
+			MinAgreeingPeers: 2,
+			MaxFailedChecks:  30,
+		},
+		Health: config.HealthConfig{
+			LivenessWindowInSeconds:     300,
+			ProgressWindowInSeconds:     7200,
+			RoleProviderMaxAgeInSeconds: 300,
+			MinConnectedPeers:           1,
+		},
+		TokenRegistry: config.TokenRegistryConfig{
+			CacheTTLInSeconds:        600,
+			RefreshIntervalInSeconds: 300,
+		},
 	}
 	configs := config.Configs{
 		GeneralConfig:   cfg,
@@ -99,6 +180,7 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 		TimeForBootstrap:          minTimeForBootstrap,
 		TimeBeforeRepeatJoin:      minTimeBeforeRepeatJoin,
 		MetricsHolder:             status.NewMetricsHolder(),
+		TransitionsHistoryHolder:  stateMachine.NewTransitionsHistoryHolder(),
 		AppStatusHandler:          &statusHandler.AppStatusHandlerStub{},
 	}
 }
@@ -215,6 +297,182 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), args.Configs.GeneralConfig.Eth.Chain.EvmCompatibleChainToElrondName()))
 		assert.Nil(t, components)
 	})
+	t.Run("unknown step in steps watchdog config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		ethToElrondName := args.Configs.GeneralConfig.Eth.Chain.EvmCompatibleChainToElrondName()
+		stateMachineConfig := args.Configs.GeneralConfig.StateMachine[ethToElrondName]
+		stateMachineConfig.StepsWatchdog.MaxDwellTimesInSeconds = map[string]uint64{
+			"unknown": 600,
+		}
+		args.Configs.GeneralConfig.StateMachine[ethToElrondName] = stateMachineConfig
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errUnknownStep))
+		assert.True(t, strings.Contains(err.Error(), ethToElrondName))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid steps watchdog action", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		elrondToEthName := args.Configs.GeneralConfig.Eth.Chain.ElrondToEvmCompatibleChainName()
+		stateMachineConfig := args.Configs.GeneralConfig.StateMachine[elrondToEthName]
+		stateMachineConfig.StepsWatchdog.Action = "restart"
+		args.Configs.GeneralConfig.StateMachine[elrondToEthName] = stateMachineConfig
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, watchdog.ErrInvalidAction))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid scheduling intervals", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		ethToElrondName := args.Configs.GeneralConfig.Eth.Chain.EvmCompatibleChainToElrondName()
+		stateMachineConfig := args.Configs.GeneralConfig.StateMachine[ethToElrondName]
+		stateMachineConfig.Scheduling.IdleIntervalInMillis = 10
+		args.Configs.GeneralConfig.StateMachine[ethToElrondName] = stateMachineConfig
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, scheduler.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid p2p messages marshalizer", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.P2P.Messages.Marshalizer = "tx-json"
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, p2p.ErrUnsupportedMarshalizerType))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid p2p signatures requests config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.P2P.SignaturesRequests.RequestTimeoutInSeconds = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidDuration))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid p2p peer scoring config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.P2P.PeerScoring.BanThreshold = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid p2p heartbeat config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.P2P.Heartbeat.SilenceThresholdInSeconds = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidDuration))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid Elrond gas estimation config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Elrond.GasEstimation.GasLimitMultiplier = 0.5
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid token registry config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.TokenRegistry.CacheTTLInSeconds = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid tokens policy config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Tokens = map[string]config.TokenPolicyConfig{
+			"WEGLD": {
+				Custody: "unknown",
+			},
+		}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "unknown custody model"))
+		assert.Nil(t, components)
+	})
+	t.Run("unknown Elrond contract profile", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Elrond.ContractProfile = "unknown"
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "contract profile not found"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid batch validators composition", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.BatchValidator.Composition = "xor"
+		args.Configs.GeneralConfig.BatchValidator.AmountBounds.Enabled = true
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "invalid batch validators composition"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid amount bounds batch validator config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.BatchValidator.AmountBounds.Enabled = true
+		args.Configs.GeneralConfig.BatchValidator.AmountBounds.MaxDepositAmount = "invalid"
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("cross-check batch validator without network address", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.BatchValidator.CrossCheck.Enabled = true
+		args.Configs.GeneralConfig.BatchValidator.CrossCheck.ElrondNetworkAddress = "http://127.0.0.1:8079"
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errMissingConfig))
+		assert.True(t, strings.Contains(err.Error(), "EthereumNetworkAddress"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid batch fingerprint config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.BatchFingerprint.MinAgreeingPeers = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, fingerprint.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid signatures holder config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.SignaturesHolder.MaxMessageHashes = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid health config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Health.LivenessWindowInSeconds = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, health.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
 	t.Run("invalid time for bootstrap", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -235,6 +493,15 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), "for TimeBeforeRepeatJoin"))
 		assert.Nil(t, components)
 	})
+	t.Run("nil TransitionsHistoryHolder", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.TransitionsHistoryHolder = nil
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.Equal(t, errNilTransitionsHistory, err)
+		assert.Nil(t, components)
+	})
 	t.Run("nil MetricsHolder", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -244,6 +511,16 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.Equal(t, errNilMetricsHolder, err)
 		assert.Nil(t, components)
 	})
+	t.Run("invalid alerts config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Alerts.Enabled = true
+		args.Configs.GeneralConfig.Alerts.QueueSize = 0
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, alerts.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -251,12 +528,72 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		components, err := NewEthElrondBridgeComponents(args)
 		require.Nil(t, err)
 		require.NotNil(t, components)
-		require.Equal(t, 6, len(components.closableHandlers))
+		require.Equal(t, 11, len(components.closableHandlers))
 		require.False(t, check.IfNil(components.ethToElrondStatusHandler))
 		require.False(t, check.IfNil(components.elrondToEthStatusHandler))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.BalanceMonitorStatusHandlerName)
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.SignaturesHolderStatusHandlerName)
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.PeerScoringStatusHandlerName)
+		require.Equal(t, []string{"ElrondToEthereum", "EthereumToElrond"}, args.TransitionsHistoryHolder.GetAvailableStateMachines())
+	})
+	t.Run("should work with disabled balance monitor", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BalanceMonitor.Enabled = false
+
+		components, err := NewEthElrondBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		require.Equal(t, 10, len(components.closableHandlers))
+		require.Equal(t, []string{"ElrondToEthereum", "EthereumToElrond", core.PeerScoringStatusHandlerName, core.SignaturesHolderStatusHandlerName},
+			args.MetricsHolder.GetAvailableStatusHandlers())
 	})
 }
 
+func TestEthElrondBridgeComponents_CreateBatchValidators(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthElrondBridgeArgs()
+	args.Configs.GeneralConfig.BatchValidator = config.BatchValidatorConfig{
+		Enabled:              true,
+		RequestTimeInSeconds: 1,
+		Composition:          "or",
+		CrossCheck: config.BatchCrossCheckConfig{
+			Enabled:                true,
+			EthereumNetworkAddress: "http://127.0.0.1:8078",
+			ElrondNetworkAddress:   "http://127.0.0.1:8079",
+		},
+		TokensAllowlist: config.BatchTokensAllowlistConfig{
+			Enabled: true,
+		},
+		AmountBounds: config.BatchAmountBoundsConfig{
+			Enabled:             true,
+			MaxDepositsPerBatch: 100,
+		},
+	}
+	args.Configs.GeneralConfig.Elrond.ProxyRestAPIEntityType = "observer"
+	args.Configs.GeneralConfig.Elrond.ProxyCacherExpirationSeconds = 600
+	components, err := NewEthElrondBridgeComponents(args)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(components.batchValidators))
+
+	// the Ethereum cross-check client registers its own status handler, use a clean holder for the rebuilds
+	components.metricsHolder = status.NewMetricsHolder()
+
+	for _, sourceChain := range []chain.Chain{chain.Ethereum, chain.Elrond} {
+		validators, errCreate := components.createBatchValidators(sourceChain, chain.Elrond, components.baseLogger, args.Configs.GeneralConfig.BatchValidator)
+		require.Nil(t, errCreate)
+
+		names := make([]string, 0, len(validators))
+		for _, namedValidator := range validators {
+			names = append(names, namedValidator.Name)
+		}
+		assert.Equal(t, []string{httpBatchValidatorName, crossCheckBatchValidatorName, tokensAllowlistBatchValidatorName, amountBoundsBatchValidatorName}, names)
+	}
+
+	_ = components.Close()
+}
+
 func TestEthElrondBridgeComponents_StartAndCloseShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -266,7 +603,7 @@ func TestEthElrondBridgeComponents_StartAndCloseShouldWork(t *testing.T) {
 
 	err = components.Start()
 	assert.Nil(t, err)
-	assert.Equal(t, 6, len(components.closableHandlers))
+	assert.Equal(t, 11, len(components.closableHandlers))
 
 	time.Sleep(time.Second * 2) // allow go routines to start
 
@@ -309,6 +646,69 @@ func TestEthElrondBridgeComponents_Start(t *testing.T) {
 	})
 }
 
+func TestEthElrondBridgeComponents_Drain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should drain, flush and broadcast leaving", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make([]string, 0)
+		args := createMockEthElrondBridgeArgs()
+		components, _ := NewEthElrondBridgeComponents(args)
+		components.ethToElrondStateMachine = &stateMachineMocks.StateMachineStub{
+			DrainCalled: func(ctx context.Context) error {
+				calls = append(calls, "drain eth to elrond")
+				return nil
+			},
+		}
+		components.elrondToEthStateMachine = &stateMachineMocks.StateMachineStub{
+			DrainCalled: func(ctx context.Context) error {
+				calls = append(calls, "drain elrond to eth")
+				return nil
+			},
+		}
+		components.flushableHandlers = []core.Flusher{
+			&testsCommon.FlusherStub{
+				FlushCalled: func(ctx context.Context) error {
+					calls = append(calls, "flush")
+					return nil
+				},
+			},
+		}
+		components.broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastLeavingCalled: func() {
+				calls = append(calls, "leave")
+			},
+		}
+
+		err := components.Drain(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"drain eth to elrond", "drain elrond to eth", "flush", "leave"}, calls)
+	})
+	t.Run("errors should not stop the drain", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		leaveBroadcast := false
+		args := createMockEthElrondBridgeArgs()
+		components, _ := NewEthElrondBridgeComponents(args)
+		components.ethToElrondStateMachine = &stateMachineMocks.StateMachineStub{
+			DrainCalled: func(ctx context.Context) error {
+				return expectedErr
+			},
+		}
+		components.broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastLeavingCalled: func() {
+				leaveBroadcast = true
+			},
+		}
+
+		err := components.Drain(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, leaveBroadcast)
+	})
+}
+
 func TestEthElrondBridgeComponents_Close(t *testing.T) {
 	t.Parallel()
 
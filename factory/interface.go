@@ -2,9 +2,15 @@ package factory
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/p2p"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine/scheduler"
 	erdgoCore "github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type dataGetter interface {
@@ -14,11 +20,27 @@ type dataGetter interface {
 	IsInterfaceNil() bool
 }
 
+type tokenRegistry interface {
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	Execute(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+type tokensPolicyHandler interface {
+	IsEnforced() bool
+	CheckEthereumDeposit(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error
+	CheckElrondDeposit(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error
+	BalanceCheckMode(erc20Address []byte, esdtTokenID []byte) string
+	IsInterfaceNil() bool
+}
+
 // ElrondRoleProvider defines the operations for the Elrond role provider
 type ElrondRoleProvider interface {
 	Execute(ctx context.Context) error
 	IsWhitelisted(address erdgoCore.AddressHandler) bool
 	SortedPublicKeys() [][]byte
+	LastUpdateTime() time.Time
 	IsInterfaceNil() bool
 }
 
@@ -26,16 +48,25 @@ type ElrondRoleProvider interface {
 type EthereumRoleProvider interface {
 	Execute(ctx context.Context) error
 	VerifyEthSignature(signature []byte, messageHash []byte) error
+	IsWhitelisted(address common.Address) bool
+	LastUpdateTime() time.Time
 	IsInterfaceNil() bool
 }
 
 // Broadcaster defines a component able to communicate with other such instances and manage signatures and other state related data
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	BroadcastJoinTopic()
+	BroadcastLeaving()
+	HasLeft(publicKey []byte) bool
 	SortedPublicKeys() [][]byte
 	RegisterOnTopics() error
 	AddBroadcastClient(client core.BroadcastClient) error
+	BroadcastHeartbeat(heartbeat *core.Heartbeat)
+	AddHeartbeatProcessor(processor core.HeartbeatProcessor) error
+	BroadcastBatchFingerprint(fingerprint *core.BatchFingerprint)
+	AddBatchFingerprintProcessor(processor core.BatchFingerprintProcessor) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -43,6 +74,14 @@ type Broadcaster interface {
 // StateMachine defines a state machine component
 type StateMachine interface {
 	Execute(ctx context.Context) error
+	Drain(ctx context.Context) error
+	Pause()
+	Resume()
+	ForceStartStep(ctx context.Context) error
+	Name() string
+	IsPaused() bool
+	LastExecutionTime() time.Time
+	LastProgressTime() time.Time
 	IsInterfaceNil() bool
 }
 
@@ -51,3 +90,33 @@ type PollingHandler interface {
 	StartProcessingLoop() error
 	IsInterfaceNil() bool
 }
+
+type closablePollingHandler interface {
+	PollingHandler
+	Close() error
+}
+
+type p2pSignaturesCleaner interface {
+	stateMachine.BatchInfoProvider
+	ClearStoredP2PSignaturesForEthereum()
+}
+
+type p2pSignaturesHolder interface {
+	core.BroadcastClient
+	Signatures(messageHash []byte) [][]byte
+	ClearStoredSignatures()
+}
+
+type peerScorer interface {
+	p2p.PeerScorer
+	PeerScores() []core.PeerScore
+}
+
+type intervalsSetter interface {
+	SetIntervals(intervals scheduler.Intervals) error
+}
+
+type adaptiveClosablePollingHandler interface {
+	closablePollingHandler
+	intervalsSetter
+}
@@ -10,11 +10,26 @@ import (
 )
 
 // StartWebServer creates and starts a web server able to respond with the metrics holder information
-func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (io.Closer, error) {
+func StartWebServer(
+	configs config.Configs,
+	metricsHolder core.MetricsHolder,
+	transitionsHistoryHolder core.TransitionsHistoryHolder,
+	configReloader core.ConfigReloader,
+	bridgeOperator core.BridgeOperator,
+	healthChecker core.HealthChecker,
+	peerScoresProvider core.PeerScoresProvider,
+	clusterInfoProvider core.ClusterInfoProvider,
+) (io.Closer, error) {
 	argsFacade := facade.ArgsRelayerFacade{
-		MetricsHolder: metricsHolder,
-		ApiInterface:  configs.FlagsConfig.RestApiInterface,
-		PprofEnabled:  configs.FlagsConfig.EnablePprof,
+		MetricsHolder:            metricsHolder,
+		TransitionsHistoryHolder: transitionsHistoryHolder,
+		ConfigReloader:           configReloader,
+		BridgeOperator:           bridgeOperator,
+		HealthChecker:            healthChecker,
+		PeerScoresProvider:       peerScoresProvider,
+		ClusterInfoProvider:      clusterInfoProvider,
+		ApiInterface:             configs.FlagsConfig.RestApiInterface,
+		PprofEnabled:             configs.FlagsConfig.EnablePprof,
 	}
 
 	relayerFacade, err := facade.NewRelayerFacade(argsFacade)
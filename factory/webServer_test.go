@@ -5,7 +5,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,7 +22,7 @@ func TestStartWebServer(t *testing.T) {
 		},
 	}
 
-	webServer, err := StartWebServer(cfg, status.NewMetricsHolder())
+	webServer, err := StartWebServer(cfg, status.NewMetricsHolder(), stateMachine.NewTransitionsHistoryHolder(), &testsCommon.ConfigReloaderStub{}, &testsCommon.BridgeOperatorStub{}, &testsCommon.HealthCheckerStub{}, &testsCommon.PeerScoresProviderStub{}, &testsCommon.ClusterInfoProviderStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, webServer)
 
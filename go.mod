@@ -13,6 +13,7 @@ require (
 	github.com/gin-contrib/cors v0.0.0-20190301062745-f9e10995c85a
 	github.com/gin-contrib/pprof v1.3.0
 	github.com/gin-gonic/gin v1.7.7
+	github.com/gogo/protobuf v1.3.2
 	github.com/stretchr/testify v1.7.0
 	github.com/urfave/cli v1.22.5
 )
@@ -0,0 +1,27 @@
+package health
+
+import "errors"
+
+// ErrNilMetricsHolder signals that a nil metrics holder was provided
+var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilPeersProvider signals that a nil peers provider was provided
+var ErrNilPeersProvider = errors.New("nil peers provider")
+
+// ErrNilElrondRoleProvider signals that a nil Elrond role provider was provided
+var ErrNilElrondRoleProvider = errors.New("nil Elrond role provider")
+
+// ErrNilEthereumRoleProvider signals that a nil Ethereum role provider was provided
+var ErrNilEthereumRoleProvider = errors.New("nil Ethereum role provider")
+
+// ErrNilElrondRelayerAddress signals that a nil Elrond relayer address was provided
+var ErrNilElrondRelayerAddress = errors.New("nil Elrond relayer address")
+
+// ErrNilStateMachine signals that a nil state machine was provided
+var ErrNilStateMachine = errors.New("nil state machine")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
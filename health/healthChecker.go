@@ -0,0 +1,248 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	erdgoCore "github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	ethereumClientCheck       = "ethereum client"
+	elrondClientCheck         = "elrond client"
+	p2pPeersCheck             = "p2p peers"
+	ethereumRoleProviderCheck = "ethereum role provider"
+	elrondRoleProviderCheck   = "elrond role provider"
+	ethereumWhitelistCheck    = "ethereum whitelist"
+	elrondWhitelistCheck      = "elrond whitelist"
+	executionCheckFormat      = "%s execution"
+	progressCheckFormat       = "%s progress"
+)
+
+// ArgsHealthChecker is the DTO used to create a new instance of healthChecker
+type ArgsHealthChecker struct {
+	Config                 config.HealthConfig
+	MetricsHolder          core.MetricsHolder
+	PeersProvider          PeersProvider
+	ElrondRoleProvider     ElrondRoleProvider
+	EthereumRoleProvider   EthereumRoleProvider
+	ElrondRelayerAddress   erdgoCore.AddressHandler
+	EthereumRelayerAddress common.Address
+	StateMachines          []StateMachine
+	Timer                  core.Timer
+}
+
+type healthChecker struct {
+	livenessWindow         time.Duration
+	progressWindow         time.Duration
+	roleProviderMaxAge     time.Duration
+	minConnectedPeers      int
+	metricsHolder          core.MetricsHolder
+	peersProvider          PeersProvider
+	elrondRoleProvider     ElrondRoleProvider
+	ethereumRoleProvider   EthereumRoleProvider
+	elrondRelayerAddress   erdgoCore.AddressHandler
+	ethereumRelayerAddress common.Address
+	stateMachines          []StateMachine
+	timer                  core.Timer
+}
+
+// NewHealthChecker creates a new instance of healthChecker able to build the liveness and the readiness reports
+func NewHealthChecker(args ArgsHealthChecker) (*healthChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &healthChecker{
+		livenessWindow:         time.Duration(args.Config.LivenessWindowInSeconds) * time.Second,
+		progressWindow:         time.Duration(args.Config.ProgressWindowInSeconds) * time.Second,
+		roleProviderMaxAge:     time.Duration(args.Config.RoleProviderMaxAgeInSeconds) * time.Second,
+		minConnectedPeers:      args.Config.MinConnectedPeers,
+		metricsHolder:          args.MetricsHolder,
+		peersProvider:          args.PeersProvider,
+		elrondRoleProvider:     args.ElrondRoleProvider,
+		ethereumRoleProvider:   args.EthereumRoleProvider,
+		elrondRelayerAddress:   args.ElrondRelayerAddress,
+		ethereumRelayerAddress: args.EthereumRelayerAddress,
+		stateMachines:          args.StateMachines,
+		timer:                  args.Timer,
+	}, nil
+}
+
+func checkArgs(args ArgsHealthChecker) error {
+	if check.IfNil(args.MetricsHolder) {
+		return ErrNilMetricsHolder
+	}
+	if check.IfNil(args.PeersProvider) {
+		return ErrNilPeersProvider
+	}
+	if check.IfNil(args.ElrondRoleProvider) {
+		return ErrNilElrondRoleProvider
+	}
+	if check.IfNil(args.EthereumRoleProvider) {
+		return ErrNilEthereumRoleProvider
+	}
+	if check.IfNil(args.ElrondRelayerAddress) {
+		return ErrNilElrondRelayerAddress
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	for idx, sm := range args.StateMachines {
+		if check.IfNil(sm) {
+			return fmt.Errorf("%w at index %d", ErrNilStateMachine, idx)
+		}
+	}
+	if args.Config.LivenessWindowInSeconds == 0 {
+		return fmt.Errorf("%w for LivenessWindowInSeconds", ErrInvalidValue)
+	}
+	if args.Config.ProgressWindowInSeconds == 0 {
+		return fmt.Errorf("%w for ProgressWindowInSeconds", ErrInvalidValue)
+	}
+	if args.Config.RoleProviderMaxAgeInSeconds == 0 {
+		return fmt.Errorf("%w for RoleProviderMaxAgeInSeconds", ErrInvalidValue)
+	}
+	if args.Config.MinConnectedPeers < 0 {
+		return fmt.Errorf("%w for MinConnectedPeers: %d", ErrInvalidValue, args.Config.MinConnectedPeers)
+	}
+
+	return nil
+}
+
+// Liveness returns the liveness report: the relayer is alive as long as all its state machines are executed
+func (hc *healthChecker) Liveness() core.HealthReport {
+	now := hc.now()
+	checks := make([]core.HealthCheck, 0, len(hc.stateMachines))
+	for _, sm := range hc.stateMachines {
+		name := fmt.Sprintf(executionCheckFormat, sm.Name())
+		checks = append(checks, checkAge(name, "last execution", sm.LastExecutionTime(), now, hc.livenessWindow))
+	}
+
+	return createReport(checks)
+}
+
+// Readiness returns the readiness report: the relayer is ready if both chain clients are available, it is connected
+// to enough peers, it is whitelisted on both chains according to recently fetched data and all its state machines
+// made progress recently
+func (hc *healthChecker) Readiness() core.HealthReport {
+	now := hc.now()
+	checks := []core.HealthCheck{
+		hc.checkClientStatus(ethereumClientCheck, core.EthClientStatusHandlerName, core.MetricEthereumClientStatus),
+		hc.checkClientStatus(elrondClientCheck, core.ElrondClientStatusHandlerName, core.MetricElrondClientStatus),
+		hc.checkConnectedPeers(),
+		checkAge(ethereumRoleProviderCheck, "last update", hc.ethereumRoleProvider.LastUpdateTime(), now, hc.roleProviderMaxAge),
+		checkAge(elrondRoleProviderCheck, "last update", hc.elrondRoleProvider.LastUpdateTime(), now, hc.roleProviderMaxAge),
+		checkWhitelisted(ethereumWhitelistCheck, hc.ethereumRoleProvider.IsWhitelisted(hc.ethereumRelayerAddress)),
+		checkWhitelisted(elrondWhitelistCheck, hc.elrondRoleProvider.IsWhitelisted(hc.elrondRelayerAddress)),
+	}
+
+	for _, sm := range hc.stateMachines {
+		checks = append(checks, hc.checkProgress(sm, now))
+	}
+
+	return createReport(checks)
+}
+
+func (hc *healthChecker) checkClientStatus(name string, statusHandlerName string, metric string) core.HealthCheck {
+	metrics, err := hc.metricsHolder.GetAllMetrics(statusHandlerName)
+	if err != nil {
+		return unhealthy(name, fmt.Sprintf("%s: %s", statusHandlerName, err.Error()))
+	}
+
+	clientStatus, _ := metrics[metric].(string)
+	if len(clientStatus) == 0 {
+		return unhealthy(name, "status not reported yet")
+	}
+	if clientStatus != ethElrond.Available.String() {
+		return unhealthy(name, fmt.Sprintf("status %s", clientStatus))
+	}
+
+	return healthy(name)
+}
+
+func (hc *healthChecker) checkConnectedPeers() core.HealthCheck {
+	numPeers := len(hc.peersProvider.ConnectedAddresses())
+	message := fmt.Sprintf("%d connected peer(s), minimum %d", numPeers, hc.minConnectedPeers)
+	if numPeers < hc.minConnectedPeers {
+		return unhealthy(p2pPeersCheck, message)
+	}
+
+	return core.HealthCheck{
+		Name:    p2pPeersCheck,
+		Healthy: true,
+		Message: message,
+	}
+}
+
+func (hc *healthChecker) checkProgress(sm StateMachine, now time.Time) core.HealthCheck {
+	name := fmt.Sprintf(progressCheckFormat, sm.Name())
+	if sm.IsPaused() {
+		return unhealthy(name, "paused")
+	}
+
+	return checkAge(name, "last progress", sm.LastProgressTime(), now, hc.progressWindow)
+}
+
+func (hc *healthChecker) now() time.Time {
+	return time.Unix(hc.timer.NowUnix(), 0)
+}
+
+func checkAge(name string, description string, timestamp time.Time, now time.Time, maxAge time.Duration) core.HealthCheck {
+	if timestamp.IsZero() {
+		return unhealthy(name, fmt.Sprintf("no %s", description))
+	}
+
+	// the timer has a seconds resolution
+	age := now.Sub(timestamp).Truncate(time.Second)
+	if age > maxAge {
+		return unhealthy(name, fmt.Sprintf("%s %s ago, maximum %s", description, age, maxAge))
+	}
+
+	return healthy(name)
+}
+
+func checkWhitelisted(name string, isWhitelisted bool) core.HealthCheck {
+	if !isWhitelisted {
+		return unhealthy(name, "relayer not whitelisted")
+	}
+
+	return healthy(name)
+}
+
+func healthy(name string) core.HealthCheck {
+	return core.HealthCheck{
+		Name:    name,
+		Healthy: true,
+	}
+}
+
+func unhealthy(name string, message string) core.HealthCheck {
+	return core.HealthCheck{
+		Name:    name,
+		Healthy: false,
+		Message: message,
+	}
+}
+
+func createReport(checks []core.HealthCheck) core.HealthReport {
+	report := core.HealthReport{
+		Healthy: true,
+		Checks:  checks,
+	}
+	for _, healthCheck := range checks {
+		report.Healthy = report.Healthy && healthCheck.Healthy
+	}
+
+	return report
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hc *healthChecker) IsInterfaceNil() bool {
+	return hc == nil
+}
@@ -0,0 +1,326 @@
+package health
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/config"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/status"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	p2pMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/p2p"
+	roleProvidersMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/roleProviders"
+	stateMachineMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/stateMachine"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Unix(1650000000, 0)
+
+func createMetricsHolder(t *testing.T, ethClientStatus string, elrondClientStatus string) core.MetricsHolder {
+	ethStatusHandler := testsCommon.NewStatusHandlerMock(core.EthClientStatusHandlerName)
+	ethStatusHandler.SetStringMetric(core.MetricEthereumClientStatus, ethClientStatus)
+	elrondStatusHandler := testsCommon.NewStatusHandlerMock(core.ElrondClientStatusHandlerName)
+	elrondStatusHandler.SetStringMetric(core.MetricElrondClientStatus, elrondClientStatus)
+
+	metricsHolder := status.NewMetricsHolder()
+	require.Nil(t, metricsHolder.AddStatusHandler(ethStatusHandler))
+	require.Nil(t, metricsHolder.AddStatusHandler(elrondStatusHandler))
+
+	return metricsHolder
+}
+
+func createStateMachineStub(name string, lastExecution time.Time, lastProgress time.Time) *stateMachineMocks.StateMachineStub {
+	return &stateMachineMocks.StateMachineStub{
+		NameCalled: func() string {
+			return name
+		},
+		LastExecutionTimeCalled: func() time.Time {
+			return lastExecution
+		},
+		LastProgressTimeCalled: func() time.Time {
+			return lastProgress
+		},
+	}
+}
+
+func createMockArgsHealthChecker(t *testing.T) ArgsHealthChecker {
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return testNow.Unix()
+	}
+
+	return ArgsHealthChecker{
+		Config: config.HealthConfig{
+			LivenessWindowInSeconds:     60,
+			ProgressWindowInSeconds:     600,
+			RoleProviderMaxAgeInSeconds: 120,
+			MinConnectedPeers:           2,
+		},
+		MetricsHolder: createMetricsHolder(t, "Available", "Available"),
+		PeersProvider: &p2pMocks.MessengerStub{
+			ConnectedAddressesCalled: func() []string {
+				return []string{"peer1", "peer2"}
+			},
+		},
+		ElrondRoleProvider: &roleProvidersMocks.ElrondRoleProviderStub{
+			LastUpdateTimeCalled: func() time.Time {
+				return testNow.Add(-time.Minute)
+			},
+		},
+		EthereumRoleProvider: &roleProvidersMocks.EthereumRoleProviderStub{
+			LastUpdateTimeCalled: func() time.Time {
+				return testNow.Add(-time.Minute)
+			},
+		},
+		ElrondRelayerAddress:   data.NewAddressFromBytes(make([]byte, 32)),
+		EthereumRelayerAddress: common.HexToAddress("0x132A150926691F08a693721503a38affeD18d524"),
+		StateMachines: []StateMachine{
+			createStateMachineStub("EthereumToElrond", testNow, testNow.Add(-time.Minute)),
+			createStateMachineStub("ElrondToEthereum", testNow, testNow.Add(-time.Minute)),
+		},
+		Timer: timer,
+	}
+}
+
+func getCheck(t *testing.T, report core.HealthReport, name string) core.HealthCheck {
+	for _, healthCheck := range report.Checks {
+		if healthCheck.Name == name {
+			return healthCheck
+		}
+	}
+
+	require.Fail(t, "check not found: "+name)
+	return core.HealthCheck{}
+}
+
+func TestNewHealthChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.MetricsHolder = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilMetricsHolder, err)
+	})
+	t.Run("nil peers provider should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.PeersProvider = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilPeersProvider, err)
+	})
+	t.Run("nil elrond role provider should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.ElrondRoleProvider = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilElrondRoleProvider, err)
+	})
+	t.Run("nil ethereum role provider should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.EthereumRoleProvider = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilEthereumRoleProvider, err)
+	})
+	t.Run("nil elrond relayer address should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.ElrondRelayerAddress = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilElrondRelayerAddress, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.Timer = nil
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("nil state machine should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.StateMachines = append(args.StateMachines, nil)
+
+		hc, err := NewHealthChecker(args)
+		assert.True(t, check.IfNil(hc))
+		assert.True(t, errors.Is(err, ErrNilStateMachine))
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		args := createMockArgsHealthChecker(t)
+		args.Config.LivenessWindowInSeconds = 0
+		_, err := NewHealthChecker(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args = createMockArgsHealthChecker(t)
+		args.Config.ProgressWindowInSeconds = 0
+		_, err = NewHealthChecker(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args = createMockArgsHealthChecker(t)
+		args.Config.RoleProviderMaxAgeInSeconds = 0
+		_, err = NewHealthChecker(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args = createMockArgsHealthChecker(t)
+		args.Config.MinConnectedPeers = -1
+		_, err = NewHealthChecker(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hc, err := NewHealthChecker(createMockArgsHealthChecker(t))
+		assert.False(t, check.IfNil(hc))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHealthChecker_Liveness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all state machines executed recently should be alive", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := NewHealthChecker(createMockArgsHealthChecker(t))
+
+		report := hc.Liveness()
+		assert.True(t, report.Healthy)
+		assert.Equal(t, 2, len(report.Checks))
+	})
+	t.Run("state machine not executed within the window should not be alive", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.StateMachines[1] = createStateMachineStub("ElrondToEthereum", testNow.Add(-time.Minute*2), testNow)
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Liveness()
+		assert.False(t, report.Healthy)
+		assert.True(t, getCheck(t, report, "EthereumToElrond execution").Healthy)
+		stuckCheck := getCheck(t, report, "ElrondToEthereum execution")
+		assert.False(t, stuckCheck.Healthy)
+		assert.Equal(t, "last execution 2m0s ago, maximum 1m0s", stuckCheck.Message)
+	})
+}
+
+func TestHealthChecker_Readiness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all checks passing should be ready", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := NewHealthChecker(createMockArgsHealthChecker(t))
+
+		report := hc.Readiness()
+		assert.True(t, report.Healthy)
+		assert.Equal(t, 9, len(report.Checks))
+		for _, healthCheck := range report.Checks {
+			assert.True(t, healthCheck.Healthy, healthCheck.Name)
+		}
+	})
+	t.Run("unavailable or unreported clients should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.MetricsHolder = createMetricsHolder(t, "Unavailable", "")
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "status Unavailable", getCheck(t, report, ethereumClientCheck).Message)
+		assert.Equal(t, "status not reported yet", getCheck(t, report, elrondClientCheck).Message)
+	})
+	t.Run("missing client status handler should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.MetricsHolder = status.NewMetricsHolder()
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		assert.False(t, getCheck(t, report, ethereumClientCheck).Healthy)
+		assert.False(t, getCheck(t, report, elrondClientCheck).Healthy)
+	})
+	t.Run("not enough peers should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.PeersProvider = &p2pMocks.MessengerStub{
+			ConnectedAddressesCalled: func() []string {
+				return []string{"peer1"}
+			},
+		}
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		peersCheck := getCheck(t, report, p2pPeersCheck)
+		assert.False(t, peersCheck.Healthy)
+		assert.Equal(t, "1 connected peer(s), minimum 2", peersCheck.Message)
+	})
+	t.Run("stale or never fetched role providers should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.ElrondRoleProvider = &roleProvidersMocks.ElrondRoleProviderStub{
+			LastUpdateTimeCalled: func() time.Time {
+				return time.Time{}
+			},
+		}
+		args.EthereumRoleProvider = &roleProvidersMocks.EthereumRoleProviderStub{
+			LastUpdateTimeCalled: func() time.Time {
+				return testNow.Add(-time.Minute * 3)
+			},
+		}
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "no last update", getCheck(t, report, elrondRoleProviderCheck).Message)
+		assert.Equal(t, "last update 3m0s ago, maximum 2m0s", getCheck(t, report, ethereumRoleProviderCheck).Message)
+	})
+	t.Run("not whitelisted relayer should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.EthereumRoleProvider = &roleProvidersMocks.EthereumRoleProviderStub{
+			IsWhitelistedCalled: func(address common.Address) bool {
+				return false
+			},
+		}
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		assert.False(t, getCheck(t, report, ethereumWhitelistCheck).Healthy)
+		assert.True(t, getCheck(t, report, elrondWhitelistCheck).Healthy)
+	})
+	t.Run("state machine without progress or paused should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHealthChecker(t)
+		args.StateMachines[0] = createStateMachineStub("EthereumToElrond", testNow, testNow.Add(-time.Hour))
+		pausedStateMachine := createStateMachineStub("ElrondToEthereum", testNow, testNow)
+		pausedStateMachine.IsPausedCalled = func() bool {
+			return true
+		}
+		args.StateMachines[1] = pausedStateMachine
+		hc, _ := NewHealthChecker(args)
+
+		report := hc.Readiness()
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "last progress 1h0m0s ago, maximum 10m0s", getCheck(t, report, "EthereumToElrond progress").Message)
+		assert.Equal(t, "paused", getCheck(t, report, "ElrondToEthereum progress").Message)
+	})
+}
@@ -0,0 +1,37 @@
+package health
+
+import (
+	"time"
+
+	erdgoCore "github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PeersProvider defines a component able to provide the addresses of the connected p2p peers
+type PeersProvider interface {
+	ConnectedAddresses() []string
+	IsInterfaceNil() bool
+}
+
+// ElrondRoleProvider defines a component able to tell if an Elrond address is whitelisted
+type ElrondRoleProvider interface {
+	IsWhitelisted(address erdgoCore.AddressHandler) bool
+	LastUpdateTime() time.Time
+	IsInterfaceNil() bool
+}
+
+// EthereumRoleProvider defines a component able to tell if an Ethereum address is whitelisted
+type EthereumRoleProvider interface {
+	IsWhitelisted(address common.Address) bool
+	LastUpdateTime() time.Time
+	IsInterfaceNil() bool
+}
+
+// StateMachine defines a state machine able to report its execution and its progress
+type StateMachine interface {
+	Name() string
+	IsPaused() bool
+	LastExecutionTime() time.Time
+	LastProgressTime() time.Time
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,137 @@
+package heartbeat
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+const minSilenceThreshold = time.Second
+
+// ArgsClusterView is the DTO used to create a new instance of clusterView
+type ArgsClusterView struct {
+	ElrondRoleProvider ElrondRoleProvider
+	SelfPublicKey      []byte
+	SilenceThreshold   time.Duration
+}
+
+type receivedHeartbeat struct {
+	heartbeat         *core.Heartbeat
+	lastSeen          time.Time
+	clockSkewInMillis int64
+}
+
+type clusterView struct {
+	elrondRoleProvider ElrondRoleProvider
+	selfPublicKey      []byte
+	silenceThreshold   time.Duration
+	mut                sync.RWMutex
+	heartbeats         map[string]*receivedHeartbeat
+	getTimeHandler     func() time.Time
+}
+
+// NewClusterView creates a new instance of clusterView able to hold the last heartbeat of each relayer
+func NewClusterView(args ArgsClusterView) (*clusterView, error) {
+	err := checkArgsClusterView(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterView{
+		elrondRoleProvider: args.ElrondRoleProvider,
+		selfPublicKey:      args.SelfPublicKey,
+		silenceThreshold:   args.SilenceThreshold,
+		heartbeats:         make(map[string]*receivedHeartbeat),
+		getTimeHandler:     time.Now,
+	}, nil
+}
+
+func checkArgsClusterView(args ArgsClusterView) error {
+	if check.IfNil(args.ElrondRoleProvider) {
+		return ErrNilElrondRoleProvider
+	}
+	if args.SilenceThreshold < minSilenceThreshold {
+		return fmt.Errorf("%w for SilenceThreshold, got: %v, minimum: %v",
+			ErrInvalidDuration, args.SilenceThreshold, minSilenceThreshold)
+	}
+
+	return nil
+}
+
+// ProcessHeartbeat will store the heartbeat received from the relayer with the provided public key. The clock skew
+// is estimated as the difference between the relayer's reported clock and the local one, network latency included
+func (cv *clusterView) ProcessHeartbeat(publicKey []byte, heartbeat *core.Heartbeat) {
+	if heartbeat == nil {
+		return
+	}
+
+	now := cv.getTimeHandler()
+	nowInMillis := now.UnixNano() / int64(time.Millisecond)
+
+	cv.mut.Lock()
+	cv.heartbeats[string(publicKey)] = &receivedHeartbeat{
+		heartbeat:         heartbeat,
+		lastSeen:          now,
+		clockSkewInMillis: heartbeat.TimestampInMillis - nowInMillis,
+	}
+	cv.mut.Unlock()
+}
+
+// ClusterInfo returns the state of every whitelisted relayer. The relayers that did not send a heartbeat in the
+// silence threshold are flagged as silent
+func (cv *clusterView) ClusterInfo() []core.RelayerStatus {
+	publicKeys := cv.elrondRoleProvider.SortedPublicKeys()
+	now := cv.getTimeHandler()
+
+	cv.mut.Lock()
+	defer cv.mut.Unlock()
+
+	cv.removeNotWhitelisted(publicKeys)
+
+	result := make([]core.RelayerStatus, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		relayerStatus := core.RelayerStatus{
+			Address:  data.NewAddressFromBytes(publicKey).AddressAsBech32String(),
+			IsSelf:   bytes.Equal(publicKey, cv.selfPublicKey),
+			IsSilent: true,
+		}
+
+		received, found := cv.heartbeats[string(publicKey)]
+		if found {
+			relayerStatus.IsSilent = now.Sub(received.lastSeen) > cv.silenceThreshold
+			relayerStatus.LastSeen = received.lastSeen
+			relayerStatus.AppVersion = received.heartbeat.AppVersion
+			relayerStatus.ClockSkewInMillis = received.clockSkewInMillis
+			relayerStatus.StateMachines = received.heartbeat.StateMachines
+			relayerStatus.Clients = received.heartbeat.Clients
+		}
+
+		result = append(result, relayerStatus)
+	}
+
+	return result
+}
+
+func (cv *clusterView) removeNotWhitelisted(publicKeys [][]byte) {
+	whitelisted := make(map[string]struct{}, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		whitelisted[string(publicKey)] = struct{}{}
+	}
+
+	for publicKey := range cv.heartbeats {
+		_, found := whitelisted[publicKey]
+		if !found {
+			delete(cv.heartbeats, publicKey)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cv *clusterView) IsInterfaceNil() bool {
+	return cv == nil
+}
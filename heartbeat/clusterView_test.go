@@ -0,0 +1,113 @@
+package heartbeat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	roleProvidersMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/roleProviders"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	selfPublicKey  = []byte("public key 0 ...................")
+	otherPublicKey = []byte("public key 1 ...................")
+	silentKey      = []byte("public key 2 ...................")
+)
+
+func createMockArgsClusterView() ArgsClusterView {
+	return ArgsClusterView{
+		ElrondRoleProvider: &roleProvidersMocks.ElrondRoleProviderStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return [][]byte{selfPublicKey, otherPublicKey, silentKey}
+			},
+		},
+		SelfPublicKey:    selfPublicKey,
+		SilenceThreshold: time.Minute,
+	}
+}
+
+func TestNewClusterView(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Elrond role provider should error", func(t *testing.T) {
+		args := createMockArgsClusterView()
+		args.ElrondRoleProvider = nil
+
+		cv, err := NewClusterView(args)
+		assert.True(t, check.IfNil(cv))
+		assert.Equal(t, ErrNilElrondRoleProvider, err)
+	})
+	t.Run("invalid silence threshold should error", func(t *testing.T) {
+		args := createMockArgsClusterView()
+		args.SilenceThreshold = minSilenceThreshold - time.Millisecond
+
+		cv, err := NewClusterView(args)
+		assert.True(t, check.IfNil(cv))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+	})
+	t.Run("should work", func(t *testing.T) {
+		cv, err := NewClusterView(createMockArgsClusterView())
+		assert.False(t, check.IfNil(cv))
+		assert.Nil(t, err)
+	})
+}
+
+func TestClusterView_ClusterInfo(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsClusterView()
+	cv, _ := NewClusterView(args)
+	currentTime := testNow
+	cv.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	stateMachines := []*core.HeartbeatStateMachine{
+		{Name: "EthereumToElrond", Step: "getting the pending batch", LastBatchID: 7},
+	}
+	cv.ProcessHeartbeat(selfPublicKey, &core.Heartbeat{
+		AppVersion:        "v1.0.0",
+		TimestampInMillis: testNow.Unix() * 1000,
+		StateMachines:     stateMachines,
+	})
+	cv.ProcessHeartbeat(otherPublicKey, &core.Heartbeat{
+		AppVersion:        "v0.9.0",
+		TimestampInMillis: testNow.Unix()*1000 + 1500,
+	})
+	cv.ProcessHeartbeat([]byte("not whitelisted"), &core.Heartbeat{})
+
+	currentTime = testNow.Add(args.SilenceThreshold)
+	cv.ProcessHeartbeat(selfPublicKey, &core.Heartbeat{
+		AppVersion:        "v1.0.0",
+		TimestampInMillis: currentTime.Unix() * 1000,
+		StateMachines:     stateMachines,
+	})
+	currentTime = currentTime.Add(time.Second)
+
+	info := cv.ClusterInfo()
+	require.Equal(t, 3, len(info))
+	assert.Equal(t, core.RelayerStatus{
+		Address:       data.NewAddressFromBytes(selfPublicKey).AddressAsBech32String(),
+		IsSelf:        true,
+		LastSeen:      testNow.Add(args.SilenceThreshold),
+		AppVersion:    "v1.0.0",
+		StateMachines: stateMachines,
+	}, info[0])
+	assert.Equal(t, core.RelayerStatus{
+		Address:           data.NewAddressFromBytes(otherPublicKey).AddressAsBech32String(),
+		IsSilent:          true,
+		LastSeen:          testNow,
+		AppVersion:        "v0.9.0",
+		ClockSkewInMillis: 1500,
+	}, info[1])
+	assert.Equal(t, core.RelayerStatus{
+		Address:  data.NewAddressFromBytes(silentKey).AddressAsBech32String(),
+		IsSilent: true,
+	}, info[2])
+	assert.Equal(t, 2, len(cv.heartbeats))
+}
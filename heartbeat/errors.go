@@ -0,0 +1,21 @@
+package heartbeat
+
+import "errors"
+
+// ErrNilBroadcaster signals that a nil broadcaster was provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrNilMetricsHolder signals that a nil metrics holder was provided
+var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrEmptyStateMachineName signals that a state machine with an empty name was provided
+var ErrEmptyStateMachineName = errors.New("empty state machine name")
+
+// ErrNilBatchInfoProvider signals that a nil batch info provider was provided
+var ErrNilBatchInfoProvider = errors.New("nil batch info provider")
+
+// ErrNilElrondRoleProvider signals that a nil Elrond role provider was provided
+var ErrNilElrondRoleProvider = errors.New("nil Elrond role provider")
+
+// ErrInvalidDuration signals that an invalid duration was provided
+var ErrInvalidDuration = errors.New("invalid duration")
@@ -0,0 +1,163 @@
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const (
+	ethereumClientName = "ethereum"
+	elrondClientName   = "elrond"
+	unknownStatus      = "unknown"
+)
+
+// MonitoredStateMachine holds the components used to report the state of a state machine in the heartbeats
+type MonitoredStateMachine struct {
+	Name              string
+	BatchInfoProvider BatchInfoProvider
+}
+
+// ArgsHeartbeatSender is the DTO used to create a new instance of heartbeatSender
+type ArgsHeartbeatSender struct {
+	Broadcaster   Broadcaster
+	MetricsHolder core.MetricsHolder
+	StateMachines []MonitoredStateMachine
+	AppVersion    string
+}
+
+type monitoredClient struct {
+	name              string
+	statusHandlerName string
+	metric            string
+}
+
+type heartbeatSender struct {
+	broadcaster      Broadcaster
+	metricsHolder    core.MetricsHolder
+	stateMachines    []MonitoredStateMachine
+	monitoredClients []monitoredClient
+	appVersion       string
+	mutLastBatchIDs  sync.Mutex
+	lastBatchIDs     map[string]uint64
+	getTimeHandler   func() time.Time
+}
+
+// NewHeartbeatSender creates a new instance of heartbeatSender able to periodically broadcast this relayer's state
+func NewHeartbeatSender(args ArgsHeartbeatSender) (*heartbeatSender, error) {
+	err := checkArgsHeartbeatSender(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &heartbeatSender{
+		broadcaster:   args.Broadcaster,
+		metricsHolder: args.MetricsHolder,
+		stateMachines: args.StateMachines,
+		monitoredClients: []monitoredClient{
+			{
+				name:              ethereumClientName,
+				statusHandlerName: core.EthClientStatusHandlerName,
+				metric:            core.MetricEthereumClientStatus,
+			},
+			{
+				name:              elrondClientName,
+				statusHandlerName: core.ElrondClientStatusHandlerName,
+				metric:            core.MetricElrondClientStatus,
+			},
+		},
+		appVersion:     args.AppVersion,
+		lastBatchIDs:   make(map[string]uint64),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+func checkArgsHeartbeatSender(args ArgsHeartbeatSender) error {
+	if check.IfNil(args.Broadcaster) {
+		return ErrNilBroadcaster
+	}
+	if check.IfNil(args.MetricsHolder) {
+		return ErrNilMetricsHolder
+	}
+	for idx, sm := range args.StateMachines {
+		if len(sm.Name) == 0 {
+			return fmt.Errorf("%w at index %d", ErrEmptyStateMachineName, idx)
+		}
+		if check.IfNil(sm.BatchInfoProvider) {
+			return fmt.Errorf("%w for state machine %s", ErrNilBatchInfoProvider, sm.Name)
+		}
+	}
+
+	return nil
+}
+
+// Execute will broadcast a new heartbeat. It is called periodically by a polling handler
+func (hs *heartbeatSender) Execute(_ context.Context) error {
+	hs.broadcaster.BroadcastHeartbeat(hs.createHeartbeat())
+
+	return nil
+}
+
+func (hs *heartbeatSender) createHeartbeat() *core.Heartbeat {
+	heartbeat := &core.Heartbeat{
+		Version:           core.MessagesVersion,
+		AppVersion:        hs.appVersion,
+		TimestampInMillis: hs.getTimeHandler().UnixNano() / int64(time.Millisecond),
+		StateMachines:     make([]*core.HeartbeatStateMachine, 0, len(hs.stateMachines)),
+		Clients:           make([]*core.HeartbeatClient, 0, len(hs.monitoredClients)),
+	}
+
+	for _, sm := range hs.stateMachines {
+		heartbeat.StateMachines = append(heartbeat.StateMachines, &core.HeartbeatStateMachine{
+			Name:        sm.Name,
+			Step:        hs.getStringMetric(sm.Name, core.MetricCurrentStateMachineStep),
+			LastBatchID: hs.getLastBatchID(sm),
+		})
+	}
+
+	for _, client := range hs.monitoredClients {
+		heartbeat.Clients = append(heartbeat.Clients, &core.HeartbeatClient{
+			Name:   client.name,
+			Status: hs.getStringMetric(client.statusHandlerName, client.metric),
+		})
+	}
+
+	return heartbeat
+}
+
+func (hs *heartbeatSender) getStringMetric(statusHandlerName string, metric string) string {
+	metrics, err := hs.metricsHolder.GetAllMetrics(statusHandlerName)
+	if err != nil {
+		return unknownStatus
+	}
+
+	value, _ := metrics[metric].(string)
+	if len(value) == 0 {
+		return unknownStatus
+	}
+
+	return value
+}
+
+// getLastBatchID returns the ID of the batch currently handled by the state machine or, if there is none, the ID of
+// the last batch it handled
+func (hs *heartbeatSender) getLastBatchID(sm MonitoredStateMachine) uint64 {
+	hs.mutLastBatchIDs.Lock()
+	defer hs.mutLastBatchIDs.Unlock()
+
+	batch := sm.BatchInfoProvider.GetStoredBatch()
+	if batch != nil {
+		hs.lastBatchIDs[sm.Name] = batch.ID
+	}
+
+	return hs.lastBatchIDs[sm.Name]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *heartbeatSender) IsInterfaceNil() bool {
+	return hs == nil
+}
@@ -0,0 +1,134 @@
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/status"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Unix(1650000000, 0)
+
+func createMetricsHolder(t *testing.T) core.MetricsHolder {
+	ethToElrondStatusHandler := testsCommon.NewStatusHandlerMock("EthereumToElrond")
+	ethToElrondStatusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, "getting the pending batch")
+	ethStatusHandler := testsCommon.NewStatusHandlerMock(core.EthClientStatusHandlerName)
+	ethStatusHandler.SetStringMetric(core.MetricEthereumClientStatus, "Available")
+
+	metricsHolder := status.NewMetricsHolder()
+	require.Nil(t, metricsHolder.AddStatusHandler(ethToElrondStatusHandler))
+	require.Nil(t, metricsHolder.AddStatusHandler(ethStatusHandler))
+
+	return metricsHolder
+}
+
+func createMockArgsHeartbeatSender(t *testing.T) ArgsHeartbeatSender {
+	return ArgsHeartbeatSender{
+		Broadcaster:   &testsCommon.BroadcasterStub{},
+		MetricsHolder: createMetricsHolder(t),
+		StateMachines: []MonitoredStateMachine{
+			{
+				Name:              "EthereumToElrond",
+				BatchInfoProvider: bridgeTests.NewBridgeExecutorStub(),
+			},
+		},
+		AppVersion: "v1.0.0",
+	}
+}
+
+func TestNewHeartbeatSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil broadcaster should error", func(t *testing.T) {
+		args := createMockArgsHeartbeatSender(t)
+		args.Broadcaster = nil
+
+		hs, err := NewHeartbeatSender(args)
+		assert.True(t, check.IfNil(hs))
+		assert.Equal(t, ErrNilBroadcaster, err)
+	})
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		args := createMockArgsHeartbeatSender(t)
+		args.MetricsHolder = nil
+
+		hs, err := NewHeartbeatSender(args)
+		assert.True(t, check.IfNil(hs))
+		assert.Equal(t, ErrNilMetricsHolder, err)
+	})
+	t.Run("empty state machine name should error", func(t *testing.T) {
+		args := createMockArgsHeartbeatSender(t)
+		args.StateMachines[0].Name = ""
+
+		hs, err := NewHeartbeatSender(args)
+		assert.True(t, check.IfNil(hs))
+		assert.True(t, errors.Is(err, ErrEmptyStateMachineName))
+	})
+	t.Run("nil batch info provider should error", func(t *testing.T) {
+		args := createMockArgsHeartbeatSender(t)
+		args.StateMachines[0].BatchInfoProvider = nil
+
+		hs, err := NewHeartbeatSender(args)
+		assert.True(t, check.IfNil(hs))
+		assert.True(t, errors.Is(err, ErrNilBatchInfoProvider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hs, err := NewHeartbeatSender(createMockArgsHeartbeatSender(t))
+		assert.False(t, check.IfNil(hs))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHeartbeatSender_Execute(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeartbeatSender(t)
+	var storedBatch *clients.TransferBatch
+	batchInfoProvider := bridgeTests.NewBridgeExecutorStub()
+	batchInfoProvider.GetStoredBatchCalled = func() *clients.TransferBatch {
+		return storedBatch
+	}
+	args.StateMachines[0].BatchInfoProvider = batchInfoProvider
+	heartbeats := make([]*core.Heartbeat, 0)
+	args.Broadcaster = &testsCommon.BroadcasterStub{
+		BroadcastHeartbeatCalled: func(heartbeat *core.Heartbeat) {
+			heartbeats = append(heartbeats, heartbeat)
+		},
+	}
+
+	hs, _ := NewHeartbeatSender(args)
+	hs.getTimeHandler = func() time.Time {
+		return testNow
+	}
+
+	storedBatch = &clients.TransferBatch{ID: 7}
+	err := hs.Execute(context.Background())
+	require.Nil(t, err)
+	storedBatch = nil
+	err = hs.Execute(context.Background())
+	require.Nil(t, err)
+
+	expectedHeartbeat := &core.Heartbeat{
+		Version:           core.MessagesVersion,
+		AppVersion:        "v1.0.0",
+		TimestampInMillis: testNow.Unix() * 1000,
+		StateMachines: []*core.HeartbeatStateMachine{
+			{Name: "EthereumToElrond", Step: "getting the pending batch", LastBatchID: 7},
+		},
+		Clients: []*core.HeartbeatClient{
+			{Name: ethereumClientName, Status: "Available"},
+			{Name: elrondClientName, Status: unknownStatus},
+		},
+	}
+	require.Equal(t, 2, len(heartbeats))
+	assert.Equal(t, expectedHeartbeat, heartbeats[0])
+	assert.Equal(t, expectedHeartbeat, heartbeats[1])
+}
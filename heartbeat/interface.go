@@ -0,0 +1,24 @@
+package heartbeat
+
+import (
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// Broadcaster defines a component able to send the heartbeats to the other relayers
+type Broadcaster interface {
+	BroadcastHeartbeat(heartbeat *core.Heartbeat)
+	IsInterfaceNil() bool
+}
+
+// BatchInfoProvider defines a component able to provide the batch currently handled by a state machine
+type BatchInfoProvider interface {
+	GetStoredBatch() *clients.TransferBatch
+	IsInterfaceNil() bool
+}
+
+// ElrondRoleProvider defines a component able to provide the public keys of the whitelisted relayers
+type ElrondRoleProvider interface {
+	SortedPublicKeys() [][]byte
+	IsInterfaceNil() bool
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
+	bridgeCore "github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/integrationTests"
 	"github.com/ElrondNetwork/elrond-eth-bridge/p2p"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
@@ -22,6 +24,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	jsonMarshalizerType      = "json"
+	gogoProtoMarshalizerType = "gogo protobuf"
+)
+
 func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
 	numBroadcasters := 5
 
@@ -159,7 +166,7 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	expectedPkInOrder = copyAndSortBytesSlices(publicKeysBytes)
 
 	integrationTests.Log.Info("creating the late broadcaster")
-	lateBroadcaster, lateSigHolder := createBroadcaster(t, messengers[len(messengers)-1], roleProvider, privateKeys[len(privateKeys)-1])
+	lateBroadcaster, lateSigHolder := createBroadcaster(t, messengers[len(messengers)-1], roleProvider, privateKeys[len(privateKeys)-1], gogoProtoMarshalizerType)
 
 	time.Sleep(time.Second)
 	lateBroadcaster.BroadcastJoinTopic()
@@ -171,6 +178,136 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 }
 
+func TestNetworkOfBroadcastersShouldRespondToSignaturesRequests(t *testing.T) {
+	numBroadcasters := 5
+
+	integrationTests.Log.Info("creating & linking network messengers...")
+	messengers := integrationTests.CreateLinkedMessengers(numBroadcasters)
+	defer func() {
+		for _, messenger := range messengers {
+			_ = messenger.Close()
+		}
+	}()
+
+	privateKeys, publicKeysBytes := createKeys(t, numBroadcasters)
+
+	roleProvider := &mockRoleProviders.ElrondRoleProviderStub{
+		IsWhitelistedCalled: func(address core.AddressHandler) bool {
+			for _, pkBytes := range publicKeysBytes {
+				if bytes.Equal(address.AddressBytes(), pkBytes) {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+
+	integrationTests.Log.Info("creating broadcasters...")
+	broadcasters, signaturesHolders := createBroadcasters(t, numBroadcasters, messengers, roleProvider, privateKeys)
+
+	time.Sleep(time.Second)
+
+	expectedPkInOrder := copyAndSortBytesSlices(publicKeysBytes)
+	messageHash := []byte("message hash")
+	joinBroadcasters(broadcasters)
+	signatures := createSignatures(numBroadcasters, "mock signature - try 1")
+	sendSignatures(broadcasters, signatures, messageHash)
+	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
+
+	// the first broadcaster lost its signatures and asks the others for them
+	clearSignatures(signaturesHolders[:1])
+	checkStateOnBroadcaster(t, broadcasters[0], signaturesHolders[0], make([][]byte, 0), expectedPkInOrder, messageHash)
+
+	integrationTests.Log.Info("requesting signatures...")
+	broadcasters[0].RequestSignatures(messageHash)
+	time.Sleep(time.Second)
+
+	checkStateOnBroadcaster(t, broadcasters[0], signaturesHolders[0], signatures, expectedPkInOrder, messageHash)
+}
+
+func TestNetworkOfBroadcastersShouldExchangeHeartbeats(t *testing.T) {
+	numBroadcasters := 5
+
+	integrationTests.Log.Info("creating & linking network messengers...")
+	messengers := integrationTests.CreateLinkedMessengers(numBroadcasters)
+	defer func() {
+		for _, messenger := range messengers {
+			_ = messenger.Close()
+		}
+	}()
+
+	privateKeys, publicKeysBytes := createKeys(t, numBroadcasters)
+
+	roleProvider := &mockRoleProviders.ElrondRoleProviderStub{
+		IsWhitelistedCalled: func(address core.AddressHandler) bool {
+			for _, pkBytes := range publicKeysBytes {
+				if bytes.Equal(address.AddressBytes(), pkBytes) {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+
+	integrationTests.Log.Info("creating broadcasters...")
+	broadcasters, _ := createBroadcasters(t, numBroadcasters, messengers, roleProvider, privateKeys)
+	heartbeatsHolders := make([]*heartbeatsHolder, 0, numBroadcasters)
+	for _, b := range broadcasters {
+		holder := newHeartbeatsHolder()
+		require.Nil(t, b.AddHeartbeatProcessor(holder))
+		heartbeatsHolders = append(heartbeatsHolders, holder)
+	}
+
+	time.Sleep(time.Second)
+
+	integrationTests.Log.Info("broadcasting heartbeats...")
+	for i, b := range broadcasters {
+		b.BroadcastHeartbeat(&bridgeCore.Heartbeat{
+			Version:    bridgeCore.MessagesVersion,
+			AppVersion: fmt.Sprintf("version %d", i),
+		})
+	}
+	time.Sleep(time.Second)
+
+	for _, holder := range heartbeatsHolders {
+		for i, pkBytes := range publicKeysBytes {
+			require.Equal(t, fmt.Sprintf("version %d", i), holder.appVersion(pkBytes))
+		}
+	}
+}
+
+type heartbeatsHolder struct {
+	mut         sync.RWMutex
+	appVersions map[string]string
+}
+
+func newHeartbeatsHolder() *heartbeatsHolder {
+	return &heartbeatsHolder{
+		appVersions: make(map[string]string),
+	}
+}
+
+// ProcessHeartbeat -
+func (holder *heartbeatsHolder) ProcessHeartbeat(publicKey []byte, heartbeat *bridgeCore.Heartbeat) {
+	holder.mut.Lock()
+	holder.appVersions[string(publicKey)] = heartbeat.AppVersion
+	holder.mut.Unlock()
+}
+
+func (holder *heartbeatsHolder) appVersion(publicKey []byte) string {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	return holder.appVersions[string(publicKey)]
+}
+
+// IsInterfaceNil -
+func (holder *heartbeatsHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
+
 func createBroadcasters(
 	t *testing.T,
 	numBroadcasters int,
@@ -181,7 +318,12 @@ func createBroadcasters(
 	broadcasters := make([]integrationTests.Broadcaster, 0, numBroadcasters)
 	signaturesHolders := make([]*testsCommon.SignaturesHolderMock, 0, numBroadcasters)
 	for i := 0; i < numBroadcasters; i++ {
-		b, sigHolder := createBroadcaster(t, messengers[i], roleProvider, privateKeys[i])
+		// the broadcasters alternate the encodings, as in a relayer set that is being upgraded
+		marshalizerType := jsonMarshalizerType
+		if i%2 == 1 {
+			marshalizerType = gogoProtoMarshalizerType
+		}
+		b, sigHolder := createBroadcaster(t, messengers[i], roleProvider, privateKeys[i], marshalizerType)
 
 		broadcasters = append(broadcasters, b)
 		signaturesHolders = append(signaturesHolders, sigHolder)
@@ -195,6 +337,7 @@ func createBroadcaster(
 	messenger elrondP2P.Messenger,
 	roleProvider *mockRoleProviders.ElrondRoleProviderStub,
 	privateKey crypto.PrivateKey,
+	marshalizerType string,
 ) (integrationTests.Broadcaster, *testsCommon.SignaturesHolderMock) {
 	cfg := elrondConfig.Config{
 		Antiflood: p2pMocks.CreateAntifloodConfig(),
@@ -202,8 +345,15 @@ func createBroadcaster(
 	ac, err := factory.NewP2PAntiFloodComponents(context.Background(), cfg, &statusHandler.AppStatusHandlerStub{}, "pid")
 	require.Nil(t, err)
 
+	marshalizer, err := p2p.NewMessagesMarshalizer(p2p.ArgsMessagesMarshalizer{
+		Type:                marshalizerType,
+		AcceptBothEncodings: true,
+	})
+	require.Nil(t, err)
+
 	args := p2p.ArgsBroadcaster{
 		Messenger:           messenger,
+		Marshalizer:         marshalizer,
 		Log:                 integrationTests.Log,
 		ElrondRoleProvider:  roleProvider,
 		KeyGen:              integrationTests.TestKeyGenerator,
@@ -212,6 +362,10 @@ func createBroadcaster(
 		SignatureProcessor:  &testsCommon.SignatureProcessorStub{},
 		Name:                "test",
 		AntifloodComponents: ac,
+		PeerScorer:          &p2pMocks.PeerScorerStub{},
+
+		SignaturesResponsesInterval: time.Second,
+		SignaturesRequestTimeout:    time.Minute,
 	}
 
 	b, err := p2p.NewBroadcaster(args)
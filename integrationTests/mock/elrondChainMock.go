@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -95,6 +96,39 @@ func (mock *ElrondChainMock) SendTransactions(ctx context.Context, txs []*data.T
 	return hashes, nil
 }
 
+// GetTransactionInfoWithResults -
+func (mock *ElrondChainMock) GetTransactionInfoWithResults(_ context.Context, hash string) (*data.TransactionInfo, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	mock.mutState.RLock()
+	defer mock.mutState.RUnlock()
+
+	tx, found := mock.sentTransactions[string(hashBytes)]
+	if !found {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	info := &data.TransactionInfo{}
+	info.Data.Transaction = data.TransactionOnNetwork{
+		Hash:     hash,
+		Nonce:    tx.Nonce,
+		Sender:   tx.SndAddr,
+		Receiver: tx.RcvAddr,
+		Data:     tx.Data,
+		Status:   "success",
+	}
+
+	return info, nil
+}
+
+// RequestTransactionCost -
+func (mock *ElrondChainMock) RequestTransactionCost(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+	return nil, errors.New("transaction cost simulation not supported")
+}
+
 // GetAllSentTransactions -
 func (mock *ElrondChainMock) GetAllSentTransactions(_ context.Context) map[string]*data.Transaction {
 	mock.mutState.RLock()
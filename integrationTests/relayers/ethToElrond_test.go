@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-eth-bridge/factory"
 	"github.com/ElrondNetwork/elrond-eth-bridge/integrationTests"
 	"github.com/ElrondNetwork/elrond-eth-bridge/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	"github.com/ElrondNetwork/elrond-eth-bridge/status"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	elrondConfig "github.com/ElrondNetwork/elrond-go/config"
@@ -172,6 +173,7 @@ func createMockBridgeComponentsArgs(
 		TimeForBootstrap:          time.Second * 5,
 		TimeBeforeRepeatJoin:      time.Second * 30,
 		MetricsHolder:             status.NewMetricsHolder(),
+		TransitionsHistoryHolder:  stateMachine.NewTransitionsHistoryHolder(),
 		AppStatusHandler:          &statusHandler.AppStatusHandlerStub{},
 		ElrondClientStatusHandler: &testsCommon.StatusHandlerStub{},
 	}
@@ -181,6 +183,7 @@ func createBridgeComponentsConfig(index int) config.Config {
 	stateMachineConfig := config.ConfigStateMachine{
 		StepDurationInMillis:       1000,
 		IntervalForLeaderInSeconds: 60,
+		TransitionsHistorySize:     100,
 	}
 
 	return config.Config{
@@ -205,11 +208,30 @@ func createBridgeComponentsConfig(index int) config.Config {
 			PrivateKeyFile:                  fmt.Sprintf("testdata/elrond%d.pem", index),
 			IntervalToResendTxsInSeconds:    10,
 			GasMap:                          testsCommon.CreateTestElrondGasMap(),
+			TransactionOutcome:              testsCommon.CreateTestElrondTransactionOutcomeConfig(),
 			MaxRetriesOnQuorumReached:       1,
 			MaxRetriesOnWasTransferProposed: 3,
 			ProxyMaxNoncesDelta:             5,
 		},
-		P2P: config.ConfigP2P{},
+		P2P: config.ConfigP2P{
+			Messages: config.P2PMessagesConfig{
+				Marshalizer: "gogo protobuf",
+			},
+			SignaturesRequests: config.P2PSignaturesRequestsConfig{
+				ResponsesIntervalInMillis: 1000,
+				RequestTimeoutInSeconds:   60,
+			},
+			PeerScoring: config.P2PPeerScoringConfig{
+				BanThreshold:             100,
+				BaseBanDurationInSeconds: 60,
+				MaxBanDurationInSeconds:  3600,
+				ScoreRetentionInSeconds:  3600,
+			},
+			Heartbeat: config.P2PHeartbeatConfig{
+				IntervalInSeconds:         30,
+				SilenceThresholdInSeconds: 120,
+			},
+		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToElrond": stateMachineConfig,
 			"ElrondToEthereum": stateMachineConfig,
@@ -222,6 +244,28 @@ func createBridgeComponentsConfig(index int) config.Config {
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				TimeToLiveInSeconds:     3600,
+				MaxMessageHashes:        100,
+				MaxSignaturesPerHash:    100,
+				MaxHashesPerPublicKey:   5,
+				CleanupIntervalInMillis: 1000,
+			},
+		},
+		BatchFingerprint: config.BatchFingerprintConfig{
+			Enabled:          true,
+			MinAgreeingPeers: 2,
+			MaxFailedChecks:  30,
+		},
+		Health: config.HealthConfig{
+			LivenessWindowInSeconds:     300,
+			ProgressWindowInSeconds:     7200,
+			RoleProviderMaxAgeInSeconds: 300,
+			MinConnectedPeers:           1,
+		},
+		TokenRegistry: config.TokenRegistryConfig{
+			CacheTTLInSeconds:        600,
+			RefreshIntervalInSeconds: 300,
 		},
 	}
 }
@@ -43,9 +43,12 @@ type Connectable interface {
 // Broadcaster defines a component able to communicate with other such instances and manage signatures and other state related data
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	BroadcastJoinTopic()
 	SortedPublicKeys() [][]byte
 	AddBroadcastClient(client core.BroadcastClient) error
+	BroadcastHeartbeat(heartbeat *core.Heartbeat)
+	AddHeartbeatProcessor(processor core.HeartbeatProcessor) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -1,7 +1,9 @@
 package p2p
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -20,13 +22,28 @@ import (
 const (
 	joinTopicSuffix        = "_join"
 	signTopicSuffix        = "_sign"
+	requestTopicSuffix     = "_request"
+	responseTopicSuffix    = "_response"
+	heartbeatTopicSuffix   = "_heartbeat"
+	fingerprintTopicSuffix = "_fingerprint"
 	defaultTopicIdentifier = "default"
 	joinTopicMessage       = "join topic"
+	leaveTopicMessage      = "leave topic"
+
+	minSignaturesResponsesInterval = time.Millisecond * 100
+	minSignaturesRequestTimeout    = time.Second
+
+	invalidMessagePenalty      = uint32(10)
+	notWhitelistedPenalty      = uint32(5)
+	invalidEthSignaturePenalty = uint32(5)
+	invalidHeartbeatPenalty    = uint32(5)
+	invalidFingerprintPenalty  = uint32(5)
 )
 
 // ArgsBroadcaster is the DTO used in the broadcaster constructor
 type ArgsBroadcaster struct {
 	Messenger           NetMessenger
+	Marshalizer         marshal.Marshalizer
 	Log                 logger.Logger
 	ElrondRoleProvider  ElrondRoleProvider
 	SignatureProcessor  SignatureProcessor
@@ -35,20 +52,33 @@ type ArgsBroadcaster struct {
 	PrivateKey          crypto.PrivateKey
 	Name                string
 	AntifloodComponents *factory.AntiFloodComponents
+	PeerScorer          PeerScorer
+
+	SignaturesResponsesInterval time.Duration
+	SignaturesRequestTimeout    time.Duration
 }
 
 type broadcaster struct {
 	*relayerMessageHandler
 	*noncesOfPublicKeys
-	messenger          NetMessenger
-	log                logger.Logger
-	elrondRoleProvider ElrondRoleProvider
-	signatureProcessor SignatureProcessor
-	name               string
-	mutClients         sync.RWMutex
-	clients            []core.BroadcastClient
-	joinTopicName      string
-	signTopicName      string
+	*leftRelayers
+	*signaturesRequests
+	messenger             NetMessenger
+	log                   logger.Logger
+	elrondRoleProvider    ElrondRoleProvider
+	signatureProcessor    SignatureProcessor
+	peerScorer            PeerScorer
+	name                  string
+	mutClients            sync.RWMutex
+	clients               []core.BroadcastClient
+	heartbeatProcessors   []core.HeartbeatProcessor
+	fingerprintProcessors []core.BatchFingerprintProcessor
+	joinTopicName         string
+	signTopicName         string
+	requestTopicName      string
+	responseTopicName     string
+	heartbeatTopicName    string
+	fingerprintTopicName  string
 }
 
 // NewBroadcaster will create a new broadcaster able to pass messages and signatures
@@ -62,20 +92,29 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 		name:               args.Name,
 		messenger:          args.Messenger,
 		noncesOfPublicKeys: newNoncesOfPublicKeys(),
+		leftRelayers:       newLeftRelayers(),
+		signaturesRequests: newSignaturesRequests(args.SignaturesResponsesInterval, args.SignaturesRequestTimeout),
 		log:                args.Log,
 		elrondRoleProvider: args.ElrondRoleProvider,
 		signatureProcessor: args.SignatureProcessor,
+		peerScorer:         args.PeerScorer,
 		relayerMessageHandler: &relayerMessageHandler{
-			marshalizer:         &marshal.JsonMarshalizer{},
+			marshalizer:         args.Marshalizer,
 			keyGen:              args.KeyGen,
 			singleSigner:        args.SingleSigner,
 			counter:             uint64(time.Now().UnixNano()),
 			privateKey:          args.PrivateKey,
 			antifloodComponents: args.AntifloodComponents,
 		},
-		clients:       make([]core.BroadcastClient, 0),
-		joinTopicName: args.Name + joinTopicSuffix,
-		signTopicName: args.Name + signTopicSuffix,
+		clients:               make([]core.BroadcastClient, 0),
+		heartbeatProcessors:   make([]core.HeartbeatProcessor, 0),
+		fingerprintProcessors: make([]core.BatchFingerprintProcessor, 0),
+		joinTopicName:         args.Name + joinTopicSuffix,
+		signTopicName:         args.Name + signTopicSuffix,
+		requestTopicName:      args.Name + requestTopicSuffix,
+		responseTopicName:     args.Name + responseTopicSuffix,
+		heartbeatTopicName:    args.Name + heartbeatTopicSuffix,
+		fingerprintTopicName:  args.Name + fingerprintTopicSuffix,
 	}
 	pk := b.privateKey.GeneratePublic()
 	b.publicKeyBytes, err = pk.ToByteArray()
@@ -108,19 +147,34 @@ func checkArgs(args ArgsBroadcaster) error {
 	if check.IfNil(args.Messenger) {
 		return ErrNilMessenger
 	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
 	if check.IfNil(args.SignatureProcessor) {
 		return ErrNilSignatureProcessor
 	}
 	if args.AntifloodComponents == nil {
 		return ErrNilAntifloodComponents
 	}
+	if check.IfNil(args.PeerScorer) {
+		return ErrNilPeerScorer
+	}
+	if args.SignaturesResponsesInterval < minSignaturesResponsesInterval {
+		return fmt.Errorf("%w for SignaturesResponsesInterval, got: %v, minimum: %v",
+			ErrInvalidDuration, args.SignaturesResponsesInterval, minSignaturesResponsesInterval)
+	}
+	if args.SignaturesRequestTimeout < minSignaturesRequestTimeout {
+		return fmt.Errorf("%w for SignaturesRequestTimeout, got: %v, minimum: %v",
+			ErrInvalidDuration, args.SignaturesRequestTimeout, minSignaturesRequestTimeout)
+	}
 
 	return nil
 }
 
 // RegisterOnTopics will register the messenger on all required topics
 func (b *broadcaster) RegisterOnTopics() error {
-	topics := []string{b.joinTopicName, b.signTopicName}
+	topics := []string{b.joinTopicName, b.signTopicName, b.requestTopicName, b.responseTopicName, b.heartbeatTopicName,
+		b.fingerprintTopicName}
 	for _, topic := range topics {
 		err := b.messenger.CreateTopic(topic, true)
 		if err != nil {
@@ -143,23 +197,32 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 	msg, err := b.preProcessMessage(message, fromConnectedPeer)
 	if err != nil {
 		b.log.Debug("got message", "topic", message.Topic(), "error", err)
+		if isPenalizedPreProcessError(err) {
+			b.peerScorer.Penalize(message.Peer(), nil, invalidMessagePenalty, "invalid message")
+		}
 		return err
 	}
 
 	addr := data.NewAddressFromBytes(msg.PublicKeyBytes)
 	hexPkBytes := hex.EncodeToString(msg.PublicKeyBytes)
 	if !b.elrondRoleProvider.IsWhitelisted(addr) {
+		b.peerScorer.Penalize(message.Peer(), msg.PublicKeyBytes, notWhitelistedPenalty, "public key not whitelisted")
 		return fmt.Errorf("%w for peer: %s", ErrPeerNotWhitelisted, hexPkBytes)
 	}
 
 	b.log.Debug("got message", "topic", message.Topic(),
 		"msg.Payload", msg.Payload, "msg.Nonce", msg.Nonce, "msg.PublicKey", addr.AddressAsBech32String())
 
-	err = b.processNonce(msg)
-	if err != nil {
-		// someone might try to send old, already seen by the network, messages
-		// drop the message and do not resend-it to other relayers
-		return err
+	if message.Topic() != b.responseTopicName {
+		// the responses are stored signatures, created and signed by their original relayers, so they can carry old
+		// nonces. They are accepted only for the pending requests so replaying them is harmless
+		err = b.processNonce(msg)
+		if err != nil {
+			// someone might try to send old, already seen by the network, messages
+			// drop the message and do not resend-it to other relayers. Not penalized as the honest relayers resend
+			// their stored signatures on each join message
+			return err
+		}
 	}
 
 	err = b.canProcessMessage(message, fromConnectedPeer)
@@ -171,14 +234,33 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 
 	switch message.Topic() {
 	case b.joinTopicName:
+		if string(msg.Payload) == leaveTopicMessage {
+			b.processLeaveMessage(msg)
+			return nil
+		}
+		b.markActive(msg.PublicKeyBytes)
 		b.processJoinMessage(message)
 	case b.signTopicName:
-		b.processSignMessage(msg)
+		b.processSignMessage(msg, message.Peer())
+	case b.requestTopicName:
+		b.processSignaturesRequest(msg, message.Peer())
+	case b.responseTopicName:
+		b.processSignaturesResponse(msg, message.Peer())
+	case b.heartbeatTopicName:
+		b.processHeartbeat(msg, message.Peer())
+	case b.fingerprintTopicName:
+		b.processBatchFingerprint(msg, message.Peer())
 	}
 
 	return nil
 }
 
+// isPenalizedPreProcessError returns false for the errors caused by relayers running a different version or
+// configuration as they are not a sign of a misbehaving peer
+func isPenalizedPreProcessError(err error) bool {
+	return !errors.Is(err, ErrUnsupportedMessageVersion) && !errors.Is(err, ErrUnacceptedMessageEncoding)
+}
+
 func (b *broadcaster) processJoinMessage(message p2p.MessageP2P) {
 	err := b.broadcastCurrentSignatures(message.Peer())
 	if err != nil {
@@ -186,6 +268,11 @@ func (b *broadcaster) processJoinMessage(message p2p.MessageP2P) {
 	}
 }
 
+func (b *broadcaster) processLeaveMessage(msg *core.SignedMessage) {
+	b.log.Info("relayer is leaving", "public key", data.NewAddressFromBytes(msg.PublicKeyBytes).AddressAsBech32String())
+	b.markLeft(msg.PublicKeyBytes)
+}
+
 func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.EthereumSignature, error) {
 	ethSignature := &core.EthereumSignature{}
 	err := b.marshalizer.Unmarshal(ethSignature, msg.Payload)
@@ -193,6 +280,11 @@ func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.Ether
 		return nil, err
 	}
 
+	err = checkVersion(ethSignature.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	err = b.signatureProcessor.VerifyEthSignature(ethSignature.Signature, ethSignature.MessageHash)
 	if err != nil {
 		return nil, err
@@ -201,16 +293,105 @@ func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.Ether
 	return ethSignature, nil
 }
 
-func (b *broadcaster) processSignMessage(msg *core.SignedMessage) {
+func (b *broadcaster) processSignMessage(msg *core.SignedMessage, peerId elrondCore.PeerID) {
 	ethSignature, err := b.getEthereumSignature(msg)
 	if err != nil {
 		b.log.Debug("received message does not contain a valid signature", "error", err)
+		b.peerScorer.Penalize(peerId, msg.PublicKeyBytes, invalidEthSignaturePenalty, "invalid ethereum signature")
+		return
+	}
+
+	b.notifyClients(msg, ethSignature)
+}
+
+func (b *broadcaster) processSignaturesRequest(msg *core.SignedMessage, peerId elrondCore.PeerID) {
+	if !b.canRespond(msg.PublicKeyBytes) {
+		b.log.Debug("signatures request dropped, too many requests", "peer", peerId.Pretty(),
+			"public key", data.NewAddressFromBytes(msg.PublicKeyBytes).AddressAsBech32String())
+		return
+	}
+
+	messages := b.retrieveMessagesForHash(msg.Payload)
+	b.log.Debug("responding to signatures request", "peer", peerId.Pretty(),
+		"message hash", msg.Payload, "num signatures", len(messages))
+
+	for _, storedMsg := range messages {
+		err := b.sendMessageToPeer(storedMsg, b.responseTopicName, peerId)
+		if err != nil {
+			b.log.Debug("error sending signatures response",
+				"error", err.Error(), "peer", peerId.Pretty())
+		}
+	}
+}
+
+func (b *broadcaster) processSignaturesResponse(msg *core.SignedMessage, peerId elrondCore.PeerID) {
+	ethSignature, err := b.getEthereumSignature(msg)
+	if err != nil {
+		b.log.Debug("received response does not contain a valid signature", "error", err)
+		b.peerScorer.Penalize(peerId, msg.PublicKeyBytes, invalidEthSignaturePenalty, "invalid ethereum signature")
+		return
+	}
+
+	if !b.isPendingRequest(ethSignature.MessageHash) {
+		b.log.Debug("received unsolicited signatures response", "message hash", ethSignature.MessageHash)
 		return
 	}
 
 	b.notifyClients(msg, ethSignature)
 }
 
+func (b *broadcaster) processHeartbeat(msg *core.SignedMessage, peerId elrondCore.PeerID) {
+	heartbeat := &core.Heartbeat{}
+	err := b.marshalizer.Unmarshal(heartbeat, msg.Payload)
+	if err == nil {
+		err = checkVersion(heartbeat.Version)
+	}
+	if err != nil {
+		b.log.Debug("received message does not contain a valid heartbeat", "error", err)
+		if isPenalizedPreProcessError(err) {
+			b.peerScorer.Penalize(peerId, msg.PublicKeyBytes, invalidHeartbeatPenalty, "invalid heartbeat")
+		}
+		return
+	}
+
+	b.notifyHeartbeatProcessors(msg.PublicKeyBytes, heartbeat)
+}
+
+func (b *broadcaster) notifyHeartbeatProcessors(publicKey []byte, heartbeat *core.Heartbeat) {
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
+	for _, processor := range b.heartbeatProcessors {
+		processor.ProcessHeartbeat(publicKey, heartbeat)
+	}
+}
+
+func (b *broadcaster) processBatchFingerprint(msg *core.SignedMessage, peerId elrondCore.PeerID) {
+	fingerprint := &core.BatchFingerprint{}
+	err := b.marshalizer.Unmarshal(fingerprint, msg.Payload)
+	if err == nil {
+		err = checkVersion(fingerprint.Version)
+	}
+	if err != nil {
+		b.log.Debug("received message does not contain a valid batch fingerprint", "error", err)
+		if isPenalizedPreProcessError(err) {
+			b.peerScorer.Penalize(peerId, msg.PublicKeyBytes, invalidFingerprintPenalty, "invalid batch fingerprint")
+		}
+		return
+	}
+
+	b.notifyFingerprintProcessors(msg.PublicKeyBytes, fingerprint)
+}
+
+func (b *broadcaster) notifyFingerprintProcessors(publicKey []byte, fingerprint *core.BatchFingerprint) {
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
+	for _, processor := range b.fingerprintProcessors {
+		processor.ProcessBatchFingerprint(publicKey, fingerprint)
+	}
+}
+
 func (b *broadcaster) notifyClients(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
 	b.mutClients.RLock()
 	defer b.mutClients.RUnlock()
@@ -235,6 +416,9 @@ func (b *broadcaster) broadcastCurrentSignatures(peerId elrondCore.PeerID) error
 }
 
 func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
 	allMessages := make(map[string]*core.SignedMessage)
 	for _, client := range b.clients {
 		messages := client.AllStoredSignatures()
@@ -246,19 +430,42 @@ func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
 	return allMessages
 }
 
+func (b *broadcaster) retrieveMessagesForHash(messageHash []byte) []*core.SignedMessage {
+	allMessages := b.retrieveUniqueMessages()
+
+	messages := make([]*core.SignedMessage, 0)
+	for _, msg := range allMessages {
+		ethSignature := &core.EthereumSignature{}
+		err := b.marshalizer.Unmarshal(ethSignature, msg.Payload)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(ethSignature.MessageHash, messageHash) {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
+
 func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId elrondCore.PeerID) error {
+	return b.sendMessageToPeer(msg, b.signTopicName, peerId)
+}
+
+func (b *broadcaster) sendMessageToPeer(msg *core.SignedMessage, topic string, peerId elrondCore.PeerID) error {
 	buff, err := b.marshalizer.Marshal(msg)
 	if err != nil {
 		return err
 	}
 
-	return b.messenger.SendToConnectedPeer(b.signTopicName, buff, peerId)
+	return b.messenger.SendToConnectedPeer(topic, buff, peerId)
 }
 
 // BroadcastSignature will send the provided signature as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
 func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte) {
 	ethSig := &core.EthereumSignature{
+		Version:     core.MessagesVersion,
 		Signature:   signature,
 		MessageHash: messageHash,
 	}
@@ -274,6 +481,49 @@ func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte) {
 	}
 }
 
+// RequestSignatures will ask the other relayers for the signatures they hold for the provided message hash.
+// The relayers respond directly to this peer and only the responses for the pending requests are accepted
+func (b *broadcaster) RequestSignatures(messageHash []byte) {
+	b.addPendingRequest(messageHash)
+
+	err := b.broadcastMessage(messageHash, b.requestTopicName)
+	if err != nil {
+		b.log.Error("error sending signatures request", "error", err)
+	}
+}
+
+// BroadcastHeartbeat will send the provided heartbeat as payload in a wrapped signed message to the other peers.
+// The local heartbeat processors are notified as well so this relayer is also part of the cluster view
+func (b *broadcaster) BroadcastHeartbeat(heartbeat *core.Heartbeat) {
+	payload, err := b.marshalizer.Marshal(heartbeat)
+	if err != nil {
+		b.log.Error("error creating heartbeat payload", "error", err)
+		return
+	}
+
+	err = b.broadcastMessage(payload, b.heartbeatTopicName)
+	if err != nil {
+		b.log.Error("error sending heartbeat", "error", err)
+	}
+
+	b.notifyHeartbeatProcessors(b.publicKeyBytes, heartbeat)
+}
+
+// BroadcastBatchFingerprint will send the provided batch fingerprint as payload in a wrapped signed message to the
+// other peers
+func (b *broadcaster) BroadcastBatchFingerprint(fingerprint *core.BatchFingerprint) {
+	payload, err := b.marshalizer.Marshal(fingerprint)
+	if err != nil {
+		b.log.Error("error creating batch fingerprint payload", "error", err)
+		return
+	}
+
+	err = b.broadcastMessage(payload, b.fingerprintTopicName)
+	if err != nil {
+		b.log.Error("error sending batch fingerprint", "error", err)
+	}
+}
+
 // BroadcastJoinTopic will send the provided signature as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
 func (b *broadcaster) BroadcastJoinTopic() {
@@ -283,6 +533,14 @@ func (b *broadcaster) BroadcastJoinTopic() {
 	}
 }
 
+// BroadcastLeaving will send a wrapped signed message to the other peers announcing that this relayer is shutting down
+func (b *broadcaster) BroadcastLeaving() {
+	err := b.broadcastMessage([]byte(leaveTopicMessage), b.joinTopicName)
+	if err != nil {
+		b.log.Error("error sending leave message", "error", err)
+	}
+}
+
 func (b *broadcaster) broadcastMessage(payload []byte, topic string) error {
 	msg, err := b.createMessage(payload)
 	if err != nil {
@@ -313,6 +571,33 @@ func (b *broadcaster) AddBroadcastClient(client core.BroadcastClient) error {
 	return nil
 }
 
+// AddHeartbeatProcessor will add a processor to the list so it can be notified of the newly received heartbeats
+func (b *broadcaster) AddHeartbeatProcessor(processor core.HeartbeatProcessor) error {
+	if check.IfNil(processor) {
+		return ErrNilHeartbeatProcessor
+	}
+
+	b.mutClients.Lock()
+	b.heartbeatProcessors = append(b.heartbeatProcessors, processor)
+	b.mutClients.Unlock()
+
+	return nil
+}
+
+// AddBatchFingerprintProcessor will add a processor to the list so it can be notified of the newly received batch
+// fingerprints
+func (b *broadcaster) AddBatchFingerprintProcessor(processor core.BatchFingerprintProcessor) error {
+	if check.IfNil(processor) {
+		return ErrNilBatchFingerprintProcessor
+	}
+
+	b.mutClients.Lock()
+	b.fingerprintProcessors = append(b.fingerprintProcessors, processor)
+	b.mutClients.Unlock()
+
+	return nil
+}
+
 // Close will close any containing members and clean any go routines associated
 func (b *broadcaster) Close() error {
 	return b.messenger.Close()
@@ -3,9 +3,11 @@ package p2p
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
@@ -32,6 +34,7 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 	ac, _ := factory.NewP2PAntiFloodComponents(context.Background(), cfg, &statusHandler.AppStatusHandlerStub{}, "")
 	return ArgsBroadcaster{
 		Messenger:           &p2pMocks.MessengerStub{},
+		Marshalizer:         &testsCommon.MarshalizerMock{},
 		Log:                 logger.GetOrCreate("test"),
 		ElrondRoleProvider:  &roleProvidersMock.ElrondRoleProviderStub{},
 		KeyGen:              &cryptoMocks.KeyGenStub{},
@@ -40,6 +43,10 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 		SignatureProcessor:  &testsCommon.SignatureProcessorStub{},
 		Name:                "test",
 		AntifloodComponents: ac,
+		PeerScorer:          &p2pMocks.PeerScorerStub{},
+
+		SignaturesResponsesInterval: time.Second,
+		SignaturesRequestTimeout:    time.Minute,
 	}
 }
 
@@ -62,6 +69,32 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilLogger, err)
 	})
+	t.Run("nil peer scorer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PeerScorer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilPeerScorer, err)
+	})
+	t.Run("invalid signatures responses interval should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.SignaturesResponsesInterval = minSignaturesResponsesInterval - time.Millisecond
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "SignaturesResponsesInterval"))
+	})
+	t.Run("invalid signatures request timeout should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.SignaturesRequestTimeout = minSignaturesRequestTimeout - time.Millisecond
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "SignaturesRequestTimeout"))
+	})
 	t.Run("nil key gen should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		args.KeyGen = nil
@@ -102,6 +135,14 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilMessenger, err)
 	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
 	t.Run("nil signature processor should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		args.SignatureProcessor = nil
@@ -194,7 +235,9 @@ func TestBroadcaster_RegisterOnTopics(t *testing.T) {
 		err := b.RegisterOnTopics()
 
 		require.Nil(t, err)
-		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix}
+		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix,
+			args.Name + requestTopicSuffix, args.Name + responseTopicSuffix, args.Name + heartbeatTopicSuffix,
+			args.Name + fingerprintTopicSuffix}
 		for _, topic := range topics {
 			assert.Equal(t, 1, createTopics[topic])
 			assert.Equal(t, 1, register[topic])
@@ -207,14 +250,46 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 
 	t.Run("pre process fails", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
+		penalizeCalled := false
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(peerID elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Equal(t, pid, peerID)
+				assert.Nil(t, publicKey)
+				assert.Equal(t, invalidMessagePenalty, penalty)
+				penalizeCalled = true
+			},
+		}
 
 		b, _ := NewBroadcaster(args)
 		p2pMsg := &p2pMocks.P2PMessageMock{
 			DataField: []byte("gibberish"),
+			PeerField: pid,
 		}
 
 		err := b.ProcessReceivedMessage(p2pMsg, "")
 		assert.NotNil(t, err)
+		assert.True(t, penalizeCalled)
+	})
+	t.Run("unsupported version should not penalize", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Fail(t, "should have not penalized the peer")
+			},
+		}
+
+		msg, _ := createSignedMessageAndMarshaledBytes(0)
+		msg.Version = core.MessagesVersion + 1
+		buff, _ := marshalizer.Marshal(msg)
+
+		b, _ := NewBroadcaster(args)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField: buff,
+			PeerField: pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.True(t, errors.Is(err, ErrUnsupportedMessageVersion))
 	})
 	t.Run("public key not whitelisted", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
@@ -228,6 +303,14 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 				return false
 			},
 		}
+		penalizeCalled := false
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Equal(t, msg.PublicKeyBytes, publicKey)
+				assert.Equal(t, notWhitelistedPenalty, penalty)
+				penalizeCalled = true
+			},
+		}
 
 		b, _ := NewBroadcaster(args)
 		p2pMsg := &p2pMocks.P2PMessageMock{
@@ -237,12 +320,18 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		err := b.ProcessReceivedMessage(p2pMsg, "")
 		assert.True(t, errors.Is(err, ErrPeerNotWhitelisted))
 		assert.True(t, isWhiteListedCalled)
+		assert.True(t, penalizeCalled)
 	})
 	t.Run("invalid nonce should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		msg, buff := createSignedMessageAndMarshaledBytes(0)
 
 		args.ElrondRoleProvider = &roleProvidersMock.ElrondRoleProviderStub{}
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Fail(t, "should have not penalized a stale nonce")
+			},
+		}
 
 		b, _ := NewBroadcaster(args)
 		b.nonces[string(msg.PublicKeyBytes)] = msg.Nonce + 1
@@ -257,6 +346,69 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		err = b.ProcessReceivedMessage(p2pMsg, "")
 		assert.Equal(t, ErrNonceTooLowInReceivedMessage, err)
 	})
+	t.Run("stored signatures resent on join should not penalize the responder", func(t *testing.T) {
+		msg1, buff1 := createSignedMessageForEthSig(0)
+		responderPid := elrondCore.PeerID("responder")
+
+		argsResponder := createMockArgsBroadcaster()
+		resentMessages := make([][]byte, 0)
+		argsResponder.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID elrondCore.PeerID) error {
+				resentMessages = append(resentMessages, buff)
+				return nil
+			},
+		}
+		responder, _ := NewBroadcaster(argsResponder)
+		_ = responder.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return []*core.SignedMessage{msg1}
+			},
+		})
+
+		args := createMockArgsBroadcaster()
+		argsPeerScorer := ArgsPeerScorer{
+			Log: logger.GetOrCreate("test"),
+			PeerDenialEvaluator: &p2pMocks.PeerDenialEvaluatorStub{
+				UpsertPeerIDCalled: func(pid elrondCore.PeerID, duration time.Duration) error {
+					assert.Fail(t, "should have not banned the responder")
+					return nil
+				},
+			},
+			StatusHandler:   testsCommon.NewStatusHandlerMock("test"),
+			BanThreshold:    10,
+			BaseBanDuration: time.Minute,
+			MaxBanDuration:  time.Hour,
+			ScoreRetention:  time.Hour,
+		}
+		peerScorer, _ := NewPeerScorer(argsPeerScorer)
+		args.PeerScorer = peerScorer
+		b, _ := NewBroadcaster(args)
+
+		err := b.ProcessReceivedMessage(&p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + signTopicSuffix,
+			PeerField:  responderPid,
+		}, responderPid)
+		require.Nil(t, err)
+
+		// the join message is re-sent periodically, each time the responder replays its stored signatures
+		for i := 0; i < 20; i++ {
+			responder.processJoinMessage(&p2pMocks.P2PMessageMock{PeerField: "joining peer"})
+		}
+		require.Equal(t, 20, len(resentMessages))
+		for _, buff := range resentMessages {
+			err = b.ProcessReceivedMessage(&p2pMocks.P2PMessageMock{
+				DataField:  buff,
+				TopicField: args.Name + signTopicSuffix,
+				PeerField:  responderPid,
+			}, responderPid)
+			assert.Equal(t, ErrNonceTooLowInReceivedMessage, err)
+		}
+
+		for _, peerScore := range peerScorer.PeerScores() {
+			assert.Equal(t, uint32(0), peerScore.Score)
+		}
+	})
 	t.Run("joined topic should send stored messages from clients", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		msg1, buff1 := createSignedMessageForEthSig(0)
@@ -364,13 +516,21 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 	})
 	t.Run("not a valid signature as payload (verify failed) should add the message's nonce", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
-		_, buff1 := createSignedMessageForEthSig(0)
+		msg1, buff1 := createSignedMessageForEthSig(0)
 		args.Messenger = &p2pMocks.MessengerStub{}
 		args.SignatureProcessor = &testsCommon.SignatureProcessorStub{
 			VerifyEthSignatureCalled: func(signature []byte, messageHash []byte) error {
 				return errors.New("invalid signature as payload")
 			},
 		}
+		penalizeCalled := false
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Equal(t, msg1.PublicKeyBytes, publicKey)
+				assert.Equal(t, invalidEthSignaturePenalty, penalty)
+				penalizeCalled = true
+			},
+		}
 
 		b, _ := NewBroadcaster(args)
 		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
@@ -385,6 +545,7 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 
 		err := b.ProcessReceivedMessage(p2pMsg, "")
 		assert.Nil(t, err)
+		assert.True(t, penalizeCalled)
 
 		assert.Equal(t, 1, len(b.SortedPublicKeys()))
 	})
@@ -464,6 +625,471 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 		assert.Equal(t, []*core.SignedMessage{msg2, msg1}, processedMessages)
 	})
+	t.Run("leave message should mark the relayer as left until it joins again", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Messenger = &p2pMocks.MessengerStub{}
+		b, _ := NewBroadcaster(args)
+
+		leaveMsg := &core.SignedMessage{
+			Payload:        []byte(leaveTopicMessage),
+			PublicKeyBytes: []byte("pk 0"),
+			Signature:      []byte("sig 0"),
+			Nonce:          34,
+		}
+		buff, _ := marshalizer.Marshal(leaveMsg)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + joinTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.True(t, b.HasLeft(leaveMsg.PublicKeyBytes))
+
+		joinMsg := &core.SignedMessage{
+			Payload:        []byte(joinTopicMessage),
+			PublicKeyBytes: leaveMsg.PublicKeyBytes,
+			Signature:      []byte("sig 1"),
+			Nonce:          35,
+		}
+		buff, _ = marshalizer.Marshal(joinMsg)
+		p2pMsg = &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + joinTopicSuffix,
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.False(t, b.HasLeft(leaveMsg.PublicKeyBytes))
+	})
+}
+
+func TestBroadcaster_SignaturesRequests(t *testing.T) {
+	t.Parallel()
+
+	messageHash := []byte("eth msg hash")
+	createRequestMessage := func(nonce uint64) []byte {
+		msg := &core.SignedMessage{
+			Payload:        messageHash,
+			PublicKeyBytes: []byte("requester pk"),
+			Signature:      []byte("requester sig"),
+			Nonce:          nonce,
+		}
+		buff, _ := marshalizer.Marshal(msg)
+
+		return buff
+	}
+
+	t.Run("request should respond with the stored signatures of the message hash", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, buff1 := createSignedMessageForEthSig(0)
+		otherEthSig := &core.EthereumSignature{
+			Signature:   []byte("eth sig"),
+			MessageHash: []byte("other msg hash"),
+		}
+		payload, _ := marshalizer.Marshal(otherEthSig)
+		msg2 := &core.SignedMessage{
+			Payload:        payload,
+			PublicKeyBytes: []byte("pk 1"),
+			Signature:      []byte("sig 1"),
+			Nonce:          35,
+		}
+
+		sentMessages := make([][]byte, 0)
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID elrondCore.PeerID) error {
+				assert.Equal(t, args.Name+responseTopicSuffix, topic)
+				assert.Equal(t, pid, peerID)
+				sentMessages = append(sentMessages, buff)
+
+				return nil
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return []*core.SignedMessage{msg1, msg2}
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  createRequestMessage(1),
+			TopicField: args.Name + requestTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{buff1}, sentMessages)
+	})
+	t.Run("requests should be rate limited for each requester", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, _ := createSignedMessageForEthSig(0)
+
+		numSent := 0
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID elrondCore.PeerID) error {
+				numSent++
+				return nil
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		currentTime := time.Now()
+		b.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return []*core.SignedMessage{msg1}
+			},
+		})
+
+		for i := 1; i <= 3; i++ {
+			p2pMsg := &p2pMocks.P2PMessageMock{
+				DataField:  createRequestMessage(uint64(i)),
+				TopicField: args.Name + requestTopicSuffix,
+				PeerField:  pid,
+			}
+			err := b.ProcessReceivedMessage(p2pMsg, "")
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, 1, numSent)
+
+		currentTime = currentTime.Add(args.SignaturesResponsesInterval)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  createRequestMessage(4),
+			TopicField: args.Name + requestTopicSuffix,
+			PeerField:  pid,
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numSent)
+	})
+	t.Run("unsolicited response should not notify the clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		_, buff1 := createSignedMessageForEthSig(0)
+
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				require.Fail(t, "should have not called process")
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + responseTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+	})
+	t.Run("response with invalid signature should not notify the clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		_, buff1 := createSignedMessageForEthSig(0)
+		args.SignatureProcessor = &testsCommon.SignatureProcessorStub{
+			VerifyEthSignatureCalled: func(signature []byte, messageHash []byte) error {
+				return errors.New("invalid signature as payload")
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		b.RequestSignatures(messageHash)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				require.Fail(t, "should have not called process")
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + responseTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+	})
+	t.Run("expired request should not accept responses", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		_, buff1 := createSignedMessageForEthSig(0)
+
+		b, _ := NewBroadcaster(args)
+		currentTime := time.Now()
+		b.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		b.RequestSignatures(messageHash)
+		currentTime = currentTime.Add(args.SignaturesRequestTimeout + time.Second)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				require.Fail(t, "should have not called process")
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + responseTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+	})
+	t.Run("responses of a pending request should notify the clients even with old nonces", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, buff1 := createSignedMessageForEthSig(0)
+
+		requestSent := false
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				assert.Equal(t, args.Name+requestTopicSuffix, topic)
+
+				msg := &core.SignedMessage{}
+				err := marshalizer.Unmarshal(msg, buff)
+				require.Nil(t, err)
+				assert.Equal(t, messageHash, msg.Payload)
+				requestSent = true
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		b.RequestSignatures(messageHash)
+		assert.True(t, requestSent)
+
+		processedMessages := make([]*core.SignedMessage, 0)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				assert.Equal(t, messageHash, ethMsg.MessageHash)
+				processedMessages = append(processedMessages, msg)
+			},
+		})
+		b.nonces[string(msg1.PublicKeyBytes)] = msg1.Nonce + 1
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + responseTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg1}, processedMessages)
+	})
+}
+
+func createSignedMessageForHeartbeat(index int) (*core.SignedMessage, []byte) {
+	heartbeat := &core.Heartbeat{
+		Version:           core.MessagesVersion,
+		AppVersion:        "v1.0.0",
+		TimestampInMillis: 1000,
+		StateMachines: []*core.HeartbeatStateMachine{
+			{Name: "EthereumToElrond", Step: "getting the pending batch", LastBatchID: 3},
+		},
+	}
+	payload, _ := marshalizer.Marshal(heartbeat)
+
+	msg := &core.SignedMessage{
+		Payload:        payload,
+		PublicKeyBytes: []byte(fmt.Sprintf("pk %d", index)),
+		Signature:      []byte(fmt.Sprintf("sig %d", index)),
+		Nonce:          34,
+	}
+	buff, _ := marshalizer.Marshal(msg)
+
+	return msg, buff
+}
+
+func TestBroadcaster_Heartbeats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil heartbeat processor should error", func(t *testing.T) {
+		b, _ := NewBroadcaster(createMockArgsBroadcaster())
+
+		err := b.AddHeartbeatProcessor(nil)
+		assert.Equal(t, ErrNilHeartbeatProcessor, err)
+	})
+	t.Run("received heartbeat should notify the processors", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg, buff := createSignedMessageForHeartbeat(0)
+
+		b, _ := NewBroadcaster(args)
+		var processedHeartbeat *core.Heartbeat
+		_ = b.AddHeartbeatProcessor(&testsCommon.HeartbeatProcessorStub{
+			ProcessHeartbeatCalled: func(publicKey []byte, heartbeat *core.Heartbeat) {
+				assert.Equal(t, msg.PublicKeyBytes, publicKey)
+				processedHeartbeat = heartbeat
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + heartbeatTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		require.NotNil(t, processedHeartbeat)
+		assert.Equal(t, "v1.0.0", processedHeartbeat.AppVersion)
+		assert.Equal(t, uint64(3), processedHeartbeat.StateMachines[0].LastBatchID)
+	})
+	t.Run("invalid heartbeat should penalize the peer", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		penalized := false
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(peerID elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Equal(t, pid, peerID)
+				assert.Equal(t, invalidHeartbeatPenalty, penalty)
+				penalized = true
+			},
+		}
+		msg, _ := createSignedMessageAndMarshaledBytes(0)
+		msg.Payload = []byte("not a heartbeat")
+		buff, _ := marshalizer.Marshal(msg)
+
+		b, _ := NewBroadcaster(args)
+		_ = b.AddHeartbeatProcessor(&testsCommon.HeartbeatProcessorStub{
+			ProcessHeartbeatCalled: func(publicKey []byte, heartbeat *core.Heartbeat) {
+				require.Fail(t, "should have not called process")
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + heartbeatTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.True(t, penalized)
+	})
+	t.Run("broadcast heartbeat should send it and notify the local processors", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		heartbeat := &core.Heartbeat{
+			Version:    core.MessagesVersion,
+			AppVersion: "v1.0.0",
+		}
+		broadcastCalled := false
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastCalled = true
+				assert.Equal(t, args.Name+heartbeatTopicSuffix, topic)
+
+				msg := &core.SignedMessage{}
+				err := marshalizer.Unmarshal(msg, buff)
+				require.Nil(t, err)
+
+				received := &core.Heartbeat{}
+				err = marshalizer.Unmarshal(received, msg.Payload)
+				require.Nil(t, err)
+				assert.Equal(t, heartbeat, received)
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		numProcessed := 0
+		_ = b.AddHeartbeatProcessor(&testsCommon.HeartbeatProcessorStub{
+			ProcessHeartbeatCalled: func(publicKey []byte, hb *core.Heartbeat) {
+				assert.Equal(t, b.publicKeyBytes, publicKey)
+				assert.Equal(t, heartbeat, hb)
+				numProcessed++
+			},
+		})
+
+		b.BroadcastHeartbeat(heartbeat)
+		assert.True(t, broadcastCalled)
+		assert.Equal(t, 1, numProcessed)
+	})
+}
+
+func TestBroadcaster_BatchFingerprints(t *testing.T) {
+	t.Parallel()
+
+	fingerprint := &core.BatchFingerprint{
+		Version:     core.MessagesVersion,
+		BridgeName:  "EthereumToElrond",
+		BatchID:     3,
+		Fingerprint: []byte("fingerprint"),
+		Fields: []*core.BatchFingerprintField{
+			{Name: "batch ID", Hash: []byte("hash")},
+		},
+	}
+
+	t.Run("nil batch fingerprint processor should error", func(t *testing.T) {
+		b, _ := NewBroadcaster(createMockArgsBroadcaster())
+
+		err := b.AddBatchFingerprintProcessor(nil)
+		assert.Equal(t, ErrNilBatchFingerprintProcessor, err)
+	})
+	t.Run("received batch fingerprint should notify the processors", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg, _ := createSignedMessageAndMarshaledBytes(0)
+		msg.Payload, _ = marshalizer.Marshal(fingerprint)
+		buff, _ := marshalizer.Marshal(msg)
+
+		b, _ := NewBroadcaster(args)
+		var processedFingerprint *core.BatchFingerprint
+		_ = b.AddBatchFingerprintProcessor(&testsCommon.BatchFingerprintProcessorStub{
+			ProcessBatchFingerprintCalled: func(publicKey []byte, fp *core.BatchFingerprint) {
+				assert.Equal(t, msg.PublicKeyBytes, publicKey)
+				processedFingerprint = fp
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + fingerprintTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.Equal(t, fingerprint, processedFingerprint)
+	})
+	t.Run("invalid batch fingerprint should penalize the peer", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		penalized := false
+		args.PeerScorer = &p2pMocks.PeerScorerStub{
+			PenalizeCalled: func(peerID elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+				assert.Equal(t, pid, peerID)
+				assert.Equal(t, invalidFingerprintPenalty, penalty)
+				penalized = true
+			},
+		}
+		msg, _ := createSignedMessageAndMarshaledBytes(0)
+		msg.Payload = []byte("not a batch fingerprint")
+		buff, _ := marshalizer.Marshal(msg)
+
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBatchFingerprintProcessor(&testsCommon.BatchFingerprintProcessorStub{
+			ProcessBatchFingerprintCalled: func(publicKey []byte, fp *core.BatchFingerprint) {
+				require.Fail(t, "should have not called process")
+			},
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + fingerprintTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "")
+		assert.Nil(t, err)
+		assert.True(t, penalized)
+	})
+	t.Run("broadcast batch fingerprint should send it", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		broadcastCalled := false
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastCalled = true
+				assert.Equal(t, args.Name+fingerprintTopicSuffix, topic)
+
+				msg := &core.SignedMessage{}
+				err := marshalizer.Unmarshal(msg, buff)
+				require.Nil(t, err)
+
+				received := &core.BatchFingerprint{}
+				err = marshalizer.Unmarshal(received, msg.Payload)
+				require.Nil(t, err)
+				assert.Equal(t, fingerprint, received)
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		b.BroadcastBatchFingerprint(fingerprint)
+		assert.True(t, broadcastCalled)
+	})
 }
 
 func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
@@ -495,6 +1121,28 @@ func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
 	assert.True(t, broadcastCalled)
 }
 
+func TestBroadcaster_BroadcastLeaving(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	args := createMockArgsBroadcaster()
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+			assert.Equal(t, args.Name+joinTopicSuffix, topic)
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+			assert.Equal(t, []byte(leaveTopicMessage), msg.Payload)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastLeaving()
+	assert.True(t, broadcastCalled)
+}
+
 func TestBroadcaster_BroadcastSignature(t *testing.T) {
 	t.Parallel()
 
@@ -52,3 +52,33 @@ var ErrNilBlackListIDsCache = errors.New("nil blacklist cache")
 
 // ErrNilBlackListedPublicKeysCache signals that a nil blacklist public keys cache was provided
 var ErrNilBlackListedPublicKeysCache = errors.New("nil blacklist public keys cache")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrUnsupportedMarshalizerType signals that the configured marshalizer type can not be used for the relayer messages
+var ErrUnsupportedMarshalizerType = errors.New("unsupported marshalizer type for the relayer messages")
+
+// ErrUnacceptedMessageEncoding signals that a message was encoded with a marshalizer that is no longer accepted
+var ErrUnacceptedMessageEncoding = errors.New("unaccepted message encoding")
+
+// ErrUnsupportedMessageVersion signals that a message has a newer version than the ones this relayer can process
+var ErrUnsupportedMessageVersion = errors.New("unsupported message version")
+
+// ErrInvalidDuration signals that an invalid duration was provided
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator was provided
+var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilPeerScorer signals that a nil peer scorer was provided
+var ErrNilPeerScorer = errors.New("nil peer scorer")
+
+// ErrNilHeartbeatProcessor signals that a nil heartbeat processor was provided
+var ErrNilHeartbeatProcessor = errors.New("nil heartbeat processor")
+
+// ErrNilBatchFingerprintProcessor signals that a nil batch fingerprint processor was provided
+var ErrNilBatchFingerprintProcessor = errors.New("nil batch fingerprint processor")
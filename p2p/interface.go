@@ -36,6 +36,12 @@ type SignatureProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// PeerScorer defines a component able to penalize the peers that send invalid messages
+type PeerScorer interface {
+	Penalize(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string)
+	IsInterfaceNil() bool
+}
+
 // PeerDenialEvaluator defines the behavior of a component that is able to decide if a peer ID is black listed or not
 type PeerDenialEvaluator interface {
 	IsDenied(pid elrondCore.PeerID) bool
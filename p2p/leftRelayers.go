@@ -0,0 +1,37 @@
+package p2p
+
+import "sync"
+
+type leftRelayers struct {
+	mut        sync.RWMutex
+	publicKeys map[string]struct{}
+}
+
+func newLeftRelayers() *leftRelayers {
+	return &leftRelayers{
+		publicKeys: make(map[string]struct{}),
+	}
+}
+
+func (holder *leftRelayers) markLeft(publicKey []byte) {
+	holder.mut.Lock()
+	holder.publicKeys[string(publicKey)] = struct{}{}
+	holder.mut.Unlock()
+}
+
+func (holder *leftRelayers) markActive(publicKey []byte) {
+	holder.mut.Lock()
+	delete(holder.publicKeys, string(publicKey))
+	holder.mut.Unlock()
+}
+
+// HasLeft returns true if the relayer with the provided public key announced it is shutting down and did
+// not join again since
+func (holder *leftRelayers) HasLeft(publicKey []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	_, found := holder.publicKeys[string(publicKey)]
+
+	return found
+}
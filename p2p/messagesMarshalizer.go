@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+)
+
+const jsonObjectStart = '{'
+
+// ArgsMessagesMarshalizer is the DTO used to create a new instance of messagesMarshalizer
+type ArgsMessagesMarshalizer struct {
+	Type                string
+	AcceptBothEncodings bool
+}
+
+// messagesMarshalizer encodes the relayer messages with the configured marshalizer and decodes them based on the
+// encoding detected in the received buffer. While both encodings are accepted, a relayer set can be migrated from the
+// legacy json encoding to the gogo protobuf one, one relayer at a time
+type messagesMarshalizer struct {
+	outgoingIsJson       bool
+	acceptBothEncodings  bool
+	jsonMarshalizer      marshal.Marshalizer
+	gogoProtoMarshalizer marshal.Marshalizer
+}
+
+// NewMessagesMarshalizer creates a new instance of the marshalizer used for the relayer p2p messages
+func NewMessagesMarshalizer(args ArgsMessagesMarshalizer) (*messagesMarshalizer, error) {
+	switch args.Type {
+	case marshalFactory.JsonMarshalizer, marshalFactory.GogoProtobuf:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedMarshalizerType, args.Type)
+	}
+
+	return &messagesMarshalizer{
+		outgoingIsJson:       args.Type == marshalFactory.JsonMarshalizer,
+		acceptBothEncodings:  args.AcceptBothEncodings,
+		jsonMarshalizer:      &marshal.JsonMarshalizer{},
+		gogoProtoMarshalizer: &marshal.GogoProtoMarshalizer{},
+	}, nil
+}
+
+// Marshal encodes the provided object with the configured marshalizer
+func (mm *messagesMarshalizer) Marshal(obj interface{}) ([]byte, error) {
+	if mm.outgoingIsJson {
+		return mm.jsonMarshalizer.Marshal(obj)
+	}
+
+	return mm.gogoProtoMarshalizer.Marshal(obj)
+}
+
+// Unmarshal decodes the provided buffer with the marshalizer matching its encoding. A json encoded message always
+// starts with '{', which is never the first byte of the gogo protobuf encoding of a relayer message
+func (mm *messagesMarshalizer) Unmarshal(obj interface{}, buff []byte) error {
+	isJson := isJsonEncoded(buff)
+	if isJson != mm.outgoingIsJson && !mm.acceptBothEncodings {
+		return ErrUnacceptedMessageEncoding
+	}
+
+	if isJson {
+		return mm.jsonMarshalizer.Unmarshal(obj, buff)
+	}
+
+	return mm.gogoProtoMarshalizer.Unmarshal(obj, buff)
+}
+
+func isJsonEncoded(buff []byte) bool {
+	trimmed := bytes.TrimLeft(buff, " \t\r\n")
+
+	return len(trimmed) > 0 && trimmed[0] == jsonObjectStart
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mm *messagesMarshalizer) IsInterfaceNil() bool {
+	return mm == nil
+}
@@ -0,0 +1,144 @@
+package p2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSignedMessage() *core.SignedMessage {
+	return &core.SignedMessage{
+		Version:        core.MessagesVersion,
+		Payload:        []byte("payload"),
+		PublicKeyBytes: []byte("pk"),
+		Signature:      []byte("sig"),
+		Nonce:          34,
+	}
+}
+
+func TestNewMessagesMarshalizer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported type should error", func(t *testing.T) {
+		mm, err := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "tx-json"})
+		assert.True(t, check.IfNil(mm))
+		assert.True(t, errors.Is(err, ErrUnsupportedMarshalizerType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		mm, err := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "gogo protobuf"})
+		assert.False(t, check.IfNil(mm))
+		assert.Nil(t, err)
+
+		mm, err = NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "json"})
+		assert.False(t, check.IfNil(mm))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMessagesMarshalizer_Marshal(t *testing.T) {
+	t.Parallel()
+
+	msg := createTestSignedMessage()
+
+	t.Run("json", func(t *testing.T) {
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "json"})
+
+		buff, err := mm.Marshal(msg)
+		require.Nil(t, err)
+		expectedBuff, _ := (&marshal.JsonMarshalizer{}).Marshal(msg)
+		assert.Equal(t, expectedBuff, buff)
+	})
+	t.Run("gogo protobuf", func(t *testing.T) {
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "gogo protobuf"})
+
+		buff, err := mm.Marshal(msg)
+		require.Nil(t, err)
+		expectedBuff, _ := msg.Marshal()
+		assert.Equal(t, expectedBuff, buff)
+	})
+}
+
+func TestMessagesMarshalizer_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	msg := createTestSignedMessage()
+	protoBuff, _ := msg.Marshal()
+	jsonBuff, _ := (&marshal.JsonMarshalizer{}).Marshal(msg)
+	legacyJsonBuff := []byte(`{"payload":"cGF5bG9hZA==","pk":"cGs=","sig":"c2ln","nonce":34}`)
+
+	t.Run("accepting both encodings should decode both", func(t *testing.T) {
+		for _, outgoingType := range []string{"json", "gogo protobuf"} {
+			mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{
+				Type:                outgoingType,
+				AcceptBothEncodings: true,
+			})
+
+			for _, buff := range [][]byte{protoBuff, jsonBuff} {
+				recovered := &core.SignedMessage{}
+				err := mm.Unmarshal(recovered, buff)
+				assert.Nil(t, err)
+				assert.Equal(t, msg, recovered)
+			}
+		}
+	})
+	t.Run("legacy json message should decode as version 0", func(t *testing.T) {
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{
+			Type:                "gogo protobuf",
+			AcceptBothEncodings: true,
+		})
+
+		recovered := &core.SignedMessage{}
+		err := mm.Unmarshal(recovered, legacyJsonBuff)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(0), recovered.Version)
+		assert.Equal(t, []byte("payload"), recovered.Payload)
+		assert.Equal(t, uint64(34), recovered.Nonce)
+	})
+	t.Run("gogo protobuf only should reject json messages", func(t *testing.T) {
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "gogo protobuf"})
+
+		err := mm.Unmarshal(&core.SignedMessage{}, jsonBuff)
+		assert.Equal(t, ErrUnacceptedMessageEncoding, err)
+
+		recovered := &core.SignedMessage{}
+		err = mm.Unmarshal(recovered, protoBuff)
+		assert.Nil(t, err)
+		assert.Equal(t, msg, recovered)
+	})
+	t.Run("json only should reject gogo protobuf messages", func(t *testing.T) {
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{Type: "json"})
+
+		err := mm.Unmarshal(&core.SignedMessage{}, protoBuff)
+		assert.Equal(t, ErrUnacceptedMessageEncoding, err)
+
+		recovered := &core.SignedMessage{}
+		err = mm.Unmarshal(recovered, jsonBuff)
+		assert.Nil(t, err)
+		assert.Equal(t, msg, recovered)
+	})
+	t.Run("ethereum signature payload should decode in both encodings", func(t *testing.T) {
+		ethSig := &core.EthereumSignature{
+			Version:     core.MessagesVersion,
+			Signature:   []byte("eth sig"),
+			MessageHash: []byte("eth msg hash"),
+		}
+		mm, _ := NewMessagesMarshalizer(ArgsMessagesMarshalizer{
+			Type:                "gogo protobuf",
+			AcceptBothEncodings: true,
+		})
+
+		ethSigProtoBuff, _ := mm.Marshal(ethSig)
+		ethSigJsonBuff, _ := (&marshal.JsonMarshalizer{}).Marshal(ethSig)
+		for _, buff := range [][]byte{ethSigProtoBuff, ethSigJsonBuff} {
+			recovered := &core.EthereumSignature{}
+			err := mm.Unmarshal(recovered, buff)
+			assert.Nil(t, err)
+			assert.Equal(t, ethSig, recovered)
+		}
+	})
+}
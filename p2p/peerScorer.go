@@ -0,0 +1,270 @@
+package p2p
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+const (
+	peerIDScoreType    = "peer ID"
+	publicKeyScoreType = "public key"
+	maxTrackedScores   = 10000
+	minBanThreshold    = 1
+	minBanDuration     = time.Second
+)
+
+// ArgsPeerScorer is the DTO used in the peer scorer constructor
+type ArgsPeerScorer struct {
+	Log                 logger.Logger
+	PeerDenialEvaluator PeerDenialEvaluator
+	StatusHandler       core.StatusHandler
+	BanThreshold        uint32
+	BaseBanDuration     time.Duration
+	MaxBanDuration      time.Duration
+	ScoreRetention      time.Duration
+}
+
+type score struct {
+	identifier  string
+	scoreType   string
+	value       uint32
+	numBans     uint32
+	lastReason  string
+	lastPenalty time.Time
+	bannedUntil time.Time
+}
+
+type peerScorer struct {
+	mut                 sync.Mutex
+	log                 logger.Logger
+	peerDenialEvaluator PeerDenialEvaluator
+	statusHandler       core.StatusHandler
+	banThreshold        uint32
+	baseBanDuration     time.Duration
+	maxBanDuration      time.Duration
+	scoreRetention      time.Duration
+	peerScores          map[string]*score
+	publicKeyScores     map[string]*score
+	getTimeHandler      func() time.Time
+}
+
+// NewPeerScorer creates a new peer scorer able to ban the peers that keep sending invalid messages
+func NewPeerScorer(args ArgsPeerScorer) (*peerScorer, error) {
+	err := checkArgsPeerScorer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peerScorer{
+		log:                 args.Log,
+		peerDenialEvaluator: args.PeerDenialEvaluator,
+		statusHandler:       args.StatusHandler,
+		banThreshold:        args.BanThreshold,
+		baseBanDuration:     args.BaseBanDuration,
+		maxBanDuration:      args.MaxBanDuration,
+		scoreRetention:      args.ScoreRetention,
+		peerScores:          make(map[string]*score),
+		publicKeyScores:     make(map[string]*score),
+		getTimeHandler:      time.Now,
+	}, nil
+}
+
+func checkArgsPeerScorer(args ArgsPeerScorer) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.PeerDenialEvaluator) {
+		return ErrNilPeerDenialEvaluator
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.BanThreshold < minBanThreshold {
+		return fmt.Errorf("%w for BanThreshold, got: %d, minimum: %d", ErrInvalidValue, args.BanThreshold, minBanThreshold)
+	}
+	if args.BaseBanDuration < minBanDuration {
+		return fmt.Errorf("%w for BaseBanDuration, got: %v, minimum: %v", ErrInvalidDuration, args.BaseBanDuration, minBanDuration)
+	}
+	if args.MaxBanDuration < args.BaseBanDuration {
+		return fmt.Errorf("%w for MaxBanDuration, got: %v, minimum: %v", ErrInvalidDuration, args.MaxBanDuration, args.BaseBanDuration)
+	}
+	if args.ScoreRetention < minBanDuration {
+		return fmt.Errorf("%w for ScoreRetention, got: %v, minimum: %v", ErrInvalidDuration, args.ScoreRetention, minBanDuration)
+	}
+
+	return nil
+}
+
+// Penalize adds the penalty to the scores of the provided peer ID and public key. The public key is empty if the
+// message could not be decoded. When one of the scores reaches the ban threshold, the peer is banned through the
+// peer denial evaluator for a duration that doubles with each ban
+func (ps *peerScorer) Penalize(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	now := ps.getTimeHandler()
+	if len(ps.peerScores)+len(ps.publicKeyScores) > maxTrackedScores {
+		ps.removeExpiredScores(now)
+	}
+
+	peerScore := getOrCreateScore(ps.peerScores, string(pid), pid.Pretty(), peerIDScoreType)
+	shouldBan := ps.addPenalty(peerScore, penalty, reason, now)
+
+	var publicKeyScore *score
+	if len(publicKey) > 0 {
+		identifier := data.NewAddressFromBytes(publicKey).AddressAsBech32String()
+		publicKeyScore = getOrCreateScore(ps.publicKeyScores, string(publicKey), identifier, publicKeyScoreType)
+		shouldBan = ps.addPenalty(publicKeyScore, penalty, reason, now) || shouldBan
+	}
+
+	ps.statusHandler.AddIntMetric(core.MetricP2PNumPenalizedMessages, 1)
+	ps.statusHandler.SetIntMetric(core.MetricP2PNumScoredPeers, len(ps.peerScores))
+	if !shouldBan {
+		return
+	}
+
+	ps.banPeer(pid, now, reason, peerScore, publicKeyScore)
+}
+
+func getOrCreateScore(scores map[string]*score, key string, identifier string, scoreType string) *score {
+	s, found := scores[key]
+	if !found {
+		s = &score{
+			identifier: identifier,
+			scoreType:  scoreType,
+		}
+		scores[key] = s
+	}
+
+	return s
+}
+
+func (ps *peerScorer) addPenalty(s *score, penalty uint32, reason string, now time.Time) bool {
+	if now.Sub(s.lastPenalty) > ps.scoreRetention {
+		s.value = 0
+	}
+
+	s.value += penalty
+	s.lastReason = reason
+	s.lastPenalty = now
+
+	return s.value >= ps.banThreshold
+}
+
+func (ps *peerScorer) banPeer(pid elrondCore.PeerID, now time.Time, reason string, scores ...*score) {
+	numBans := uint32(0)
+	for _, s := range scores {
+		if s != nil && s.numBans > numBans {
+			numBans = s.numBans
+		}
+	}
+
+	duration := ps.computeBanDuration(numBans)
+	bannedUntil := now.Add(duration)
+	for _, s := range scores {
+		if s == nil {
+			continue
+		}
+
+		s.value = 0
+		s.numBans = numBans + 1
+		s.bannedUntil = bannedUntil
+	}
+
+	err := ps.peerDenialEvaluator.UpsertPeerID(pid, duration)
+	if err != nil {
+		ps.log.Warn("error banning peer", "peer", pid.Pretty(), "error", err)
+		return
+	}
+
+	ps.log.Warn("peer banned for sending invalid messages", "peer", pid.Pretty(),
+		"duration", duration, "num bans", numBans+1, "last reason", reason)
+	ps.statusHandler.AddIntMetric(core.MetricP2PNumPeerBans, 1)
+}
+
+func (ps *peerScorer) computeBanDuration(numBans uint32) time.Duration {
+	duration := ps.baseBanDuration
+	for i := uint32(0); i < numBans; i++ {
+		duration *= 2
+		if duration >= ps.maxBanDuration {
+			return ps.maxBanDuration
+		}
+	}
+
+	return duration
+}
+
+func (ps *peerScorer) removeExpiredScores(now time.Time) {
+	for _, scores := range []map[string]*score{ps.peerScores, ps.publicKeyScores} {
+		for key, s := range scores {
+			if ps.isExpired(s, now) {
+				delete(scores, key)
+			}
+		}
+	}
+}
+
+// isExpired returns true if the score did not receive any penalty in the retention time and is not banned
+func (ps *peerScorer) isExpired(s *score, now time.Time) bool {
+	return now.Sub(s.lastPenalty) > ps.scoreRetention && now.After(s.bannedUntil)
+}
+
+// PeerScores returns the scores of the peer IDs and public keys penalized in the retention time or still banned,
+// the highest scores first
+func (ps *peerScorer) PeerScores() []core.PeerScore {
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	now := ps.getTimeHandler()
+	result := make([]core.PeerScore, 0, len(ps.peerScores)+len(ps.publicKeyScores))
+	for _, scores := range []map[string]*score{ps.peerScores, ps.publicKeyScores} {
+		for _, s := range scores {
+			if ps.isExpired(s, now) {
+				continue
+			}
+
+			peerScore := core.PeerScore{
+				Identifier:  s.identifier,
+				Type:        s.scoreType,
+				Score:       s.value,
+				NumBans:     s.numBans,
+				LastReason:  s.lastReason,
+				LastPenalty: s.lastPenalty,
+			}
+			if now.Sub(s.lastPenalty) > ps.scoreRetention {
+				peerScore.Score = 0
+			}
+			if s.bannedUntil.After(now) {
+				peerScore.BannedUntil = s.bannedUntil
+			}
+
+			result = append(result, peerScore)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+
+		return result[i].Identifier < result[j].Identifier
+	})
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *peerScorer) IsInterfaceNil() bool {
+	return ps == nil
+}
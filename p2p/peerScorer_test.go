@@ -0,0 +1,214 @@
+package p2p
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	p2pMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/p2p"
+	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPeerScorer() ArgsPeerScorer {
+	return ArgsPeerScorer{
+		Log:                 logger.GetOrCreate("test"),
+		PeerDenialEvaluator: &p2pMocks.PeerDenialEvaluatorStub{},
+		StatusHandler:       testsCommon.NewStatusHandlerMock("test"),
+		BanThreshold:        10,
+		BaseBanDuration:     time.Minute,
+		MaxBanDuration:      time.Minute * 5,
+		ScoreRetention:      time.Hour,
+	}
+}
+
+func TestNewPeerScorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.Log = nil
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil peer denial evaluator should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.PeerDenialEvaluator = nil
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.Equal(t, ErrNilPeerDenialEvaluator, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.StatusHandler = nil
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid ban threshold should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.BanThreshold = 0
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "BanThreshold"))
+	})
+	t.Run("invalid base ban duration should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.BaseBanDuration = minBanDuration - time.Millisecond
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "BaseBanDuration"))
+	})
+	t.Run("max ban duration lower than the base one should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.MaxBanDuration = args.BaseBanDuration - time.Second
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "MaxBanDuration"))
+	})
+	t.Run("invalid score retention should error", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.ScoreRetention = minBanDuration - time.Millisecond
+
+		ps, err := NewPeerScorer(args)
+		assert.True(t, check.IfNil(ps))
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+		assert.True(t, strings.Contains(err.Error(), "ScoreRetention"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		ps, err := NewPeerScorer(createMockArgsPeerScorer())
+		assert.False(t, check.IfNil(ps))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPeerScorer_Penalize(t *testing.T) {
+	t.Parallel()
+
+	publicKey := []byte("public key 0")
+
+	t.Run("should ban the peer with escalating durations", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		bans := make([]time.Duration, 0)
+		args.PeerDenialEvaluator = &p2pMocks.PeerDenialEvaluatorStub{
+			UpsertPeerIDCalled: func(peerID elrondCore.PeerID, duration time.Duration) error {
+				assert.Equal(t, pid, peerID)
+				bans = append(bans, duration)
+				return nil
+			},
+		}
+
+		ps, _ := NewPeerScorer(args)
+		for i := 0; i < 4; i++ {
+			ps.Penalize(pid, publicKey, 5, "invalid message")
+			ps.Penalize(pid, publicKey, 5, "invalid message")
+		}
+
+		expectedBans := []time.Duration{time.Minute, time.Minute * 2, time.Minute * 4, time.Minute * 5}
+		assert.Equal(t, expectedBans, bans)
+		assert.Equal(t, 8, statusHandler.GetIntMetric(core.MetricP2PNumPenalizedMessages))
+		assert.Equal(t, 4, statusHandler.GetIntMetric(core.MetricP2PNumPeerBans))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricP2PNumScoredPeers))
+	})
+	t.Run("public key score should ban the peers sending its messages", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		bannedPeers := make([]elrondCore.PeerID, 0)
+		args.PeerDenialEvaluator = &p2pMocks.PeerDenialEvaluatorStub{
+			UpsertPeerIDCalled: func(peerID elrondCore.PeerID, duration time.Duration) error {
+				bannedPeers = append(bannedPeers, peerID)
+				return nil
+			},
+		}
+
+		ps, _ := NewPeerScorer(args)
+		ps.Penalize("pid 1", publicKey, 5, "not whitelisted")
+		ps.Penalize("pid 2", publicKey, 5, "not whitelisted")
+
+		assert.Equal(t, []elrondCore.PeerID{"pid 2"}, bannedPeers)
+	})
+	t.Run("score should be reset after the retention time", func(t *testing.T) {
+		args := createMockArgsPeerScorer()
+		args.PeerDenialEvaluator = &p2pMocks.PeerDenialEvaluatorStub{
+			UpsertPeerIDCalled: func(peerID elrondCore.PeerID, duration time.Duration) error {
+				assert.Fail(t, "should have not banned the peer")
+				return nil
+			},
+		}
+
+		ps, _ := NewPeerScorer(args)
+		currentTime := time.Now()
+		ps.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		ps.Penalize(pid, publicKey, 9, "invalid message")
+		currentTime = currentTime.Add(args.ScoreRetention + time.Second)
+		ps.Penalize(pid, publicKey, 9, "invalid message")
+	})
+}
+
+func TestPeerScorer_PeerScores(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeerScorer()
+	ps, _ := NewPeerScorer(args)
+	currentTime := time.Now()
+	ps.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	publicKey := []byte("public key 0")
+	ps.Penalize("pid 1", nil, 3, "invalid message")
+	ps.Penalize("pid 2", publicKey, 10, "not whitelisted")
+	ps.Penalize("pid 2", publicKey, 1, "stale nonce")
+
+	scores := ps.PeerScores()
+	require.Equal(t, 3, len(scores))
+	assert.Equal(t, core.PeerScore{
+		Identifier:  elrondCore.PeerID("pid 1").Pretty(),
+		Type:        peerIDScoreType,
+		Score:       3,
+		LastReason:  "invalid message",
+		LastPenalty: currentTime,
+	}, scores[0])
+	assert.Equal(t, core.PeerScore{
+		Identifier:  elrondCore.PeerID("pid 2").Pretty(),
+		Type:        peerIDScoreType,
+		Score:       1,
+		NumBans:     1,
+		LastReason:  "stale nonce",
+		LastPenalty: currentTime,
+		BannedUntil: currentTime.Add(args.BaseBanDuration),
+	}, scores[1])
+	assert.Equal(t, core.PeerScore{
+		Identifier:  data.NewAddressFromBytes(publicKey).AddressAsBech32String(),
+		Type:        publicKeyScoreType,
+		Score:       1,
+		NumBans:     1,
+		LastReason:  "stale nonce",
+		LastPenalty: currentTime,
+		BannedUntil: currentTime.Add(args.BaseBanDuration),
+	}, scores[2])
+
+	currentTime = currentTime.Add(args.ScoreRetention + time.Second)
+	assert.Equal(t, 0, len(ps.PeerScores()))
+}
@@ -59,6 +59,11 @@ func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, from
 		return nil, err
 	}
 
+	err = checkVersion(msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	pk, err := rmh.keyGen.PublicKeyFromByteArray(msg.PublicKeyBytes)
 	if err != nil {
 		return nil, err
@@ -93,6 +98,16 @@ func checkLengths(msg *core.SignedMessage) error {
 	return nil
 }
 
+// checkVersion rejects the messages produced by newer relayers using a format this relayer does not know yet.
+// The version is not part of the signed data so the legacy relayers can still verify the messages
+func checkVersion(version uint32) error {
+	if version > core.MessagesVersion {
+		return fmt.Errorf("%w %d, maximum supported %d", ErrUnsupportedMessageVersion, version, core.MessagesVersion)
+	}
+
+	return nil
+}
+
 // createMessage will create a new message ready to be broadcast
 func (rmh *relayerMessageHandler) createMessage(payload []byte) (*core.SignedMessage, error) {
 	nonce := atomic.AddUint64(&rmh.counter, 1)
@@ -107,6 +122,7 @@ func (rmh *relayerMessageHandler) createMessage(payload []byte) (*core.SignedMes
 	}
 
 	return &core.SignedMessage{
+		Version:        core.MessagesVersion,
 		Payload:        payload,
 		PublicKeyBytes: rmh.publicKeyBytes,
 		Signature:      sig,
@@ -117,6 +117,7 @@ func TestRelayerMessageHandler_preProcess(t *testing.T) {
 
 	t.Run("preProcess errors if unmarshal fails", preProcessUnmarshal)
 	t.Run("preProcess errors if fields lengths exceeds the limit", preProcessLimits)
+	t.Run("preProcess errors if the version is not supported", preProcessUnsupportedVersion)
 	t.Run("preProcess errors if keygen fails", preProcessKeygenFails)
 	t.Run("preProcess errors if verify fails", preProcessVerifyFails)
 	t.Run("preProcess should work", preProcessShouldWork)
@@ -261,6 +262,34 @@ func preProcessVerifyFails(t *testing.T) {
 	assert.True(t, strings.Contains(reason, "unverifiable signature"))
 }
 
+func preProcessUnsupportedVersion(t *testing.T) {
+	msg := &core.SignedMessage{
+		Version:        core.MessagesVersion + 1,
+		Payload:        []byte("payload"),
+		PublicKeyBytes: []byte("pk"),
+		Signature:      []byte("sig"),
+		Nonce:          34,
+	}
+	buff, _ := marshalizer.Marshal(msg)
+
+	rmh := &relayerMessageHandler{
+		marshalizer: &testsCommon.MarshalizerMock{},
+		keyGen: &cryptoMocks.KeyGenStub{
+			PublicKeyFromByteArrayStub: func(b []byte) (crypto.PublicKey, error) {
+				assert.Fail(t, "should have not called PublicKeyFromByteArray")
+				return nil, nil
+			},
+		},
+	}
+
+	p2pmsg := &p2pMocks.P2PMessageMock{
+		DataField: buff,
+	}
+
+	_, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	assert.True(t, errors.Is(err, ErrUnsupportedMessageVersion))
+}
+
 func preProcessShouldWork(t *testing.T) {
 	originalMsg, buff := createSignedMessageAndMarshaledBytes(0)
 	nonceBytes := make([]byte, 8)
@@ -335,6 +364,7 @@ func TestRelayerMessageHandler_createMessage(t *testing.T) {
 
 		msg, err := rmh.createMessage(payload)
 		expectedMsg := &core.SignedMessage{
+			Version:        core.MessagesVersion,
 			Payload:        payload,
 			PublicKeyBytes: rmh.publicKeyBytes,
 			Signature:      sig,
@@ -347,6 +377,7 @@ func TestRelayerMessageHandler_createMessage(t *testing.T) {
 		counter++
 		msg, err = rmh.createMessage(payload)
 		expectedMsg = &core.SignedMessage{
+			Version:        core.MessagesVersion,
 			Payload:        payload,
 			PublicKeyBytes: rmh.publicKeyBytes,
 			Signature:      sig,
@@ -0,0 +1,71 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+)
+
+type signaturesRequests struct {
+	mut               sync.Mutex
+	pendingRequests   map[string]time.Time
+	lastResponses     map[string]time.Time
+	responsesInterval time.Duration
+	requestTimeout    time.Duration
+	getTimeHandler    func() time.Time
+}
+
+func newSignaturesRequests(responsesInterval time.Duration, requestTimeout time.Duration) *signaturesRequests {
+	return &signaturesRequests{
+		pendingRequests:   make(map[string]time.Time),
+		lastResponses:     make(map[string]time.Time),
+		responsesInterval: responsesInterval,
+		requestTimeout:    requestTimeout,
+		getTimeHandler:    time.Now,
+	}
+}
+
+func (holder *signaturesRequests) addPendingRequest(messageHash []byte) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	now := holder.getTimeHandler()
+	holder.removeExpiredRequests(now)
+	holder.pendingRequests[string(messageHash)] = now
+}
+
+// isPendingRequest returns true if this relayer requested the signatures of the provided message hash and
+// the request did not expire yet
+func (holder *signaturesRequests) isPendingRequest(messageHash []byte) bool {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	holder.removeExpiredRequests(holder.getTimeHandler())
+	_, found := holder.pendingRequests[string(messageHash)]
+
+	return found
+}
+
+func (holder *signaturesRequests) removeExpiredRequests(now time.Time) {
+	for messageHash, requestTime := range holder.pendingRequests {
+		if now.Sub(requestTime) > holder.requestTimeout {
+			delete(holder.pendingRequests, messageHash)
+		}
+	}
+}
+
+// canRespond returns true if the relayer with the provided public key did not receive a response in the last
+// responses interval. A positive answer counts as a response
+func (holder *signaturesRequests) canRespond(publicKey []byte) bool {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	now := holder.getTimeHandler()
+	lastResponse, found := holder.lastResponses[string(publicKey)]
+	if found && now.Sub(lastResponse) < holder.responsesInterval {
+		return false
+	}
+
+	holder.lastResponses[string(publicKey)] = now
+
+	return true
+}
@@ -0,0 +1,16 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// DisabledStepsWatchdog implementation in case no steps watchdog is used
+type DisabledStepsWatchdog struct{}
+
+// CheckStep returns false
+func (dsw *DisabledStepsWatchdog) CheckStep(_ core.StepIdentifier) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsw *DisabledStepsWatchdog) IsInterfaceNil() bool {
+	return dsw == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledStepsWatchdog_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := &DisabledStepsWatchdog{}
+	assert.False(t, check.IfNil(disabled))
+	assert.False(t, disabled.CheckStep("step"))
+}
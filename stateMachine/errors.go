@@ -16,3 +16,36 @@ var ErrNilLogger = errors.New("nil logger")
 
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilStepsWatchdog signals that a nil steps watchdog was provided
+var ErrNilStepsWatchdog = errors.New("nil steps watchdog")
+
+// ErrNilBatchInfoProvider signals that a nil batch info provider was provided
+var ErrNilBatchInfoProvider = errors.New("nil batch info provider")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrTransitionsHistoryExists signals that the transitions history of a state machine was already added
+var ErrTransitionsHistoryExists = errors.New("transitions history already exists")
+
+// ErrMissingTransitionsHistory signals that the transitions history of a state machine was not found
+var ErrMissingTransitionsHistory = errors.New("missing transitions history")
+
+// ErrNilTransitionsHistoryProvider signals that a nil transitions history provider was provided
+var ErrNilTransitionsHistoryProvider = errors.New("nil transitions history provider")
+
+// ErrUndeclaredTargetStep signals that a step declared a transition towards an unknown step
+var ErrUndeclaredTargetStep = errors.New("undeclared target step")
+
+// ErrUnreachableStep signals that a step can not be reached from the start step using the declared transitions
+var ErrUnreachableStep = errors.New("unreachable step")
+
+// ErrUndeclaredTransition signals that a step returned a next step it did not declare as an allowed transition
+var ErrUndeclaredTransition = errors.New("undeclared transition")
+
+// ErrUnknownDiagramFormat signals that an unknown diagram format was provided
+var ErrUnknownDiagramFormat = errors.New("unknown diagram format")
@@ -0,0 +1,19 @@
+package stateMachine
+
+import (
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// StepsWatchdog defines the operations of a component able to detect a state machine stuck in a step
+type StepsWatchdog interface {
+	CheckStep(identifier core.StepIdentifier) bool
+	IsInterfaceNil() bool
+}
+
+// BatchInfoProvider defines the component able to provide the batch and the action ID currently processed
+type BatchInfoProvider interface {
+	GetStoredBatch() *clients.TransferBatch
+	GetStoredActionID() uint64
+	IsInterfaceNil() bool
+}
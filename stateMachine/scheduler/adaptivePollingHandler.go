@@ -0,0 +1,241 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	minimumInterval   = time.Millisecond
+	backoffMultiplier = 2
+)
+
+// ArgsAdaptivePollingHandler is the DTO used in the adaptive polling handler constructor
+type ArgsAdaptivePollingHandler struct {
+	Log                  logger.Logger
+	Name                 string
+	Executor             HintedExecutor
+	FastInterval         time.Duration
+	RegularInterval      time.Duration
+	IdleInterval         time.Duration
+	ErrorInitialInterval time.Duration
+	ErrorMaxInterval     time.Duration
+}
+
+// Intervals holds the durations used by the adaptive polling handler between two consecutive executions
+type Intervals struct {
+	Fast         time.Duration
+	Regular      time.Duration
+	Idle         time.Duration
+	ErrorInitial time.Duration
+	ErrorMax     time.Duration
+}
+
+// adaptivePollingHandler represents the component able to call the executor continuously until the call to Close
+// is done. The interval between two consecutive calls depends on the outcome of the last execution: fast while
+// the state machine makes progress, slow when idle and exponentially increasing on repeated errors
+type adaptivePollingHandler struct {
+	log      logger.Logger
+	name     string
+	executor HintedExecutor
+
+	mutIntervals sync.RWMutex
+	intervals    Intervals
+
+	mutState             sync.RWMutex
+	cancel               func()
+	numConsecutiveErrors int
+}
+
+// NewAdaptivePollingHandler will create a new adaptive polling handler instance
+func NewAdaptivePollingHandler(args ArgsAdaptivePollingHandler) (*adaptivePollingHandler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adaptivePollingHandler{
+		log:       args.Log,
+		name:      args.Name,
+		executor:  args.Executor,
+		intervals: intervalsFromArgs(args),
+	}, nil
+}
+
+func intervalsFromArgs(args ArgsAdaptivePollingHandler) Intervals {
+	return Intervals{
+		Fast:         args.FastInterval,
+		Regular:      args.RegularInterval,
+		Idle:         args.IdleInterval,
+		ErrorInitial: args.ErrorInitialInterval,
+		ErrorMax:     args.ErrorMaxInterval,
+	}
+}
+
+func checkArgs(args ArgsAdaptivePollingHandler) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Executor) {
+		return ErrNilExecutor
+	}
+
+	return checkIntervals(intervalsFromArgs(args))
+}
+
+func checkIntervals(intervals Intervals) error {
+	if intervals.Fast < minimumInterval {
+		return fmt.Errorf("%w for FastInterval", ErrInvalidValue)
+	}
+	if intervals.Regular < intervals.Fast {
+		return fmt.Errorf("%w for RegularInterval, should be at least FastInterval", ErrInvalidValue)
+	}
+	if intervals.Idle < intervals.Regular {
+		return fmt.Errorf("%w for IdleInterval, should be at least RegularInterval", ErrInvalidValue)
+	}
+	if intervals.ErrorInitial < minimumInterval {
+		return fmt.Errorf("%w for ErrorInitialInterval", ErrInvalidValue)
+	}
+	if intervals.ErrorMax < intervals.ErrorInitial {
+		return fmt.Errorf("%w for ErrorMaxInterval, should be at least ErrorInitialInterval", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// SetIntervals validates and applies new intervals, used starting with the next execution
+func (aph *adaptivePollingHandler) SetIntervals(intervals Intervals) error {
+	err := checkIntervals(intervals)
+	if err != nil {
+		return err
+	}
+
+	aph.mutIntervals.Lock()
+	aph.intervals = intervals
+	aph.mutIntervals.Unlock()
+
+	aph.log.Info("adaptivePollingHandler intervals changed", "name", aph.name,
+		"fast", intervals.Fast, "regular", intervals.Regular, "idle", intervals.Idle,
+		"error initial", intervals.ErrorInitial, "error max", intervals.ErrorMax)
+
+	return nil
+}
+
+func (aph *adaptivePollingHandler) getIntervals() Intervals {
+	aph.mutIntervals.RLock()
+	defer aph.mutIntervals.RUnlock()
+
+	return aph.intervals
+}
+
+// StartProcessingLoop will start the processing loop
+func (aph *adaptivePollingHandler) StartProcessingLoop() error {
+	aph.mutState.Lock()
+	defer aph.mutState.Unlock()
+
+	if aph.cancel != nil {
+		return ErrLoopAlreadyStarted
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	aph.cancel = cancel
+
+	go aph.processLoop(ctx)
+
+	return nil
+}
+
+func (aph *adaptivePollingHandler) processLoop(ctx context.Context) {
+	defer aph.cleanup()
+
+	for {
+		err := aph.executor.Execute(ctx)
+		hint := aph.executor.LastStepHint()
+		if err != nil {
+			aph.log.Error("error in adaptivePollingHandler.processLoop",
+				"name", aph.name, "error", err)
+		}
+
+		interval := aph.computeInterval(err, hint)
+		aph.log.Trace("adaptivePollingHandler.processLoop", "name", aph.name, "hint", hint, "next tick in", interval)
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			aph.log.Debug("adaptivePollingHandler's processing loop is closing...",
+				"name", aph.name)
+			return
+		}
+	}
+}
+
+// computeInterval returns the duration until the next execution based on the last execution's outcome
+func (aph *adaptivePollingHandler) computeInterval(err error, hint core.StepHint) time.Duration {
+	intervals := aph.getIntervals()
+	if err != nil || hint == core.StepHintClientError {
+		aph.numConsecutiveErrors++
+		return aph.computeBackoffInterval(intervals)
+	}
+
+	aph.numConsecutiveErrors = 0
+	switch hint {
+	case core.StepHintProgress, core.StepHintRetrySoon:
+		return intervals.Fast
+	case core.StepHintNothingToDo:
+		return intervals.Idle
+	default:
+		return intervals.Regular
+	}
+}
+
+func (aph *adaptivePollingHandler) computeBackoffInterval(intervals Intervals) time.Duration {
+	interval := intervals.ErrorInitial
+	for i := 1; i < aph.numConsecutiveErrors; i++ {
+		interval *= backoffMultiplier
+		if interval >= intervals.ErrorMax {
+			return intervals.ErrorMax
+		}
+	}
+
+	return interval
+}
+
+func (aph *adaptivePollingHandler) cleanup() {
+	aph.mutState.Lock()
+	defer aph.mutState.Unlock()
+
+	aph.cancel = nil
+}
+
+// IsRunning returns true if the processing loop is running
+func (aph *adaptivePollingHandler) IsRunning() bool {
+	aph.mutState.RLock()
+	defer aph.mutState.RUnlock()
+
+	return aph.cancel != nil
+}
+
+// Close will close any containing members and clean any go routines associated
+func (aph *adaptivePollingHandler) Close() error {
+	aph.mutState.RLock()
+	defer aph.mutState.RUnlock()
+
+	if aph.cancel != nil {
+		aph.cancel()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aph *adaptivePollingHandler) IsInterfaceNil() bool {
+	return aph == nil
+}
@@ -0,0 +1,262 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	stateMachineMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/stateMachine"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsAdaptivePollingHandler() ArgsAdaptivePollingHandler {
+	return ArgsAdaptivePollingHandler{
+		Log:                  &testsCommon.LoggerStub{},
+		Name:                 "test",
+		Executor:             &stateMachineMocks.HintedExecutorStub{},
+		FastInterval:         time.Second,
+		RegularInterval:      time.Second * 12,
+		IdleInterval:         time.Second * 30,
+		ErrorInitialInterval: time.Second * 5,
+		ErrorMaxInterval:     time.Second * 60,
+	}
+}
+
+func TestNewAdaptivePollingHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.Log = nil
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.Executor = nil
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.Equal(t, ErrNilExecutor, err)
+	})
+	t.Run("invalid fast interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.FastInterval = 0
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "FastInterval")
+	})
+	t.Run("regular interval lower than fast interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.RegularInterval = args.FastInterval - 1
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "RegularInterval")
+	})
+	t.Run("idle interval lower than regular interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.IdleInterval = args.RegularInterval - 1
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "IdleInterval")
+	})
+	t.Run("invalid error initial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.ErrorInitialInterval = 0
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "ErrorInitialInterval")
+	})
+	t.Run("error max interval lower than error initial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		args.ErrorMaxInterval = args.ErrorInitialInterval - 1
+		aph, err := NewAdaptivePollingHandler(args)
+
+		assert.True(t, check.IfNil(aph))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "ErrorMaxInterval")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		aph, err := NewAdaptivePollingHandler(createMockArgsAdaptivePollingHandler())
+
+		assert.False(t, check.IfNil(aph))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAdaptivePollingHandler_ComputeInterval(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hints should select the interval", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		aph, _ := NewAdaptivePollingHandler(args)
+
+		assert.Equal(t, args.FastInterval, aph.computeInterval(nil, core.StepHintProgress))
+		assert.Equal(t, args.FastInterval, aph.computeInterval(nil, core.StepHintRetrySoon))
+		assert.Equal(t, args.RegularInterval, aph.computeInterval(nil, core.StepHintWaiting))
+		assert.Equal(t, args.RegularInterval, aph.computeInterval(nil, core.StepHintNone))
+		assert.Equal(t, args.IdleInterval, aph.computeInterval(nil, core.StepHintNothingToDo))
+	})
+	t.Run("repeated errors should back off exponentially", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		aph, _ := NewAdaptivePollingHandler(args)
+
+		assert.Equal(t, time.Second*5, aph.computeInterval(expectedErr, core.StepHintProgress))
+		assert.Equal(t, time.Second*10, aph.computeInterval(nil, core.StepHintClientError))
+		assert.Equal(t, time.Second*20, aph.computeInterval(expectedErr, core.StepHintNone))
+		assert.Equal(t, time.Second*40, aph.computeInterval(nil, core.StepHintClientError))
+		assert.Equal(t, time.Second*60, aph.computeInterval(nil, core.StepHintClientError))
+		assert.Equal(t, time.Second*60, aph.computeInterval(nil, core.StepHintClientError))
+
+		// a successful execution resets the backoff
+		assert.Equal(t, args.IdleInterval, aph.computeInterval(nil, core.StepHintNothingToDo))
+		assert.Equal(t, time.Second*5, aph.computeInterval(nil, core.StepHintClientError))
+	})
+}
+
+func TestAdaptivePollingHandler_SetIntervals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid intervals should error and keep the old ones", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		aph, _ := NewAdaptivePollingHandler(args)
+
+		err := aph.SetIntervals(Intervals{
+			Fast:         time.Second * 2,
+			Regular:      time.Second,
+			Idle:         time.Second * 30,
+			ErrorInitial: time.Second,
+			ErrorMax:     time.Second,
+		})
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Equal(t, args.FastInterval, aph.computeInterval(nil, core.StepHintProgress))
+	})
+	t.Run("should apply the new intervals", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAdaptivePollingHandler()
+		aph, _ := NewAdaptivePollingHandler(args)
+
+		intervals := Intervals{
+			Fast:         time.Second * 2,
+			Regular:      time.Second * 6,
+			Idle:         time.Second * 60,
+			ErrorInitial: time.Second * 3,
+			ErrorMax:     time.Second * 5,
+		}
+		err := aph.SetIntervals(intervals)
+		assert.Nil(t, err)
+		assert.Equal(t, intervals.Fast, aph.computeInterval(nil, core.StepHintProgress))
+		assert.Equal(t, intervals.Regular, aph.computeInterval(nil, core.StepHintWaiting))
+		assert.Equal(t, intervals.Idle, aph.computeInterval(nil, core.StepHintNothingToDo))
+		assert.Equal(t, intervals.ErrorInitial, aph.computeInterval(expectedErr, core.StepHintNone))
+		assert.Equal(t, intervals.ErrorMax, aph.computeInterval(expectedErr, core.StepHintNone))
+	})
+}
+
+func TestAdaptivePollingHandler_StartProcessingLoop(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := uint32(0)
+	args := createMockArgsAdaptivePollingHandler()
+	args.FastInterval = time.Millisecond
+	args.RegularInterval = time.Hour
+	args.IdleInterval = time.Hour
+	args.Executor = &stateMachineMocks.HintedExecutorStub{
+		ExecuteCalled: func(ctx context.Context) error {
+			atomic.AddUint32(&numExecutions, 1)
+			return nil
+		},
+		LastStepHintCalled: func() core.StepHint {
+			if atomic.LoadUint32(&numExecutions) < 5 {
+				return core.StepHintProgress
+			}
+
+			return core.StepHintNothingToDo
+		},
+	}
+	aph, _ := NewAdaptivePollingHandler(args)
+
+	err := aph.StartProcessingLoop()
+	assert.Nil(t, err)
+	assert.Equal(t, ErrLoopAlreadyStarted, aph.StartProcessingLoop())
+
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, uint32(5), atomic.LoadUint32(&numExecutions))
+	assert.True(t, aph.IsRunning())
+
+	_ = aph.Close()
+	time.Sleep(time.Millisecond * 50)
+	assert.False(t, aph.IsRunning())
+}
+
+func TestAdaptivePollingHandler_SlowExecutionShouldNotTriggerAnExtraTick(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := uint32(0)
+	args := createMockArgsAdaptivePollingHandler()
+	args.FastInterval = time.Millisecond * 10
+	args.RegularInterval = time.Millisecond * 20
+	args.IdleInterval = time.Hour
+	args.Executor = &stateMachineMocks.HintedExecutorStub{
+		ExecuteCalled: func(ctx context.Context) error {
+			if atomic.AddUint32(&numExecutions, 1) == 1 {
+				// the first execution takes longer than the regular interval
+				time.Sleep(time.Millisecond * 100)
+			}
+			return nil
+		},
+		LastStepHintCalled: func() core.StepHint {
+			return core.StepHintNothingToDo
+		},
+	}
+	aph, _ := NewAdaptivePollingHandler(args)
+
+	err := aph.StartProcessingLoop()
+	require.Nil(t, err)
+
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numExecutions))
+
+	_ = aph.Close()
+}
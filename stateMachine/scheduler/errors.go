@@ -0,0 +1,15 @@
+package scheduler
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilExecutor signals that a nil executor was provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrLoopAlreadyStarted signals that the processing loop was already started
+var ErrLoopAlreadyStarted = errors.New("loop already started")
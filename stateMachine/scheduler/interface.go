@@ -0,0 +1,14 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// HintedExecutor defines an executor able to provide the scheduling hint resulted from its last execution
+type HintedExecutor interface {
+	Execute(ctx context.Context) error
+	LastStepHint() core.StepHint
+	IsInterfaceNil() bool
+}
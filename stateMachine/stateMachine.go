@@ -3,12 +3,17 @@ package stateMachine
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
+const minHistorySize = 1
+
 // ArgsStateMachine represents the state machine arguments
 type ArgsStateMachine struct {
 	StateMachineName     string
@@ -16,14 +21,32 @@ type ArgsStateMachine struct {
 	StartStateIdentifier core.StepIdentifier
 	Log                  logger.Logger
 	StatusHandler        core.StatusHandler
+	StepsWatchdog        StepsWatchdog
+	BatchInfoProvider    BatchInfoProvider
+	Timer                core.Timer
+	HistorySize          int
 }
 
 type stateMachine struct {
-	stateMachineName string
-	steps            core.MachineStates
-	currentStep      core.Step
-	log              logger.Logger
-	statusHandler    core.StatusHandler
+	stateMachineName    string
+	steps               core.MachineStates
+	startStepIdentifier core.StepIdentifier
+	currentStep         core.Step
+	log                 logger.Logger
+	statusHandler       core.StatusHandler
+	stepsWatchdog       StepsWatchdog
+	batchInfoProvider   BatchInfoProvider
+	timer               core.Timer
+	history             *transitionsHistory
+	stepEnteredAt       time.Time
+	transitions         transitionsGraph
+	lastStepHint        core.StepHint
+	executionSlot       chan struct{}
+	draining            uint32
+	paused              uint32
+	mutTimes            sync.RWMutex
+	lastExecution       time.Time
+	lastProgress        time.Time
 }
 
 // NewStateMachine creates a state machine able to execute all provided steps
@@ -34,15 +57,28 @@ func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 	}
 
 	sm := &stateMachine{
-		stateMachineName: args.StateMachineName,
-		steps:            args.Steps,
-		log:              args.Log,
-		statusHandler:    args.StatusHandler,
+		stateMachineName:    args.StateMachineName,
+		steps:               args.Steps,
+		startStepIdentifier: args.StartStateIdentifier,
+		log:                 args.Log,
+		statusHandler:       args.StatusHandler,
+		stepsWatchdog:       args.StepsWatchdog,
+		batchInfoProvider:   args.BatchInfoProvider,
+		timer:               args.Timer,
+		history:             newTransitionsHistory(args.HistorySize),
+		executionSlot:       make(chan struct{}, 1),
 	}
 	sm.currentStep, err = sm.getNextStep(args.StartStateIdentifier)
 	if err != nil {
 		return nil, err
 	}
+	sm.transitions, err = createTransitionsGraph(args.Steps, args.StartStateIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	sm.stepEnteredAt = sm.now()
+	sm.lastExecution = sm.stepEnteredAt
+	sm.lastProgress = sm.stepEnteredAt
 
 	return sm, nil
 }
@@ -62,23 +98,216 @@ func checkArgs(args ArgsStateMachine) error {
 	if check.IfNil(args.StatusHandler) {
 		return ErrNilStatusHandler
 	}
+	if check.IfNil(args.StepsWatchdog) {
+		return ErrNilStepsWatchdog
+	}
+	if check.IfNil(args.BatchInfoProvider) {
+		return ErrNilBatchInfoProvider
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	if args.HistorySize < minHistorySize {
+		return fmt.Errorf("%w for HistorySize, got: %d, minimum: %d", ErrInvalidValue, args.HistorySize, minHistorySize)
+	}
 
 	return nil
 }
 
-// Execute will execute one step
+// now returns the current time as provided by the timer, with a precision of one second
+func (sm *stateMachine) now() time.Time {
+	return time.Unix(sm.timer.NowUnix(), 0)
+}
+
+// Execute will execute one step. After the drain started, no step will be executed anymore
 func (sm *stateMachine) Execute(ctx context.Context) error {
+	select {
+	case sm.executionSlot <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() {
+		<-sm.executionSlot
+	}()
+
+	sm.mutTimes.Lock()
+	sm.lastExecution = sm.now()
+	sm.mutTimes.Unlock()
+
+	if sm.isDraining() {
+		sm.log.Debug(fmt.Sprintf("%s: draining, step not executed", sm.stateMachineName),
+			"step", sm.currentStep.Identifier())
+		return nil
+	}
+	if sm.IsPaused() {
+		sm.log.Debug(fmt.Sprintf("%s: paused, step not executed", sm.stateMachineName),
+			"step", sm.currentStep.Identifier())
+		sm.lastStepHint = core.StepHintNothingToDo
+		return nil
+	}
+
 	return sm.executeStep(ctx)
 }
 
+// Pause stops the execution of new steps until Resume is called. The step in progress, if any, is not interrupted
+func (sm *stateMachine) Pause() {
+	atomic.StoreUint32(&sm.paused, 1)
+	sm.statusHandler.SetStringMetric(core.MetricStateMachinePaused, "true")
+	sm.log.Info(fmt.Sprintf("%s: paused", sm.stateMachineName))
+}
+
+// Resume restarts the execution of steps after a Pause call
+func (sm *stateMachine) Resume() {
+	atomic.StoreUint32(&sm.paused, 0)
+	sm.statusHandler.SetStringMetric(core.MetricStateMachinePaused, "false")
+	sm.log.Info(fmt.Sprintf("%s: resumed", sm.stateMachineName))
+}
+
+// IsPaused returns true if the state machine was paused
+func (sm *stateMachine) IsPaused() bool {
+	return atomic.LoadUint32(&sm.paused) == 1
+}
+
+// ForceStartStep waits for the step in progress, if any, to finish and then forces the start step as the current one.
+// It returns an error if the step in progress did not finish before the context ended
+func (sm *stateMachine) ForceStartStep(ctx context.Context) error {
+	select {
+	case sm.executionSlot <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("%w while forcing the start step of %s", ctx.Err(), sm.stateMachineName)
+	}
+	defer func() {
+		<-sm.executionSlot
+	}()
+
+	sm.log.Warn(fmt.Sprintf("%s: forcing the start step on request", sm.stateMachineName),
+		"current step", sm.currentStep.Identifier(), "start step", sm.startStepIdentifier)
+
+	startStep, err := sm.getNextStep(sm.startStepIdentifier)
+	if err != nil {
+		return err
+	}
+	sm.changeStep(startStep, true)
+	sm.stepsWatchdog.CheckStep(sm.startStepIdentifier)
+
+	return nil
+}
+
+// Drain stops the execution of new steps and waits for the step in progress, if any, to finish. It returns an
+// error if the step in progress did not finish before the context ended
+func (sm *stateMachine) Drain(ctx context.Context) error {
+	atomic.StoreUint32(&sm.draining, 1)
+	sm.log.Info(fmt.Sprintf("%s: draining, waiting for the step in progress", sm.stateMachineName))
+
+	select {
+	case sm.executionSlot <- struct{}{}:
+		<-sm.executionSlot
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w while draining %s", ctx.Err(), sm.stateMachineName)
+	}
+}
+
+func (sm *stateMachine) isDraining() bool {
+	return atomic.LoadUint32(&sm.draining) == 1
+}
+
 func (sm *stateMachine) executeStep(ctx context.Context) error {
+	err := sm.checkStuckStep()
+	if err != nil {
+		return err
+	}
+
 	sm.log.Debug(fmt.Sprintf("%s: executing step", sm.stateMachineName),
 		"step", sm.currentStep.Identifier())
 	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(sm.currentStep.Identifier()))
 	nextStepIdentifier := sm.currentStep.Execute(ctx)
+	sm.setLastStepHint(nextStepIdentifier)
+	if sm.currentStep.Identifier() == sm.startStepIdentifier {
+		// waiting for new batches on the start step is not considered a lack of progress
+		sm.markProgress(sm.now())
+	}
+	if !sm.transitions.isAllowed(sm.currentStep.Identifier(), nextStepIdentifier) {
+		return sm.handleUndeclaredTransition(nextStepIdentifier)
+	}
 
-	currentStep, err := sm.getNextStep(nextStepIdentifier)
-	sm.currentStep = currentStep
+	nextStep, err := sm.getNextStep(nextStepIdentifier)
+	if err != nil {
+		sm.currentStep = nil
+		return err
+	}
+	sm.changeStep(nextStep, false)
+
+	return nil
+}
+
+// changeStep sets the provided step as current and records the transition if the step actually changed
+func (sm *stateMachine) changeStep(nextStep core.Step, forced bool) {
+	previousStep := sm.currentStep
+	sm.currentStep = nextStep
+	if previousStep.Identifier() == nextStep.Identifier() {
+		return
+	}
+
+	now := sm.now()
+	transition := core.StepTransition{
+		From:             previousStep.Identifier(),
+		To:               nextStep.Identifier(),
+		Timestamp:        now.Unix(),
+		DurationInMillis: now.Sub(sm.stepEnteredAt).Milliseconds(),
+		ActionID:         sm.batchInfoProvider.GetStoredActionID(),
+		Forced:           forced,
+	}
+	batch := sm.batchInfoProvider.GetStoredBatch()
+	if batch != nil {
+		transition.BatchID = batch.ID
+	}
+
+	sm.history.add(transition)
+	sm.stepEnteredAt = now
+	sm.markProgress(now)
+}
+
+func (sm *stateMachine) markProgress(timestamp time.Time) {
+	sm.mutTimes.Lock()
+	sm.lastProgress = timestamp
+	sm.mutTimes.Unlock()
+}
+
+// setLastStepHint uses the hint provided by the executed step, if any, otherwise it derives it from the transition
+func (sm *stateMachine) setLastStepHint(nextStepIdentifier core.StepIdentifier) {
+	hint := core.StepHintNone
+	hintProvider, ok := sm.currentStep.(core.StepHintProvider)
+	if ok {
+		hint = hintProvider.ConsumeHint()
+	}
+
+	if hint == core.StepHintNone {
+		currentStepIdentifier := sm.currentStep.Identifier()
+		switch {
+		case nextStepIdentifier != currentStepIdentifier:
+			hint = core.StepHintProgress
+		case currentStepIdentifier == sm.startStepIdentifier:
+			hint = core.StepHintNothingToDo
+		default:
+			hint = core.StepHintWaiting
+		}
+	}
+
+	sm.lastStepHint = hint
+	sm.statusHandler.SetStringMetric(core.MetricLastStepHint, string(hint))
+}
+
+func (sm *stateMachine) handleUndeclaredTransition(nextStepIdentifier core.StepIdentifier) error {
+	err := fmt.Errorf("%w from '%s' to '%s'", ErrUndeclaredTransition, sm.currentStep.Identifier(), nextStepIdentifier)
+	sm.log.Error(fmt.Sprintf("%s: forcing the start step", sm.stateMachineName),
+		"error", err, "start step", sm.startStepIdentifier)
+
+	startStep, errStartStep := sm.getNextStep(sm.startStepIdentifier)
+	if errStartStep != nil {
+		return errStartStep
+	}
+	sm.changeStep(startStep, true)
 
 	return err
 }
@@ -92,6 +321,57 @@ func (sm *stateMachine) getNextStep(identifier core.StepIdentifier) (core.Step,
 	return nextStep, nil
 }
 
+func (sm *stateMachine) checkStuckStep() error {
+	shouldForceStartStep := sm.stepsWatchdog.CheckStep(sm.currentStep.Identifier())
+	if !shouldForceStartStep {
+		return nil
+	}
+
+	sm.log.Warn(fmt.Sprintf("%s: forcing the start step", sm.stateMachineName),
+		"stuck step", sm.currentStep.Identifier(), "start step", sm.startStepIdentifier)
+
+	startStep, err := sm.getNextStep(sm.startStepIdentifier)
+	if err != nil {
+		return err
+	}
+	sm.changeStep(startStep, true)
+	sm.stepsWatchdog.CheckStep(sm.startStepIdentifier)
+
+	return nil
+}
+
+// LastExecutionTime returns the time of the last Execute call, including the calls that did not execute a step
+// because the state machine was paused or draining
+func (sm *stateMachine) LastExecutionTime() time.Time {
+	sm.mutTimes.RLock()
+	defer sm.mutTimes.RUnlock()
+
+	return sm.lastExecution
+}
+
+// LastProgressTime returns the last time the state machine either changed its step or executed its start step
+func (sm *stateMachine) LastProgressTime() time.Time {
+	sm.mutTimes.RLock()
+	defer sm.mutTimes.RUnlock()
+
+	return sm.lastProgress
+}
+
+// LastStepHint returns the scheduling hint resulted from the last executed step
+func (sm *stateMachine) LastStepHint() core.StepHint {
+	return sm.lastStepHint
+}
+
+// Name returns the state machine's name
+func (sm *stateMachine) Name() string {
+	return sm.stateMachineName
+}
+
+// GetTransitionsHistory returns the most recent transitions between steps, the oldest one being the first
+func (sm *stateMachine) GetTransitionsHistory() []core.StepTransition {
+	return sm.history.getAll()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sm *stateMachine) IsInterfaceNil() bool {
 	return sm == nil
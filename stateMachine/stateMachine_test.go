@@ -3,13 +3,19 @@ package stateMachine_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	stateMachineMocks "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/stateMachine"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgs() stateMachine.ArgsStateMachine {
@@ -19,11 +25,21 @@ func createMockArgs() stateMachine.ArgsStateMachine {
 				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
 					return "mock"
 				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "mock"
+				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{"mock"}
+				},
 			},
 		},
 		StartStateIdentifier: "mock",
 		Log:                  logger.GetOrCreate("test"),
 		StatusHandler:        testsCommon.NewStatusHandlerMock("mock"),
+		StepsWatchdog:        &stateMachineMocks.StepsWatchdogStub{},
+		BatchInfoProvider:    &stateMachineMocks.BatchInfoProviderStub{},
+		Timer:                &testsCommon.TimerMock{},
+		HistorySize:          10,
 	}
 }
 
@@ -80,6 +96,66 @@ func TestNewStateMachine(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.True(t, errors.Is(err, stateMachine.ErrNilStatusHandler))
 	})
+	t.Run("nil steps watchdog", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StepsWatchdog = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilStepsWatchdog, err)
+	})
+	t.Run("nil batch info provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BatchInfoProvider = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilBatchInfoProvider, err)
+	})
+	t.Run("nil timer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Timer = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilTimer, err)
+	})
+	t.Run("invalid history size", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.HistorySize = 0
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrInvalidValue))
+	})
+	t.Run("undeclared target step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps["mock"] = createStepMock("mock", "missing")
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrUndeclaredTargetStep))
+	})
+	t.Run("unreachable step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps["orphan"] = createStepMock("orphan", "mock")
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrUnreachableStep))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -109,6 +185,9 @@ func TestExecute(t *testing.T) {
 				IdentifierCalled: func() core.StepIdentifier {
 					return providedIdentifier0
 				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{providedIdentifier1}
+				},
 			},
 			providedIdentifier1: &testsCommon.StepMock{
 				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
@@ -117,6 +196,9 @@ func TestExecute(t *testing.T) {
 				IdentifierCalled: func() core.StepIdentifier {
 					return providedIdentifier1
 				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{providedIdentifier2}
+				},
 			},
 			providedIdentifier2: &testsCommon.StepMock{
 				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
@@ -125,6 +207,9 @@ func TestExecute(t *testing.T) {
 				IdentifierCalled: func() core.StepIdentifier {
 					return providedIdentifier2
 				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{providedIdentifier2}
+				},
 			},
 		}
 		args.StartStateIdentifier = providedIdentifier0
@@ -144,4 +229,449 @@ func TestExecute(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, providedIdentifier2, sm.GetCurrentStepIdentifier())
 	})
+	t.Run("stuck step should force the start step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		startIdentifier := core.StepIdentifier("start")
+		stuckIdentifier := core.StepIdentifier("stuck")
+		args.Steps = map[core.StepIdentifier]core.Step{
+			startIdentifier: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					return stuckIdentifier
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return startIdentifier
+				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{stuckIdentifier}
+				},
+			},
+			stuckIdentifier: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					return stuckIdentifier
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return stuckIdentifier
+				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{stuckIdentifier}
+				},
+			},
+		}
+		args.StartStateIdentifier = startIdentifier
+		checkedSteps := make([]core.StepIdentifier, 0)
+		numStuckChecks := 0
+		args.StepsWatchdog = &stateMachineMocks.StepsWatchdogStub{
+			CheckStepCalled: func(identifier core.StepIdentifier) bool {
+				checkedSteps = append(checkedSteps, identifier)
+				if identifier != stuckIdentifier {
+					return false
+				}
+
+				numStuckChecks++
+				return numStuckChecks == 2
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, stuckIdentifier, sm.GetCurrentStepIdentifier())
+
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, stuckIdentifier, sm.GetCurrentStepIdentifier())
+
+		// the watchdog triggers: the start step should be executed instead of the stuck step
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, stuckIdentifier, sm.GetCurrentStepIdentifier())
+
+		expectedCheckedSteps := []core.StepIdentifier{startIdentifier, stuckIdentifier, stuckIdentifier, startIdentifier}
+		assert.Equal(t, expectedCheckedSteps, checkedSteps)
+
+		history := sm.GetTransitionsHistory()
+		require.Equal(t, 3, len(history))
+		assert.False(t, history[0].Forced)
+		assert.Equal(t, stuckIdentifier, history[1].From)
+		assert.Equal(t, startIdentifier, history[1].To)
+		assert.True(t, history[1].Forced)
+		assert.False(t, history[2].Forced)
+	})
+}
+
+func TestExecute_UndeclaredTransitionShouldForceTheStartStep(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Steps = core.MachineStates{
+		"step0": createStepMock("step0", "step1"),
+		"step1": &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				return "step2"
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return "step1"
+			},
+			AllowedTransitionsCalled: func() []core.StepIdentifier {
+				return []core.StepIdentifier{"step0"}
+			},
+		},
+		"step2": createStepMock("step2", "step0"),
+	}
+	args.Steps["step0"].(*testsCommon.StepMock).AllowedTransitionsCalled = func() []core.StepIdentifier {
+		return []core.StepIdentifier{"step1", "step2"}
+	}
+	args.StartStateIdentifier = "step0"
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	err := sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, core.StepIdentifier("step1"), sm.GetCurrentStepIdentifier())
+
+	err = sm.Execute(context.Background())
+	assert.True(t, errors.Is(err, stateMachine.ErrUndeclaredTransition))
+	assert.Equal(t, core.StepIdentifier("step0"), sm.GetCurrentStepIdentifier())
+
+	history := sm.GetTransitionsHistory()
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, core.StepIdentifier("step1"), history[1].From)
+	assert.Equal(t, core.StepIdentifier("step0"), history[1].To)
+	assert.True(t, history[1].Forced)
+}
+
+func TestStateMachine_GetTransitionsHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.StateMachineName = "test machine"
+	args.HistorySize = 3
+	numSteps := 4
+	args.Steps = make(core.MachineStates)
+	for i := 0; i < numSteps; i++ {
+		identifier := core.StepIdentifier(fmt.Sprintf("step%d", i))
+		nextIdentifier := core.StepIdentifier(fmt.Sprintf("step%d", (i+1)%numSteps))
+		args.Steps[identifier] = &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				return nextIdentifier
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return identifier
+			},
+			AllowedTransitionsCalled: func() []core.StepIdentifier {
+				return []core.StepIdentifier{nextIdentifier}
+			},
+		}
+	}
+	args.StartStateIdentifier = "step0"
+	batchID := uint64(0)
+	args.BatchInfoProvider = &stateMachineMocks.BatchInfoProviderStub{
+		GetStoredBatchCalled: func() *clients.TransferBatch {
+			if batchID == 0 {
+				return nil
+			}
+
+			return &clients.TransferBatch{
+				ID: batchID,
+			}
+		},
+		GetStoredActionIDCalled: func() uint64 {
+			return batchID * 10
+		},
+	}
+	currentTime := int64(1000)
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return currentTime
+	}
+	args.Timer = timer
+	sm, _ := stateMachine.NewStateMachine(args)
+	assert.Equal(t, "test machine", sm.Name())
+	assert.Empty(t, sm.GetTransitionsHistory())
+
+	currentTime += 3
+	_ = sm.Execute(context.Background())
+	history := sm.GetTransitionsHistory()
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, core.StepIdentifier("step0"), history[0].From)
+	assert.Equal(t, core.StepIdentifier("step1"), history[0].To)
+	assert.Equal(t, uint64(0), history[0].BatchID)
+	assert.Equal(t, int64(3000), history[0].DurationInMillis)
+	assert.Equal(t, int64(1003), history[0].Timestamp)
+
+	for i := 1; i <= 4; i++ {
+		batchID = uint64(i)
+		_ = sm.Execute(context.Background())
+	}
+
+	history = sm.GetTransitionsHistory()
+	require.Equal(t, 3, len(history))
+	expectedFrom := []core.StepIdentifier{"step2", "step3", "step0"}
+	for i, transition := range history {
+		assert.Equal(t, expectedFrom[i], transition.From)
+		assert.Equal(t, uint64(i+2), transition.BatchID)
+		assert.Equal(t, uint64(i+2)*10, transition.ActionID)
+	}
+}
+
+func createStepMock(identifier core.StepIdentifier, nextIdentifier core.StepIdentifier) *testsCommon.StepMock {
+	return &testsCommon.StepMock{
+		ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+			return nextIdentifier
+		},
+		IdentifierCalled: func() core.StepIdentifier {
+			return identifier
+		},
+		AllowedTransitionsCalled: func() []core.StepIdentifier {
+			return []core.StepIdentifier{nextIdentifier}
+		},
+	}
+}
+
+type hintedStepMock struct {
+	*testsCommon.StepMock
+	hint core.StepHint
+}
+
+func (step *hintedStepMock) ConsumeHint() core.StepHint {
+	return step.hint
+}
+
+func TestStateMachine_LastStepHint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("derived hints", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		waitingStep := createStepMock("waiting", "waiting")
+		args.Steps = core.MachineStates{
+			"start": &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					return "start"
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "start"
+				},
+				AllowedTransitionsCalled: func() []core.StepIdentifier {
+					return []core.StepIdentifier{"start", "waiting"}
+				},
+			},
+			"waiting": waitingStep,
+		}
+		args.StartStateIdentifier = "start"
+		statusHandler := testsCommon.NewStatusHandlerMock("mock")
+		args.StatusHandler = statusHandler
+		sm, _ := stateMachine.NewStateMachine(args)
+		assert.Equal(t, core.StepHintNone, sm.LastStepHint())
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, core.StepHintNothingToDo, sm.LastStepHint())
+		assert.Equal(t, string(core.StepHintNothingToDo), statusHandler.GetStringMetric(core.MetricLastStepHint))
+
+		args.Steps["start"].(*testsCommon.StepMock).ExecuteCalled = func(ctx context.Context) core.StepIdentifier {
+			return "waiting"
+		}
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, core.StepHintProgress, sm.LastStepHint())
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, core.StepHintWaiting, sm.LastStepHint())
+	})
+	t.Run("hint provided by the step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps = core.MachineStates{
+			"mock": &hintedStepMock{
+				StepMock: createStepMock("mock", "mock"),
+				hint:     core.StepHintClientError,
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, core.StepHintClientError, sm.LastStepHint())
+	})
+}
+
+func TestStateMachine_Drain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no step in progress", func(t *testing.T) {
+		t.Parallel()
+
+		numExecutions := 0
+		args := createMockArgs()
+		args.Steps["mock"].(*testsCommon.StepMock).ExecuteCalled = func(ctx context.Context) core.StepIdentifier {
+			numExecutions++
+			return "mock"
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numExecutions)
+
+		err = sm.Drain(context.Background())
+		assert.Nil(t, err)
+
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numExecutions)
+	})
+	t.Run("should wait for the step in progress", func(t *testing.T) {
+		t.Parallel()
+
+		stepStarted := make(chan struct{})
+		finishStep := make(chan struct{})
+		args := createMockArgs()
+		args.Steps["mock"].(*testsCommon.StepMock).ExecuteCalled = func(ctx context.Context) core.StepIdentifier {
+			close(stepStarted)
+			<-finishStep
+			return "mock"
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		go func() {
+			_ = sm.Execute(context.Background())
+		}()
+		<-stepStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		err := sm.Drain(ctx)
+		cancel()
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+		close(finishStep)
+		err = sm.Drain(context.Background())
+		assert.Nil(t, err)
+	})
+}
+
+func TestStateMachine_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	args := createMockArgs()
+	args.Steps["mock"].(*testsCommon.StepMock).ExecuteCalled = func(ctx context.Context) core.StepIdentifier {
+		numExecutions++
+		return "mock"
+	}
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	sm.Pause()
+	assert.True(t, sm.IsPaused())
+	assert.Equal(t, "true", statusHandler.GetStringMetric(core.MetricStateMachinePaused))
+
+	err := sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numExecutions)
+	assert.Equal(t, core.StepHintNothingToDo, sm.LastStepHint())
+
+	sm.Resume()
+	assert.False(t, sm.IsPaused())
+	assert.Equal(t, "false", statusHandler.GetStringMetric(core.MetricStateMachinePaused))
+
+	err = sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numExecutions)
+}
+
+func TestStateMachine_ForceStartStep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should force the start step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps = core.MachineStates{
+			"step1": createStepMock("step1", "step2"),
+			"step2": createStepMock("step2", "step1"),
+		}
+		args.StartStateIdentifier = "step1"
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		require.Nil(t, err)
+
+		err = sm.ForceStartStep(context.Background())
+		assert.Nil(t, err)
+
+		history := sm.GetTransitionsHistory()
+		require.Equal(t, 2, len(history))
+		assert.Equal(t, core.StepIdentifier("step2"), history[1].From)
+		assert.Equal(t, core.StepIdentifier("step1"), history[1].To)
+		assert.True(t, history[1].Forced)
+	})
+	t.Run("should wait for the step in progress", func(t *testing.T) {
+		t.Parallel()
+
+		stepStarted := make(chan struct{})
+		finishStep := make(chan struct{})
+		args := createMockArgs()
+		args.Steps["mock"].(*testsCommon.StepMock).ExecuteCalled = func(ctx context.Context) core.StepIdentifier {
+			close(stepStarted)
+			<-finishStep
+			return "mock"
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		go func() {
+			_ = sm.Execute(context.Background())
+		}()
+		<-stepStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		err := sm.ForceStartStep(ctx)
+		cancel()
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+		close(finishStep)
+		err = sm.ForceStartStep(context.Background())
+		assert.Nil(t, err)
+	})
+}
+
+func TestStateMachine_ExecutionAndProgressTimes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	waitingStep := createStepMock("waiting", "waiting")
+	args.Steps = core.MachineStates{
+		"start":   createStepMock("start", "waiting"),
+		"waiting": waitingStep,
+	}
+	args.StartStateIdentifier = "start"
+	currentTime := int64(1000)
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return atomic.LoadInt64(&currentTime)
+	}
+	args.Timer = timer
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	creationTime := sm.LastProgressTime()
+	assert.Equal(t, time.Unix(1000, 0), creationTime)
+	assert.Equal(t, creationTime, sm.LastExecutionTime())
+
+	atomic.AddInt64(&currentTime, 1)
+	_ = sm.Execute(context.Background())
+	progressTime := sm.LastProgressTime()
+	assert.True(t, progressTime.After(creationTime))
+
+	atomic.AddInt64(&currentTime, 1)
+	_ = sm.Execute(context.Background())
+	assert.Equal(t, progressTime, sm.LastProgressTime(), "waiting on a step other than the start one is not a progress")
+	assert.True(t, sm.LastExecutionTime().After(progressTime))
+
+	sm.Pause()
+	lastExecutionTime := sm.LastExecutionTime()
+	atomic.AddInt64(&currentTime, 1)
+	_ = sm.Execute(context.Background())
+	assert.True(t, sm.LastExecutionTime().After(lastExecutionTime))
+	assert.Equal(t, progressTime, sm.LastProgressTime())
 }
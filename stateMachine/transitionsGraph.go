@@ -0,0 +1,187 @@
+package stateMachine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// DiagramFormat defines the output format of a rendered transitions graph
+type DiagramFormat string
+
+const (
+	// MermaidFormat renders the transitions graph as a Mermaid state diagram
+	MermaidFormat DiagramFormat = "mermaid"
+	// GraphvizFormat renders the transitions graph as a Graphviz (dot) digraph
+	GraphvizFormat DiagramFormat = "graphviz"
+)
+
+type transitionsGraph map[core.StepIdentifier]map[core.StepIdentifier]struct{}
+
+// ValidateTransitions checks that the transitions declared by the provided steps form a closed graph
+// (all declared targets are known steps) and that every step is reachable from the start step
+func ValidateTransitions(steps core.MachineStates, startStepIdentifier core.StepIdentifier) error {
+	_, err := createTransitionsGraph(steps, startStepIdentifier)
+
+	return err
+}
+
+func createTransitionsGraph(steps core.MachineStates, startStepIdentifier core.StepIdentifier) (transitionsGraph, error) {
+	_, found := steps[startStepIdentifier]
+	if !found {
+		return nil, fmt.Errorf("%w for identifier '%s'", ErrStepNotFound, startStepIdentifier)
+	}
+
+	graph := make(transitionsGraph, len(steps))
+	for identifier, step := range steps {
+		targets := make(map[core.StepIdentifier]struct{})
+		for _, target := range step.AllowedTransitions() {
+			_, found = steps[target]
+			if !found {
+				return nil, fmt.Errorf("%w: step '%s' declares a transition to '%s'",
+					ErrUndeclaredTargetStep, identifier, target)
+			}
+			targets[target] = struct{}{}
+		}
+		graph[identifier] = targets
+	}
+
+	visited := map[core.StepIdentifier]struct{}{
+		startStepIdentifier: {},
+	}
+	queue := []core.StepIdentifier{startStepIdentifier}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for target := range graph[current] {
+			_, found = visited[target]
+			if found {
+				continue
+			}
+			visited[target] = struct{}{}
+			queue = append(queue, target)
+		}
+	}
+
+	for _, identifier := range sortedIdentifiers(steps) {
+		_, found = visited[identifier]
+		if !found {
+			return nil, fmt.Errorf("%w: step '%s' from start step '%s'", ErrUnreachableStep, identifier, startStepIdentifier)
+		}
+	}
+
+	return graph, nil
+}
+
+func (graph transitionsGraph) isAllowed(from core.StepIdentifier, to core.StepIdentifier) bool {
+	_, found := graph[from][to]
+
+	return found
+}
+
+// CreateTransitionsDiagram validates and renders the transitions graph of the provided steps in the required format
+func CreateTransitionsDiagram(
+	name string,
+	steps core.MachineStates,
+	startStepIdentifier core.StepIdentifier,
+	format DiagramFormat,
+) (string, error) {
+	graph, err := createTransitionsGraph(steps, startStepIdentifier)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case MermaidFormat:
+		return graph.toMermaid(name, startStepIdentifier), nil
+	case GraphvizFormat:
+		return graph.toGraphviz(name, startStepIdentifier), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownDiagramFormat, format)
+	}
+}
+
+func (graph transitionsGraph) toMermaid(name string, startStepIdentifier core.StepIdentifier) string {
+	ids := graph.nodeIDs()
+
+	builder := &strings.Builder{}
+	_, _ = fmt.Fprintf(builder, "---\ntitle: %s\n---\n", name)
+	builder.WriteString("stateDiagram-v2\n")
+	for _, identifier := range graph.sortedSteps() {
+		_, _ = fmt.Fprintf(builder, "    state \"%s\" as %s\n", identifier, ids[identifier])
+	}
+	_, _ = fmt.Fprintf(builder, "    [*] --> %s\n", ids[startStepIdentifier])
+	for _, from := range graph.sortedSteps() {
+		for _, to := range graph.sortedTargets(from) {
+			_, _ = fmt.Fprintf(builder, "    %s --> %s\n", ids[from], ids[to])
+		}
+	}
+
+	return builder.String()
+}
+
+func (graph transitionsGraph) toGraphviz(name string, startStepIdentifier core.StepIdentifier) string {
+	builder := &strings.Builder{}
+	_, _ = fmt.Fprintf(builder, "digraph %q {\n", name)
+	builder.WriteString("    start [shape=point];\n")
+	_, _ = fmt.Fprintf(builder, "    start -> %q;\n", startStepIdentifier)
+	for _, from := range graph.sortedSteps() {
+		_, _ = fmt.Fprintf(builder, "    %q;\n", from)
+	}
+	for _, from := range graph.sortedSteps() {
+		for _, to := range graph.sortedTargets(from) {
+			_, _ = fmt.Fprintf(builder, "    %q -> %q;\n", from, to)
+		}
+	}
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// nodeIDs generates Mermaid-safe node identifiers, as the step identifiers contain spaces
+func (graph transitionsGraph) nodeIDs() map[core.StepIdentifier]string {
+	ids := make(map[core.StepIdentifier]string, len(graph))
+	for index, identifier := range graph.sortedSteps() {
+		ids[identifier] = fmt.Sprintf("s%d", index)
+	}
+
+	return ids
+}
+
+func (graph transitionsGraph) sortedSteps() []core.StepIdentifier {
+	identifiers := make([]core.StepIdentifier, 0, len(graph))
+	for identifier := range graph {
+		identifiers = append(identifiers, identifier)
+	}
+	sortIdentifiers(identifiers)
+
+	return identifiers
+}
+
+func (graph transitionsGraph) sortedTargets(from core.StepIdentifier) []core.StepIdentifier {
+	identifiers := make([]core.StepIdentifier, 0, len(graph[from]))
+	for identifier := range graph[from] {
+		identifiers = append(identifiers, identifier)
+	}
+	sortIdentifiers(identifiers)
+
+	return identifiers
+}
+
+func sortedIdentifiers(steps core.MachineStates) []core.StepIdentifier {
+	identifiers := make([]core.StepIdentifier, 0, len(steps))
+	for identifier := range steps {
+		identifiers = append(identifiers, identifier)
+	}
+	sortIdentifiers(identifiers)
+
+	return identifiers
+}
+
+func sortIdentifiers(identifiers []core.StepIdentifier) {
+	sort.Slice(identifiers, func(i, j int) bool {
+		return identifiers[i] < identifiers[j]
+	})
+}
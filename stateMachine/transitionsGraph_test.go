@@ -0,0 +1,111 @@
+package stateMachine_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestSteps() core.MachineStates {
+	return core.MachineStates{
+		"get pending":  createStepMock("get pending", "perform"),
+		"perform":      createStepMock("perform", "get pending"),
+		"with a quote": createStepMock("with a quote", "get pending"),
+	}
+}
+
+func TestValidateTransitions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing start step", func(t *testing.T) {
+		t.Parallel()
+
+		err := stateMachine.ValidateTransitions(createTestSteps(), "missing")
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+	})
+	t.Run("undeclared target step", func(t *testing.T) {
+		t.Parallel()
+
+		steps := createTestSteps()
+		steps["perform"] = createStepMock("perform", "missing")
+		err := stateMachine.ValidateTransitions(steps, "get pending")
+		assert.True(t, errors.Is(err, stateMachine.ErrUndeclaredTargetStep))
+		assert.Contains(t, err.Error(), "missing")
+	})
+	t.Run("unreachable step", func(t *testing.T) {
+		t.Parallel()
+
+		err := stateMachine.ValidateTransitions(createTestSteps(), "get pending")
+		assert.True(t, errors.Is(err, stateMachine.ErrUnreachableStep))
+		assert.Contains(t, err.Error(), "with a quote")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		steps := createTestSteps()
+		steps["perform"].(*testsCommon.StepMock).AllowedTransitionsCalled = func() []core.StepIdentifier {
+			return []core.StepIdentifier{"get pending", "with a quote"}
+		}
+		err := stateMachine.ValidateTransitions(steps, "get pending")
+		assert.Nil(t, err)
+	})
+}
+
+func TestCreateTransitionsDiagram(t *testing.T) {
+	t.Parallel()
+
+	steps := core.MachineStates{
+		"get pending": createStepMock("get pending", "perform"),
+		"perform":     createStepMock("perform", "get pending"),
+	}
+
+	t.Run("invalid graph", func(t *testing.T) {
+		t.Parallel()
+
+		diagram, err := stateMachine.CreateTransitionsDiagram("test", steps, "missing", stateMachine.MermaidFormat)
+		assert.Empty(t, diagram)
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+	})
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		diagram, err := stateMachine.CreateTransitionsDiagram("test", steps, "get pending", "svg")
+		assert.Empty(t, diagram)
+		assert.True(t, errors.Is(err, stateMachine.ErrUnknownDiagramFormat))
+	})
+	t.Run("mermaid", func(t *testing.T) {
+		t.Parallel()
+
+		diagram, err := stateMachine.CreateTransitionsDiagram("test", steps, "get pending", stateMachine.MermaidFormat)
+		assert.Nil(t, err)
+
+		expected := "---\ntitle: test\n---\n" +
+			"stateDiagram-v2\n" +
+			"    state \"get pending\" as s0\n" +
+			"    state \"perform\" as s1\n" +
+			"    [*] --> s0\n" +
+			"    s0 --> s1\n" +
+			"    s1 --> s0\n"
+		assert.Equal(t, expected, diagram)
+	})
+	t.Run("graphviz", func(t *testing.T) {
+		t.Parallel()
+
+		diagram, err := stateMachine.CreateTransitionsDiagram("test", steps, "get pending", stateMachine.GraphvizFormat)
+		assert.Nil(t, err)
+
+		expected := "digraph \"test\" {\n" +
+			"    start [shape=point];\n" +
+			"    start -> \"get pending\";\n" +
+			"    \"get pending\";\n" +
+			"    \"perform\";\n" +
+			"    \"get pending\" -> \"perform\";\n" +
+			"    \"perform\" -> \"get pending\";\n" +
+			"}\n"
+		assert.Equal(t, expected, diagram)
+	})
+}
@@ -0,0 +1,52 @@
+package stateMachine
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// transitionsHistory is a bounded ring buffer holding the most recent transitions
+type transitionsHistory struct {
+	mut     sync.RWMutex
+	entries []core.StepTransition
+	next    int
+	full    bool
+}
+
+func newTransitionsHistory(size int) *transitionsHistory {
+	return &transitionsHistory{
+		entries: make([]core.StepTransition, size),
+	}
+}
+
+func (th *transitionsHistory) add(transition core.StepTransition) {
+	th.mut.Lock()
+	defer th.mut.Unlock()
+
+	th.entries[th.next] = transition
+	th.next++
+	if th.next == len(th.entries) {
+		th.next = 0
+		th.full = true
+	}
+}
+
+// getAll returns a copy of the stored transitions, the oldest one being the first
+func (th *transitionsHistory) getAll() []core.StepTransition {
+	th.mut.RLock()
+	defer th.mut.RUnlock()
+
+	if !th.full {
+		result := make([]core.StepTransition, th.next)
+		copy(result, th.entries[:th.next])
+
+		return result
+	}
+
+	result := make([]core.StepTransition, 0, len(th.entries))
+	result = append(result, th.entries[th.next:]...)
+	result = append(result, th.entries[:th.next]...)
+
+	return result
+}
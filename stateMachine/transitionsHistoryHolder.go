@@ -0,0 +1,75 @@
+package stateMachine
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+type transitionsHistoryHolder struct {
+	mut       sync.RWMutex
+	providers map[string]core.TransitionsHistoryProvider
+}
+
+// NewTransitionsHistoryHolder returns a new instance of the component able to hold the state machines transitions history
+func NewTransitionsHistoryHolder() *transitionsHistoryHolder {
+	return &transitionsHistoryHolder{
+		providers: make(map[string]core.TransitionsHistoryProvider),
+	}
+}
+
+// AddTransitionsHistoryProvider adds the new transitions history provider, if it does not exist
+func (holder *transitionsHistoryHolder) AddTransitionsHistoryProvider(provider core.TransitionsHistoryProvider) error {
+	if check.IfNil(provider) {
+		return ErrNilTransitionsHistoryProvider
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	name := provider.Name()
+	_, exists := holder.providers[name]
+	if exists {
+		return fmt.Errorf("%w for %s", ErrTransitionsHistoryExists, name)
+	}
+
+	holder.providers[name] = provider
+
+	return nil
+}
+
+// GetAvailableStateMachines returns a sorted list of all state machines names that have a transitions history
+func (holder *transitionsHistoryHolder) GetAvailableStateMachines() []string {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	names := make([]string, 0, len(holder.providers))
+	for name := range holder.providers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// GetTransitionsHistory returns the transitions history of the provided state machine
+func (holder *transitionsHistoryHolder) GetTransitionsHistory(name string) ([]core.StepTransition, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	provider, exists := holder.providers[name]
+	if !exists {
+		return nil, fmt.Errorf("%w for %s", ErrMissingTransitionsHistory, name)
+	}
+
+	return provider.GetTransitionsHistory(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *transitionsHistoryHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,87 @@
+package stateMachine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+type transitionsHistoryProviderStub struct {
+	name    string
+	history []core.StepTransition
+}
+
+func (stub *transitionsHistoryProviderStub) Name() string {
+	return stub.name
+}
+
+func (stub *transitionsHistoryProviderStub) GetTransitionsHistory() []core.StepTransition {
+	return stub.history
+}
+
+func (stub *transitionsHistoryProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewTransitionsHistoryHolder(t *testing.T) {
+	t.Parallel()
+
+	holder := NewTransitionsHistoryHolder()
+	assert.False(t, check.IfNil(holder))
+	assert.Empty(t, holder.GetAvailableStateMachines())
+}
+
+func TestTransitionsHistoryHolder_AddTransitionsHistoryProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := NewTransitionsHistoryHolder()
+		err := holder.AddTransitionsHistoryProvider(nil)
+		assert.Equal(t, ErrNilTransitionsHistoryProvider, err)
+	})
+	t.Run("duplicated provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := NewTransitionsHistoryHolder()
+		err := holder.AddTransitionsHistoryProvider(&transitionsHistoryProviderStub{name: "sm"})
+		assert.Nil(t, err)
+
+		err = holder.AddTransitionsHistoryProvider(&transitionsHistoryProviderStub{name: "sm"})
+		assert.True(t, errors.Is(err, ErrTransitionsHistoryExists))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder := NewTransitionsHistoryHolder()
+		_ = holder.AddTransitionsHistoryProvider(&transitionsHistoryProviderStub{name: "sm2"})
+		_ = holder.AddTransitionsHistoryProvider(&transitionsHistoryProviderStub{name: "sm1"})
+
+		assert.Equal(t, []string{"sm1", "sm2"}, holder.GetAvailableStateMachines())
+	})
+}
+
+func TestTransitionsHistoryHolder_GetTransitionsHistory(t *testing.T) {
+	t.Parallel()
+
+	history := []core.StepTransition{
+		{
+			From: "step1",
+			To:   "step2",
+		},
+	}
+	holder := NewTransitionsHistoryHolder()
+	_ = holder.AddTransitionsHistoryProvider(&transitionsHistoryProviderStub{name: "sm", history: history})
+
+	result, err := holder.GetTransitionsHistory("missing")
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrMissingTransitionsHistory))
+
+	result, err = holder.GetTransitionsHistory("sm")
+	assert.Nil(t, err)
+	assert.Equal(t, history, result)
+}
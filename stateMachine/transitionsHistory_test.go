@@ -0,0 +1,49 @@
+package stateMachine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTransitions(from int, to int) []core.StepTransition {
+	transitions := make([]core.StepTransition, 0, to-from)
+	for i := from; i < to; i++ {
+		transitions = append(transitions, core.StepTransition{
+			From:      core.StepIdentifier(fmt.Sprintf("step%d", i)),
+			To:        core.StepIdentifier(fmt.Sprintf("step%d", i+1)),
+			Timestamp: int64(i),
+		})
+	}
+
+	return transitions
+}
+
+func TestTransitionsHistory(t *testing.T) {
+	t.Parallel()
+
+	th := newTransitionsHistory(3)
+	assert.Empty(t, th.getAll())
+
+	transitions := createTransitions(0, 7)
+	th.add(transitions[0])
+	th.add(transitions[1])
+	assert.Equal(t, transitions[0:2], th.getAll())
+
+	th.add(transitions[2])
+	assert.Equal(t, transitions[0:3], th.getAll())
+
+	th.add(transitions[3])
+	assert.Equal(t, transitions[1:4], th.getAll())
+
+	th.add(transitions[4])
+	th.add(transitions[5])
+	th.add(transitions[6])
+	assert.Equal(t, transitions[4:7], th.getAll())
+
+	result := th.getAll()
+	result[0].From = "changed"
+	assert.Equal(t, transitions[4:7], th.getAll())
+}
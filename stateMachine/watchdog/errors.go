@@ -0,0 +1,21 @@
+package watchdog
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler was provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilAlertHandler signals that a nil alert handler was provided
+var ErrNilAlertHandler = errors.New("nil alert handler")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidAction signals that an invalid watchdog action was provided
+var ErrInvalidAction = errors.New("invalid action")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,179 @@
+package watchdog
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// Action defines what the watchdog does when a step exceeds its maximum dwell time
+type Action string
+
+const (
+	// AlertAction will only log and raise an alert when a step exceeds its maximum dwell time
+	AlertAction Action = "alert"
+
+	// ResetAction will log, raise an alert and force the state machine back into its start step when a step
+	// exceeds its maximum dwell time
+	ResetAction Action = "reset"
+)
+
+// ArgsStepsWatchdog is the DTO used for the creating a new steps watchdog instance
+type ArgsStepsWatchdog struct {
+	StateMachineName string
+	Log              logger.Logger
+	StatusHandler    core.StatusHandler
+	AlertHandler     core.AlertHandler
+	Timer            core.Timer
+	Action           Action
+	MaxDwellTimes    map[core.StepIdentifier]time.Duration
+}
+
+type stepsWatchdog struct {
+	stateMachineName string
+	log              logger.Logger
+	statusHandler    core.StatusHandler
+	alertHandler     core.AlertHandler
+	timer            core.Timer
+	action           Action
+	maxDwellTimes    map[core.StepIdentifier]time.Duration
+
+	mut            sync.RWMutex
+	currentStep    core.StepIdentifier
+	enteredAt      int64
+	breachReported bool
+	numEntries     map[core.StepIdentifier]int
+}
+
+// NewStepsWatchdog creates a new watchdog able to detect a state machine stuck in a step
+func NewStepsWatchdog(args ArgsStepsWatchdog) (*stepsWatchdog, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDwellTimes := make(map[core.StepIdentifier]time.Duration)
+	for identifier, maxDwellTime := range args.MaxDwellTimes {
+		maxDwellTimes[identifier] = maxDwellTime
+	}
+
+	return &stepsWatchdog{
+		stateMachineName: args.StateMachineName,
+		log:              args.Log,
+		statusHandler:    args.StatusHandler,
+		alertHandler:     args.AlertHandler,
+		timer:            args.Timer,
+		action:           args.Action,
+		maxDwellTimes:    maxDwellTimes,
+		numEntries:       make(map[core.StepIdentifier]int),
+	}, nil
+}
+
+func checkArgs(args ArgsStepsWatchdog) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.AlertHandler) {
+		return ErrNilAlertHandler
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	switch args.Action {
+	case AlertAction, ResetAction:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidAction, args.Action)
+	}
+	for identifier, maxDwellTime := range args.MaxDwellTimes {
+		if maxDwellTime < time.Second {
+			return fmt.Errorf("%w for the maximum dwell time of step %q, got: %v, minimum: %v",
+				ErrInvalidValue, identifier, maxDwellTime, time.Second)
+		}
+	}
+
+	return nil
+}
+
+// CheckStep records the dwell time in the provided step and returns true if the state machine should be forced
+// back into its start step
+func (watchdog *stepsWatchdog) CheckStep(identifier core.StepIdentifier) bool {
+	now := watchdog.timer.NowUnix()
+
+	watchdog.mut.Lock()
+	defer watchdog.mut.Unlock()
+
+	if watchdog.currentStep != identifier {
+		watchdog.currentStep = identifier
+		watchdog.enteredAt = now
+		watchdog.breachReported = false
+		watchdog.numEntries[identifier]++
+	}
+
+	dwellTime := time.Duration(now-watchdog.enteredAt) * time.Second
+	numEntries := watchdog.numEntries[identifier]
+	watchdog.statusHandler.SetIntMetric(core.MetricCurrentStepDwellTimeInSeconds, int(dwellTime.Seconds()))
+	watchdog.statusHandler.SetIntMetric(core.MetricCurrentStepNumEntries, numEntries)
+
+	maxDwellTime, found := watchdog.maxDwellTimes[identifier]
+	if !found || dwellTime <= maxDwellTime {
+		return false
+	}
+
+	if !watchdog.breachReported {
+		watchdog.breachReported = true
+		watchdog.statusHandler.AddIntMetric(core.MetricNumStuckStepsDetected, 1)
+		watchdog.log.Error(fmt.Sprintf("%s: step exceeded the maximum dwell time", watchdog.stateMachineName),
+			"step", identifier, "dwell time", dwellTime, "maximum dwell time", maxDwellTime,
+			"num entries", numEntries, "action", watchdog.action)
+	}
+
+	watchdog.alertHandler.Alert(core.Alert{
+		Type:     core.AlertStuckStep,
+		Severity: core.AlertSeverityCritical,
+		Source:   watchdog.stateMachineName,
+		Message: fmt.Sprintf("step %q exceeded the maximum dwell time of %v: dwell time %v, num entries %d, action %s",
+			identifier, maxDwellTime, dwellTime, numEntries, watchdog.action),
+	})
+
+	if watchdog.action != ResetAction {
+		return false
+	}
+
+	watchdog.currentStep = ""
+
+	return true
+}
+
+// DwellTime returns the time spent so far in the current step
+func (watchdog *stepsWatchdog) DwellTime() time.Duration {
+	now := watchdog.timer.NowUnix()
+
+	watchdog.mut.RLock()
+	defer watchdog.mut.RUnlock()
+
+	if len(watchdog.currentStep) == 0 {
+		return 0
+	}
+
+	return time.Duration(now-watchdog.enteredAt) * time.Second
+}
+
+// NumEntries returns how many times the state machine entered the provided step
+func (watchdog *stepsWatchdog) NumEntries(identifier core.StepIdentifier) int {
+	watchdog.mut.RLock()
+	defer watchdog.mut.RUnlock()
+
+	return watchdog.numEntries[identifier]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watchdog *stepsWatchdog) IsInterfaceNil() bool {
+	return watchdog == nil
+}
@@ -0,0 +1,216 @@
+package watchdog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const stuckStep = core.StepIdentifier("stuck step")
+const otherStep = core.StepIdentifier("other step")
+
+func createMockArgsStepsWatchdog() ArgsStepsWatchdog {
+	return ArgsStepsWatchdog{
+		StateMachineName: "test",
+		Log:              &testsCommon.LoggerStub{},
+		StatusHandler:    testsCommon.NewStatusHandlerMock("test"),
+		AlertHandler:     &testsCommon.AlertHandlerStub{},
+		Timer:            testsCommon.NewTimerStub(),
+		Action:           AlertAction,
+		MaxDwellTimes: map[core.StepIdentifier]time.Duration{
+			stuckStep: time.Minute,
+		},
+	}
+}
+
+func TestNewStepsWatchdog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.Log = nil
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.StatusHandler = nil
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil alert handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.AlertHandler = nil
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.Equal(t, ErrNilAlertHandler, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.Timer = nil
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("invalid action should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.Action = "restart"
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.True(t, errors.Is(err, ErrInvalidAction))
+		assert.True(t, strings.Contains(err.Error(), "restart"))
+	})
+	t.Run("invalid max dwell time should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.MaxDwellTimes[otherStep] = time.Millisecond
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.True(t, check.IfNil(watchdog))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), string(otherStep)))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+
+		watchdog, err := NewStepsWatchdog(args)
+		assert.False(t, check.IfNil(watchdog))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStepsWatchdog_CheckStep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should record dwell times and entries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		now := int64(1000)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				assert.Fail(t, "should have not called alert")
+			},
+		}
+		watchdog, _ := NewStepsWatchdog(args)
+
+		assert.False(t, watchdog.CheckStep(otherStep))
+		now += 10
+		assert.False(t, watchdog.CheckStep(otherStep))
+		assert.Equal(t, 10, statusHandler.GetIntMetric(core.MetricCurrentStepDwellTimeInSeconds))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricCurrentStepNumEntries))
+		assert.Equal(t, time.Second*10, watchdog.DwellTime())
+
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		now += 60
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		assert.Equal(t, 60, statusHandler.GetIntMetric(core.MetricCurrentStepDwellTimeInSeconds))
+
+		assert.False(t, watchdog.CheckStep(otherStep))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricCurrentStepDwellTimeInSeconds))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricCurrentStepNumEntries))
+		assert.Equal(t, 2, watchdog.NumEntries(otherStep))
+		assert.Equal(t, 1, watchdog.NumEntries(stuckStep))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumStuckStepsDetected))
+	})
+	t.Run("alert action should only alert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		now := int64(1000)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		numAlerts := 0
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				numAlerts++
+				assert.Equal(t, core.AlertStuckStep, alert.Type)
+				assert.Equal(t, core.AlertSeverityCritical, alert.Severity)
+				assert.Equal(t, "test", alert.Source)
+				assert.True(t, strings.Contains(alert.Message, string(stuckStep)))
+			},
+		}
+		watchdog, _ := NewStepsWatchdog(args)
+
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		now += 61
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		now += 10
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		assert.Equal(t, 2, numAlerts)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumStuckStepsDetected))
+		assert.Equal(t, 71, statusHandler.GetIntMetric(core.MetricCurrentStepDwellTimeInSeconds))
+	})
+	t.Run("reset action should force the start step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStepsWatchdog()
+		args.Action = ResetAction
+		now := int64(1000)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		numAlerts := 0
+		args.AlertHandler = &testsCommon.AlertHandlerStub{
+			AlertCalled: func(alert core.Alert) {
+				numAlerts++
+			},
+		}
+		watchdog, _ := NewStepsWatchdog(args)
+
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		now += 60
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		now++
+		assert.True(t, watchdog.CheckStep(stuckStep))
+		assert.Equal(t, 1, numAlerts)
+		assert.Equal(t, time.Duration(0), watchdog.DwellTime())
+
+		assert.False(t, watchdog.CheckStep(otherStep))
+		assert.False(t, watchdog.CheckStep(stuckStep))
+		assert.Equal(t, 2, watchdog.NumEntries(stuckStep))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumStuckStepsDetected))
+	})
+}
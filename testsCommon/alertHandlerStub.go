@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// AlertHandlerStub -
+type AlertHandlerStub struct {
+	AlertCalled func(alert core.Alert)
+}
+
+// Alert -
+func (stub *AlertHandlerStub) Alert(alert core.Alert) {
+	if stub.AlertCalled != nil {
+		stub.AlertCalled(alert)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AlertHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
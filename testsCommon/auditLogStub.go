@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/api/shared"
+
+// AuditLogStub -
+type AuditLogStub struct {
+	LogCalled func(entry shared.AuditEntry) error
+}
+
+// Log -
+func (stub *AuditLogStub) Log(entry shared.AuditEntry) error {
+	if stub.LogCalled != nil {
+		return stub.LogCalled(entry)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AuditLogStub) IsInterfaceNil() bool {
+	return stub == nil
+}
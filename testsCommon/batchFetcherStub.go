@@ -0,0 +1,27 @@
+package testsCommon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+)
+
+// BatchFetcherStub -
+type BatchFetcherStub struct {
+	GetBatchCalled func(ctx context.Context, batchID uint64) (*clients.TransferBatch, error)
+}
+
+// GetBatch -
+func (stub *BatchFetcherStub) GetBatch(ctx context.Context, batchID uint64) (*clients.TransferBatch, error) {
+	if stub.GetBatchCalled != nil {
+		return stub.GetBatchCalled(ctx, batchID)
+	}
+
+	return nil, errors.New("method not implemented")
+}
+
+// IsInterfaceNil -
+func (stub *BatchFetcherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// BatchFingerprintProcessorStub -
+type BatchFingerprintProcessorStub struct {
+	ProcessBatchFingerprintCalled func(publicKey []byte, fingerprint *core.BatchFingerprint)
+}
+
+// ProcessBatchFingerprint -
+func (stub *BatchFingerprintProcessorStub) ProcessBatchFingerprint(publicKey []byte, fingerprint *core.BatchFingerprint) {
+	if stub.ProcessBatchFingerprintCalled != nil {
+		stub.ProcessBatchFingerprintCalled(publicKey, fingerprint)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BatchFingerprintProcessorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package bridge
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/clients"
+
+// BatchFingerprintCheckerStub -
+type BatchFingerprintCheckerStub struct {
+	CheckAgreementCalled func(batch *clients.TransferBatch) (bool, error)
+}
+
+// CheckAgreement -
+func (stub *BatchFingerprintCheckerStub) CheckAgreement(batch *clients.TransferBatch) (bool, error) {
+	if stub.CheckAgreementCalled != nil {
+		return stub.CheckAgreementCalled(batch)
+	}
+
+	return false, nil
+}
+
+// IsInterfaceNil -
+func (stub *BatchFingerprintCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -53,6 +53,7 @@ type BridgeExecutorStub struct {
 	ResetRetriesCountOnEthereumCalled                      func()
 	ClearStoredP2PSignaturesForEthereumCalled              func()
 	ValidateBatchCalled                                    func(ctx context.Context, batch *clients.TransferBatch) (bool, error)
+	IsBatchFingerprintAgreedCalled                         func() (bool, error)
 	CheckElrondClientAvailabilityCalled                    func(ctx context.Context) error
 	CheckEthereumClientAvailabilityCalled                  func(ctx context.Context) error
 }
@@ -381,6 +382,15 @@ func (stub *BridgeExecutorStub) ValidateBatch(ctx context.Context, batch *client
 	return false, notImplemented
 }
 
+// IsBatchFingerprintAgreed -
+func (stub *BridgeExecutorStub) IsBatchFingerprintAgreed() (bool, error) {
+	stub.incrementFunctionCounter()
+	if stub.IsBatchFingerprintAgreedCalled != nil {
+		return stub.IsBatchFingerprintAgreedCalled()
+	}
+	return false, notImplemented
+}
+
 // CheckElrondClientAvailability -
 func (stub *BridgeExecutorStub) CheckElrondClientAvailability(ctx context.Context) error {
 	if stub.CheckElrondClientAvailabilityCalled != nil {
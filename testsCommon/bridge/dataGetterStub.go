@@ -6,9 +6,10 @@ import (
 
 // DataGetterStub -
 type DataGetterStub struct {
-	GetTokenIdForErc20AddressCalled func(ctx context.Context, erc20Address []byte) ([][]byte, error)
-	GetERC20AddressForTokenIdCalled func(ctx context.Context, tokenId []byte) ([][]byte, error)
-	GetAllStakedRelayersCalled      func(ctx context.Context) ([][]byte, error)
+	GetTokenIdForErc20AddressCalled  func(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenIdCalled  func(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetAllStakedRelayersCalled       func(ctx context.Context) ([][]byte, error)
+	GetCurrentBatchAsDataBytesCalled func(ctx context.Context) ([][]byte, error)
 }
 
 // GetTokenIdForErc20Address -
@@ -36,6 +37,15 @@ func (stub *DataGetterStub) GetAllStakedRelayers(ctx context.Context) ([][]byte,
 	return make([][]byte, 0), nil
 }
 
+// GetCurrentBatchAsDataBytes -
+func (stub *DataGetterStub) GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error) {
+	if stub.GetCurrentBatchAsDataBytesCalled != nil {
+		return stub.GetCurrentBatchAsDataBytesCalled(ctx)
+	}
+
+	return make([][]byte, 0), nil
+}
+
 // IsInterfaceNil -
 func (stub *DataGetterStub) IsInterfaceNil() bool {
 	return stub == nil
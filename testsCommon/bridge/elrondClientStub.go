@@ -29,6 +29,7 @@ type ElrondClientStub struct {
 	SignCalled                                     func(ctx context.Context, actionID uint64) (string, error)
 	WasSignedCalled                                func(ctx context.Context, actionID uint64) (bool, error)
 	PerformActionCalled                            func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error)
+	WaitForTransactionOutcomeCalled                func(ctx context.Context, hash string) error
 	CheckClientAvailabilityCalled                  func(ctx context.Context) error
 	CloseCalled                                    func() error
 }
@@ -186,6 +187,15 @@ func (stub *ElrondClientStub) PerformAction(ctx context.Context, actionID uint64
 	return "", nil
 }
 
+// WaitForTransactionOutcome -
+func (stub *ElrondClientStub) WaitForTransactionOutcome(ctx context.Context, hash string) error {
+	if stub.WaitForTransactionOutcomeCalled != nil {
+		return stub.WaitForTransactionOutcomeCalled(ctx, hash)
+	}
+
+	return nil
+}
+
 // CheckClientAvailability -
 func (stub *ElrondClientStub) CheckClientAvailability(ctx context.Context) error {
 	if stub.CheckClientAvailabilityCalled != nil {
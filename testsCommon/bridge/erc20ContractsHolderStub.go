@@ -9,7 +9,10 @@ import (
 
 // ERC20ContractsHolderStub -
 type ERC20ContractsHolderStub struct {
-	BalanceOfCalled func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error)
+	BalanceOfCalled       func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error)
+	TotalSupplyCalled     func(ctx context.Context, erc20Address common.Address) (*big.Int, error)
+	CapCalled             func(ctx context.Context, erc20Address common.Address) (*big.Int, error)
+	MinterAllowanceCalled func(ctx context.Context, erc20Address common.Address, minter common.Address) (*big.Int, error)
 }
 
 // BalanceOf -
@@ -21,6 +24,33 @@ func (stub *ERC20ContractsHolderStub) BalanceOf(ctx context.Context, erc20Addres
 	return big.NewInt(0), nil
 }
 
+// TotalSupply -
+func (stub *ERC20ContractsHolderStub) TotalSupply(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+	if stub.TotalSupplyCalled != nil {
+		return stub.TotalSupplyCalled(ctx, erc20Address)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// Cap -
+func (stub *ERC20ContractsHolderStub) Cap(ctx context.Context, erc20Address common.Address) (*big.Int, error) {
+	if stub.CapCalled != nil {
+		return stub.CapCalled(ctx, erc20Address)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// MinterAllowance -
+func (stub *ERC20ContractsHolderStub) MinterAllowance(ctx context.Context, erc20Address common.Address, minter common.Address) (*big.Int, error) {
+	if stub.MinterAllowanceCalled != nil {
+		return stub.MinterAllowanceCalled(ctx, erc20Address, minter)
+	}
+
+	return big.NewInt(0), nil
+}
+
 // IsInterfaceNil -
 func (stub *ERC20ContractsHolderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -14,6 +14,7 @@ type EthereumClientStub struct {
 	WasExecutedCalled                      func(ctx context.Context, batchID uint64) (bool, error)
 	GenerateMessageHashCalled              func(batch *clients.TransferBatch) (common.Hash, error)
 	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash)
+	RequestSignaturesForMessageHashCalled  func(msgHash common.Hash)
 	ExecuteTransferCalled                  func(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error)
 	CheckClientAvailabilityCalled          func(ctx context.Context) error
 	GetTransactionsStatusesCalled          func(ctx context.Context, batchId uint64) ([]byte, error)
@@ -55,6 +56,13 @@ func (stub *EthereumClientStub) BroadcastSignatureForMessageHash(msgHash common.
 	}
 }
 
+// RequestSignaturesForMessageHash -
+func (stub *EthereumClientStub) RequestSignaturesForMessageHash(msgHash common.Hash) {
+	if stub.RequestSignaturesForMessageHashCalled != nil {
+		stub.RequestSignaturesForMessageHashCalled(msgHash)
+	}
+}
+
 // ExecuteTransfer -
 func (stub *EthereumClientStub) ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error) {
 	if stub.ExecuteTransferCalled != nil {
@@ -0,0 +1,22 @@
+package bridge
+
+import "context"
+
+// OutcomeTrackerStub -
+type OutcomeTrackerStub struct {
+	WaitForOutcomeCalled func(ctx context.Context, hash string) error
+}
+
+// WaitForOutcome -
+func (stub *OutcomeTrackerStub) WaitForOutcome(ctx context.Context, hash string) error {
+	if stub.WaitForOutcomeCalled != nil {
+		return stub.WaitForOutcomeCalled(ctx, hash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *OutcomeTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
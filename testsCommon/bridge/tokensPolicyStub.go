@@ -0,0 +1,52 @@
+package bridge
+
+import "math/big"
+
+// TokensPolicyStub -
+type TokensPolicyStub struct {
+	IsEnforcedCalled           func() bool
+	CheckEthereumDepositCalled func(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error
+	CheckElrondDepositCalled   func(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error
+	BalanceCheckModeCalled     func(erc20Address []byte, esdtTokenID []byte) string
+}
+
+// IsEnforced -
+func (stub *TokensPolicyStub) IsEnforced() bool {
+	if stub.IsEnforcedCalled != nil {
+		return stub.IsEnforcedCalled()
+	}
+
+	return false
+}
+
+// CheckEthereumDeposit -
+func (stub *TokensPolicyStub) CheckEthereumDeposit(erc20Address []byte, esdtTokenID []byte, amount *big.Int) error {
+	if stub.CheckEthereumDepositCalled != nil {
+		return stub.CheckEthereumDepositCalled(erc20Address, esdtTokenID, amount)
+	}
+
+	return nil
+}
+
+// CheckElrondDeposit -
+func (stub *TokensPolicyStub) CheckElrondDeposit(esdtTokenID []byte, erc20Address []byte, amount *big.Int) error {
+	if stub.CheckElrondDepositCalled != nil {
+		return stub.CheckElrondDepositCalled(esdtTokenID, erc20Address, amount)
+	}
+
+	return nil
+}
+
+// BalanceCheckMode -
+func (stub *TokensPolicyStub) BalanceCheckMode(erc20Address []byte, esdtTokenID []byte) string {
+	if stub.BalanceCheckModeCalled != nil {
+		return stub.BalanceCheckModeCalled(erc20Address, esdtTokenID)
+	}
+
+	return "safeBalance"
+}
+
+// IsInterfaceNil -
+func (stub *TokensPolicyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,60 @@
+package testsCommon
+
+import "context"
+
+// BridgeOperatorStub -
+type BridgeOperatorStub struct {
+	PauseStateMachineCalled     func(name string) error
+	ResumeStateMachineCalled    func(name string) error
+	ForceStartStepCalled        func(ctx context.Context, name string) error
+	ClearStoredSignaturesCalled func()
+	RefreshRoleProvidersCalled  func(ctx context.Context) error
+}
+
+// PauseStateMachine -
+func (stub *BridgeOperatorStub) PauseStateMachine(name string) error {
+	if stub.PauseStateMachineCalled != nil {
+		return stub.PauseStateMachineCalled(name)
+	}
+
+	return nil
+}
+
+// ResumeStateMachine -
+func (stub *BridgeOperatorStub) ResumeStateMachine(name string) error {
+	if stub.ResumeStateMachineCalled != nil {
+		return stub.ResumeStateMachineCalled(name)
+	}
+
+	return nil
+}
+
+// ForceStartStep -
+func (stub *BridgeOperatorStub) ForceStartStep(ctx context.Context, name string) error {
+	if stub.ForceStartStepCalled != nil {
+		return stub.ForceStartStepCalled(ctx, name)
+	}
+
+	return nil
+}
+
+// ClearStoredSignatures -
+func (stub *BridgeOperatorStub) ClearStoredSignatures() {
+	if stub.ClearStoredSignaturesCalled != nil {
+		stub.ClearStoredSignaturesCalled()
+	}
+}
+
+// RefreshRoleProviders -
+func (stub *BridgeOperatorStub) RefreshRoleProviders(ctx context.Context) error {
+	if stub.RefreshRoleProvidersCalled != nil {
+		return stub.RefreshRoleProvidersCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *BridgeOperatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -4,12 +4,19 @@ import "github.com/ElrondNetwork/elrond-eth-bridge/core"
 
 // BroadcasterStub -
 type BroadcasterStub struct {
-	BroadcastSignatureCalled func(signature []byte, messageHash []byte)
-	BroadcastJoinTopicCalled func()
-	SortedPublicKeysCalled   func() [][]byte
-	RegisterOnTopicsCalled   func() error
-	AddBroadcastClientCalled func(client core.BroadcastClient) error
-	CloseCalled              func() error
+	BroadcastSignatureCalled           func(signature []byte, messageHash []byte)
+	RequestSignaturesCalled            func(messageHash []byte)
+	BroadcastJoinTopicCalled           func()
+	BroadcastLeavingCalled             func()
+	HasLeftCalled                      func(publicKey []byte) bool
+	SortedPublicKeysCalled             func() [][]byte
+	RegisterOnTopicsCalled             func() error
+	AddBroadcastClientCalled           func(client core.BroadcastClient) error
+	BroadcastHeartbeatCalled           func(heartbeat *core.Heartbeat)
+	AddHeartbeatProcessorCalled        func(processor core.HeartbeatProcessor) error
+	BroadcastBatchFingerprintCalled    func(fingerprint *core.BatchFingerprint)
+	AddBatchFingerprintProcessorCalled func(processor core.BatchFingerprintProcessor) error
+	CloseCalled                        func() error
 }
 
 // BroadcastSignature -
@@ -19,6 +26,13 @@ func (bs *BroadcasterStub) BroadcastSignature(signature []byte, messageHash []by
 	}
 }
 
+// RequestSignatures -
+func (bs *BroadcasterStub) RequestSignatures(messageHash []byte) {
+	if bs.RequestSignaturesCalled != nil {
+		bs.RequestSignaturesCalled(messageHash)
+	}
+}
+
 // BroadcastJoinTopic -
 func (bs *BroadcasterStub) BroadcastJoinTopic() {
 	if bs.BroadcastJoinTopicCalled != nil {
@@ -26,6 +40,22 @@ func (bs *BroadcasterStub) BroadcastJoinTopic() {
 	}
 }
 
+// BroadcastLeaving -
+func (bs *BroadcasterStub) BroadcastLeaving() {
+	if bs.BroadcastLeavingCalled != nil {
+		bs.BroadcastLeavingCalled()
+	}
+}
+
+// HasLeft -
+func (bs *BroadcasterStub) HasLeft(publicKey []byte) bool {
+	if bs.HasLeftCalled != nil {
+		return bs.HasLeftCalled(publicKey)
+	}
+
+	return false
+}
+
 // SortedPublicKeys -
 func (bs *BroadcasterStub) SortedPublicKeys() [][]byte {
 	if bs.SortedPublicKeysCalled != nil {
@@ -53,6 +83,38 @@ func (bs *BroadcasterStub) AddBroadcastClient(client core.BroadcastClient) error
 	return nil
 }
 
+// BroadcastHeartbeat -
+func (bs *BroadcasterStub) BroadcastHeartbeat(heartbeat *core.Heartbeat) {
+	if bs.BroadcastHeartbeatCalled != nil {
+		bs.BroadcastHeartbeatCalled(heartbeat)
+	}
+}
+
+// AddHeartbeatProcessor -
+func (bs *BroadcasterStub) AddHeartbeatProcessor(processor core.HeartbeatProcessor) error {
+	if bs.AddHeartbeatProcessorCalled != nil {
+		return bs.AddHeartbeatProcessorCalled(processor)
+	}
+
+	return nil
+}
+
+// BroadcastBatchFingerprint -
+func (bs *BroadcasterStub) BroadcastBatchFingerprint(fingerprint *core.BatchFingerprint) {
+	if bs.BroadcastBatchFingerprintCalled != nil {
+		bs.BroadcastBatchFingerprintCalled(fingerprint)
+	}
+}
+
+// AddBatchFingerprintProcessor -
+func (bs *BroadcasterStub) AddBatchFingerprintProcessor(processor core.BatchFingerprintProcessor) error {
+	if bs.AddBatchFingerprintProcessorCalled != nil {
+		return bs.AddBatchFingerprintProcessorCalled(processor)
+	}
+
+	return nil
+}
+
 // Close -
 func (bs *BroadcasterStub) Close() error {
 	if bs.CloseCalled() != nil {
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// ClusterInfoProviderStub -
+type ClusterInfoProviderStub struct {
+	ClusterInfoCalled func() []core.RelayerStatus
+}
+
+// ClusterInfo -
+func (stub *ClusterInfoProviderStub) ClusterInfo() []core.RelayerStatus {
+	if stub.ClusterInfoCalled != nil {
+		return stub.ClusterInfoCalled()
+	}
+
+	return make([]core.RelayerStatus, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ClusterInfoProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
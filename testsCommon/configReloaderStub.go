@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// ConfigReloaderStub -
+type ConfigReloaderStub struct {
+	ReloadConfigCalled func() (core.ConfigReloadReport, error)
+}
+
+// ReloadConfig -
+func (stub *ConfigReloaderStub) ReloadConfig() (core.ConfigReloadReport, error) {
+	if stub.ReloadConfigCalled != nil {
+		return stub.ReloadConfigCalled()
+	}
+
+	return core.ConfigReloadReport{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ConfigReloaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -1,6 +1,8 @@
 package facade
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 )
 
@@ -10,6 +12,21 @@ type RelayerFacadeStub struct {
 	GetMetricsListCalled   func() core.GeneralMetrics
 	RestApiInterfaceCalled func() string
 	PprofEnabledCalled     func() bool
+
+	GetStateMachineHistoryCalled func(name string) ([]core.StepTransition, error)
+	ReloadConfigCalled           func() (core.ConfigReloadReport, error)
+
+	PauseStateMachineCalled     func(name string) error
+	ResumeStateMachineCalled    func(name string) error
+	ForceStartStepCalled        func(ctx context.Context, name string) error
+	ClearStoredSignaturesCalled func()
+	RefreshRoleProvidersCalled  func(ctx context.Context) error
+	SetLogLevelCalled           func(logLevel string) error
+
+	GetLivenessCalled    func() core.HealthReport
+	GetReadinessCalled   func() core.HealthReport
+	GetPeerScoresCalled  func() []core.PeerScore
+	GetClusterInfoCalled func() []core.RelayerStatus
 }
 
 // GetMetrics -
@@ -46,6 +63,112 @@ func (stub *RelayerFacadeStub) PprofEnabled() bool {
 	return false
 }
 
+// GetStateMachineHistory -
+func (stub *RelayerFacadeStub) GetStateMachineHistory(name string) ([]core.StepTransition, error) {
+	if stub.GetStateMachineHistoryCalled != nil {
+		return stub.GetStateMachineHistoryCalled(name)
+	}
+
+	return make([]core.StepTransition, 0), nil
+}
+
+// ReloadConfig -
+func (stub *RelayerFacadeStub) ReloadConfig() (core.ConfigReloadReport, error) {
+	if stub.ReloadConfigCalled != nil {
+		return stub.ReloadConfigCalled()
+	}
+
+	return core.ConfigReloadReport{}, nil
+}
+
+// PauseStateMachine -
+func (stub *RelayerFacadeStub) PauseStateMachine(name string) error {
+	if stub.PauseStateMachineCalled != nil {
+		return stub.PauseStateMachineCalled(name)
+	}
+
+	return nil
+}
+
+// ResumeStateMachine -
+func (stub *RelayerFacadeStub) ResumeStateMachine(name string) error {
+	if stub.ResumeStateMachineCalled != nil {
+		return stub.ResumeStateMachineCalled(name)
+	}
+
+	return nil
+}
+
+// ForceStartStep -
+func (stub *RelayerFacadeStub) ForceStartStep(ctx context.Context, name string) error {
+	if stub.ForceStartStepCalled != nil {
+		return stub.ForceStartStepCalled(ctx, name)
+	}
+
+	return nil
+}
+
+// ClearStoredSignatures -
+func (stub *RelayerFacadeStub) ClearStoredSignatures() {
+	if stub.ClearStoredSignaturesCalled != nil {
+		stub.ClearStoredSignaturesCalled()
+	}
+}
+
+// RefreshRoleProviders -
+func (stub *RelayerFacadeStub) RefreshRoleProviders(ctx context.Context) error {
+	if stub.RefreshRoleProvidersCalled != nil {
+		return stub.RefreshRoleProvidersCalled(ctx)
+	}
+
+	return nil
+}
+
+// SetLogLevel -
+func (stub *RelayerFacadeStub) SetLogLevel(logLevel string) error {
+	if stub.SetLogLevelCalled != nil {
+		return stub.SetLogLevelCalled(logLevel)
+	}
+
+	return nil
+}
+
+// GetLiveness -
+func (stub *RelayerFacadeStub) GetLiveness() core.HealthReport {
+	if stub.GetLivenessCalled != nil {
+		return stub.GetLivenessCalled()
+	}
+
+	return core.HealthReport{}
+}
+
+// GetReadiness -
+func (stub *RelayerFacadeStub) GetReadiness() core.HealthReport {
+	if stub.GetReadinessCalled != nil {
+		return stub.GetReadinessCalled()
+	}
+
+	return core.HealthReport{}
+}
+
+// GetPeerScores -
+func (stub *RelayerFacadeStub) GetPeerScores() []core.PeerScore {
+	if stub.GetPeerScoresCalled != nil {
+		return stub.GetPeerScoresCalled()
+	}
+
+	return make([]core.PeerScore, 0)
+}
+
+// GetClusterInfo -
+func (stub *RelayerFacadeStub) GetClusterInfo() []core.RelayerStatus {
+	if stub.GetClusterInfoCalled != nil {
+		return stub.GetClusterInfoCalled()
+	}
+
+	return make([]core.RelayerStatus, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (stub *RelayerFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
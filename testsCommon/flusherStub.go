@@ -0,0 +1,22 @@
+package testsCommon
+
+import "context"
+
+// FlusherStub -
+type FlusherStub struct {
+	FlushCalled func(ctx context.Context) error
+}
+
+// Flush -
+func (stub *FlusherStub) Flush(ctx context.Context) error {
+	if stub.FlushCalled != nil {
+		return stub.FlushCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *FlusherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -16,3 +16,13 @@ func CreateTestElrondGasMap() config.ElrondGasMapConfig {
 		PerformActionForEach:   107,
 	}
 }
+
+// CreateTestElrondTransactionOutcomeConfig will create an Elrond transaction outcome config for testing purposes
+func CreateTestElrondTransactionOutcomeConfig() config.ElrondTransactionOutcomeConfig {
+	return config.ElrondTransactionOutcomeConfig{
+		PollingIntervalInMillis: 100,
+		MaxWaitInSeconds:        10,
+		OutOfGasIncreasePercent: 20,
+		MaxOutOfGasIncreases:    3,
+	}
+}
@@ -0,0 +1,32 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// HealthCheckerStub -
+type HealthCheckerStub struct {
+	LivenessCalled  func() core.HealthReport
+	ReadinessCalled func() core.HealthReport
+}
+
+// Liveness -
+func (stub *HealthCheckerStub) Liveness() core.HealthReport {
+	if stub.LivenessCalled != nil {
+		return stub.LivenessCalled()
+	}
+
+	return core.HealthReport{Healthy: true}
+}
+
+// Readiness -
+func (stub *HealthCheckerStub) Readiness() core.HealthReport {
+	if stub.ReadinessCalled != nil {
+		return stub.ReadinessCalled()
+	}
+
+	return core.HealthReport{Healthy: true}
+}
+
+// IsInterfaceNil -
+func (stub *HealthCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// HeartbeatProcessorStub -
+type HeartbeatProcessorStub struct {
+	ProcessHeartbeatCalled func(publicKey []byte, heartbeat *core.Heartbeat)
+}
+
+// ProcessHeartbeat -
+func (stub *HeartbeatProcessorStub) ProcessHeartbeat(publicKey []byte, heartbeat *core.Heartbeat) {
+	if stub.ProcessHeartbeatCalled != nil {
+		stub.ProcessHeartbeatCalled(publicKey, heartbeat)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *HeartbeatProcessorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
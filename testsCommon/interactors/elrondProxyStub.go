@@ -10,13 +10,15 @@ import (
 
 // ElrondProxyStub -
 type ElrondProxyStub struct {
-	GetNetworkConfigCalled  func(ctx context.Context) (*data.NetworkConfig, error)
-	SendTransactionCalled   func(ctx context.Context, transaction *data.Transaction) (string, error)
-	SendTransactionsCalled  func(ctx context.Context, txs []*data.Transaction) ([]string, error)
-	ExecuteVMQueryCalled    func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error)
-	GetAccountCalled        func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
-	GetNetworkStatusCalled  func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
-	GetShardOfAddressCalled func(ctx context.Context, bech32Address string) (uint32, error)
+	GetNetworkConfigCalled              func(ctx context.Context) (*data.NetworkConfig, error)
+	SendTransactionCalled               func(ctx context.Context, transaction *data.Transaction) (string, error)
+	SendTransactionsCalled              func(ctx context.Context, txs []*data.Transaction) ([]string, error)
+	ExecuteVMQueryCalled                func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error)
+	GetAccountCalled                    func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetNetworkStatusCalled              func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetShardOfAddressCalled             func(ctx context.Context, bech32Address string) (uint32, error)
+	GetTransactionInfoWithResultsCalled func(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	RequestTransactionCostCalled        func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // GetNetworkConfig -
@@ -82,6 +84,24 @@ func (eps *ElrondProxyStub) GetShardOfAddress(ctx context.Context, bech32Address
 	return 0, fmt.Errorf("not implemented")
 }
 
+// GetTransactionInfoWithResults -
+func (eps *ElrondProxyStub) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	if eps.GetTransactionInfoWithResultsCalled != nil {
+		return eps.GetTransactionInfoWithResultsCalled(ctx, hash)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+// RequestTransactionCost -
+func (eps *ElrondProxyStub) RequestTransactionCost(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	if eps.RequestTransactionCostCalled != nil {
+		return eps.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
 // IsInterfaceNil -
 func (eps *ElrondProxyStub) IsInterfaceNil() bool {
 	return eps == nil
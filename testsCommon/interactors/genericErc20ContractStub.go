@@ -10,7 +10,10 @@ import (
 
 // GenericErc20ContractStub -
 type GenericErc20ContractStub struct {
-	BalanceOfCalled func(account common.Address) (*big.Int, error)
+	BalanceOfCalled       func(account common.Address) (*big.Int, error)
+	TotalSupplyCalled     func() (*big.Int, error)
+	CapCalled             func() (*big.Int, error)
+	MinterAllowanceCalled func(minter common.Address) (*big.Int, error)
 }
 
 // BalanceOf -
@@ -21,3 +24,30 @@ func (stub *GenericErc20ContractStub) BalanceOf(_ *bind.CallOpts, account common
 
 	return nil, errors.New("GenericErc20ContractStub.BalanceOf not implemented")
 }
+
+// TotalSupply -
+func (stub *GenericErc20ContractStub) TotalSupply(_ *bind.CallOpts) (*big.Int, error) {
+	if stub.TotalSupplyCalled != nil {
+		return stub.TotalSupplyCalled()
+	}
+
+	return nil, errors.New("GenericErc20ContractStub.TotalSupply not implemented")
+}
+
+// Cap -
+func (stub *GenericErc20ContractStub) Cap(_ *bind.CallOpts) (*big.Int, error) {
+	if stub.CapCalled != nil {
+		return stub.CapCalled()
+	}
+
+	return nil, errors.New("GenericErc20ContractStub.Cap not implemented")
+}
+
+// MinterAllowance -
+func (stub *GenericErc20ContractStub) MinterAllowance(_ *bind.CallOpts, minter common.Address) (*big.Int, error) {
+	if stub.MinterAllowanceCalled != nil {
+		return stub.MinterAllowanceCalled(minter)
+	}
+
+	return nil, errors.New("GenericErc20ContractStub.MinterAllowance not implemented")
+}
@@ -0,0 +1,36 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// NotifierStub -
+type NotifierStub struct {
+	NotifyCalled func(ctx context.Context, alert core.Alert) error
+	NameCalled   func() string
+}
+
+// Notify -
+func (stub *NotifierStub) Notify(ctx context.Context, alert core.Alert) error {
+	if stub.NotifyCalled != nil {
+		return stub.NotifyCalled(ctx, alert)
+	}
+
+	return nil
+}
+
+// Name -
+func (stub *NotifierStub) Name() string {
+	if stub.NameCalled != nil {
+		return stub.NameCalled()
+	}
+
+	return "stub"
+}
+
+// IsInterfaceNil -
+func (stub *NotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package p2p
+
+import (
+	"time"
+
+	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// PeerDenialEvaluatorStub -
+type PeerDenialEvaluatorStub struct {
+	IsDeniedCalled     func(pid elrondCore.PeerID) bool
+	UpsertPeerIDCalled func(pid elrondCore.PeerID, duration time.Duration) error
+}
+
+// IsDenied -
+func (stub *PeerDenialEvaluatorStub) IsDenied(pid elrondCore.PeerID) bool {
+	if stub.IsDeniedCalled != nil {
+		return stub.IsDeniedCalled(pid)
+	}
+
+	return false
+}
+
+// UpsertPeerID -
+func (stub *PeerDenialEvaluatorStub) UpsertPeerID(pid elrondCore.PeerID, duration time.Duration) error {
+	if stub.UpsertPeerIDCalled != nil {
+		return stub.UpsertPeerIDCalled(pid, duration)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerDenialEvaluatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
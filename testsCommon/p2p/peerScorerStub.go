@@ -0,0 +1,20 @@
+package p2p
+
+import elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+
+// PeerScorerStub -
+type PeerScorerStub struct {
+	PenalizeCalled func(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string)
+}
+
+// Penalize -
+func (stub *PeerScorerStub) Penalize(pid elrondCore.PeerID, publicKey []byte, penalty uint32, reason string) {
+	if stub.PenalizeCalled != nil {
+		stub.PenalizeCalled(pid, publicKey, penalty, reason)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PeerScorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
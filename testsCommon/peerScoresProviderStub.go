@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// PeerScoresProviderStub -
+type PeerScoresProviderStub struct {
+	PeerScoresCalled func() []core.PeerScore
+}
+
+// PeerScores -
+func (stub *PeerScoresProviderStub) PeerScores() []core.PeerScore {
+	if stub.PeerScoresCalled != nil {
+		return stub.PeerScoresCalled()
+	}
+
+	return make([]core.PeerScore, 0)
+}
+
+// IsInterfaceNil -
+func (stub *PeerScoresProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
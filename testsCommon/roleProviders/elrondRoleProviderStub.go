@@ -1,10 +1,16 @@
 package roleProviders
 
-import "github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+)
 
 // ElrondRoleProviderStub -
 type ElrondRoleProviderStub struct {
-	IsWhitelistedCalled func(address core.AddressHandler) bool
+	IsWhitelistedCalled    func(address core.AddressHandler) bool
+	LastUpdateTimeCalled   func() time.Time
+	SortedPublicKeysCalled func() [][]byte
 }
 
 // IsWhitelisted -
@@ -16,6 +22,24 @@ func (stub *ElrondRoleProviderStub) IsWhitelisted(address core.AddressHandler) b
 	return true
 }
 
+// LastUpdateTime -
+func (stub *ElrondRoleProviderStub) LastUpdateTime() time.Time {
+	if stub.LastUpdateTimeCalled != nil {
+		return stub.LastUpdateTimeCalled()
+	}
+
+	return time.Now()
+}
+
+// SortedPublicKeys -
+func (stub *ElrondRoleProviderStub) SortedPublicKeys() [][]byte {
+	if stub.SortedPublicKeysCalled != nil {
+		return stub.SortedPublicKeysCalled()
+	}
+
+	return make([][]byte, 0)
+}
+
 // IsInterfaceNil -
 func (stub *ElrondRoleProviderStub) IsInterfaceNil() bool {
 	return stub == nil
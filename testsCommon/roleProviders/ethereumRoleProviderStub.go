@@ -0,0 +1,36 @@
+package roleProviders
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumRoleProviderStub -
+type EthereumRoleProviderStub struct {
+	IsWhitelistedCalled  func(address common.Address) bool
+	LastUpdateTimeCalled func() time.Time
+}
+
+// IsWhitelisted -
+func (stub *EthereumRoleProviderStub) IsWhitelisted(address common.Address) bool {
+	if stub.IsWhitelistedCalled != nil {
+		return stub.IsWhitelistedCalled(address)
+	}
+
+	return true
+}
+
+// LastUpdateTime -
+func (stub *EthereumRoleProviderStub) LastUpdateTime() time.Time {
+	if stub.LastUpdateTimeCalled != nil {
+		return stub.LastUpdateTimeCalled()
+	}
+
+	return time.Now()
+}
+
+// IsInterfaceNil -
+func (stub *EthereumRoleProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package stateMachine
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/clients"
+
+// BatchInfoProviderStub -
+type BatchInfoProviderStub struct {
+	GetStoredBatchCalled    func() *clients.TransferBatch
+	GetStoredActionIDCalled func() uint64
+}
+
+// GetStoredBatch -
+func (stub *BatchInfoProviderStub) GetStoredBatch() *clients.TransferBatch {
+	if stub.GetStoredBatchCalled != nil {
+		return stub.GetStoredBatchCalled()
+	}
+
+	return nil
+}
+
+// GetStoredActionID -
+func (stub *BatchInfoProviderStub) GetStoredActionID() uint64 {
+	if stub.GetStoredActionIDCalled != nil {
+		return stub.GetStoredActionIDCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *BatchInfoProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package stateMachine
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// HintedExecutorStub -
+type HintedExecutorStub struct {
+	ExecuteCalled      func(ctx context.Context) error
+	LastStepHintCalled func() core.StepHint
+}
+
+// Execute -
+func (stub *HintedExecutorStub) Execute(ctx context.Context) error {
+	if stub.ExecuteCalled != nil {
+		return stub.ExecuteCalled(ctx)
+	}
+
+	return nil
+}
+
+// LastStepHint -
+func (stub *HintedExecutorStub) LastStepHint() core.StepHint {
+	if stub.LastStepHintCalled != nil {
+		return stub.LastStepHintCalled()
+	}
+
+	return core.StepHintNone
+}
+
+// IsInterfaceNil -
+func (stub *HintedExecutorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -52,6 +52,9 @@ func (smm *StateMachineMock) Execute(ctx context.Context) error {
 	nextStepIdentifier := smm.CurrentStep.Execute(ctx)
 
 	smm.ExecutedSteps = append(smm.ExecutedSteps, smm.CurrentStep.Identifier())
+	if !isTransitionAllowed(smm.CurrentStep, nextStepIdentifier) {
+		return fmt.Errorf("undeclared transition from '%s' to '%s'", smm.CurrentStep.Identifier(), nextStepIdentifier)
+	}
 
 	nextStep, err := smm.getNextStep(nextStepIdentifier)
 	if err != nil {
@@ -63,6 +66,16 @@ func (smm *StateMachineMock) Execute(ctx context.Context) error {
 	return nil
 }
 
+func isTransitionAllowed(step core.Step, nextStepIdentifier core.StepIdentifier) bool {
+	for _, identifier := range step.AllowedTransitions() {
+		if identifier == nextStepIdentifier {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsInterfaceNil -
 func (smm *StateMachineMock) IsInterfaceNil() bool {
 	return smm == nil
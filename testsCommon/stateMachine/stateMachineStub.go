@@ -0,0 +1,101 @@
+package stateMachine
+
+import (
+	"context"
+	"time"
+)
+
+// StateMachineStub -
+type StateMachineStub struct {
+	ExecuteCalled           func(ctx context.Context) error
+	DrainCalled             func(ctx context.Context) error
+	PauseCalled             func()
+	ResumeCalled            func()
+	IsPausedCalled          func() bool
+	ForceStartStepCalled    func(ctx context.Context) error
+	NameCalled              func() string
+	LastExecutionTimeCalled func() time.Time
+	LastProgressTimeCalled  func() time.Time
+}
+
+// Execute -
+func (stub *StateMachineStub) Execute(ctx context.Context) error {
+	if stub.ExecuteCalled != nil {
+		return stub.ExecuteCalled(ctx)
+	}
+
+	return nil
+}
+
+// Drain -
+func (stub *StateMachineStub) Drain(ctx context.Context) error {
+	if stub.DrainCalled != nil {
+		return stub.DrainCalled(ctx)
+	}
+
+	return nil
+}
+
+// Pause -
+func (stub *StateMachineStub) Pause() {
+	if stub.PauseCalled != nil {
+		stub.PauseCalled()
+	}
+}
+
+// Resume -
+func (stub *StateMachineStub) Resume() {
+	if stub.ResumeCalled != nil {
+		stub.ResumeCalled()
+	}
+}
+
+// ForceStartStep -
+func (stub *StateMachineStub) ForceStartStep(ctx context.Context) error {
+	if stub.ForceStartStepCalled != nil {
+		return stub.ForceStartStepCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsPaused -
+func (stub *StateMachineStub) IsPaused() bool {
+	if stub.IsPausedCalled != nil {
+		return stub.IsPausedCalled()
+	}
+
+	return false
+}
+
+// Name -
+func (stub *StateMachineStub) Name() string {
+	if stub.NameCalled != nil {
+		return stub.NameCalled()
+	}
+
+	return ""
+}
+
+// LastExecutionTime -
+func (stub *StateMachineStub) LastExecutionTime() time.Time {
+	if stub.LastExecutionTimeCalled != nil {
+		return stub.LastExecutionTimeCalled()
+	}
+
+	return time.Now()
+}
+
+// LastProgressTime -
+func (stub *StateMachineStub) LastProgressTime() time.Time {
+	if stub.LastProgressTimeCalled != nil {
+		return stub.LastProgressTimeCalled()
+	}
+
+	return time.Now()
+}
+
+// IsInterfaceNil -
+func (stub *StateMachineStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package stateMachine
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// StepsWatchdogStub -
+type StepsWatchdogStub struct {
+	CheckStepCalled func(identifier core.StepIdentifier) bool
+}
+
+// CheckStep -
+func (stub *StepsWatchdogStub) CheckStep(identifier core.StepIdentifier) bool {
+	if stub.CheckStepCalled != nil {
+		return stub.CheckStepCalled(identifier)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *StepsWatchdogStub) IsInterfaceNil() bool {
+	return stub == nil
+}
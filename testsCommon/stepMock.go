@@ -8,8 +8,9 @@ import (
 
 // StepMock -
 type StepMock struct {
-	ExecuteCalled    func(ctx context.Context) core.StepIdentifier
-	IdentifierCalled func() core.StepIdentifier
+	ExecuteCalled            func(ctx context.Context) core.StepIdentifier
+	IdentifierCalled         func() core.StepIdentifier
+	AllowedTransitionsCalled func() []core.StepIdentifier
 }
 
 // Execute -
@@ -26,6 +27,15 @@ func (sm *StepMock) Identifier() core.StepIdentifier {
 	return ""
 }
 
+// AllowedTransitions -
+func (sm *StepMock) AllowedTransitions() []core.StepIdentifier {
+	if sm.AllowedTransitionsCalled != nil {
+		return sm.AllowedTransitionsCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (sm *StepMock) IsInterfaceNil() bool {
 	return sm == nil
@@ -0,0 +1,42 @@
+package testsCommon
+
+import "github.com/ElrondNetwork/elrond-eth-bridge/core"
+
+// TransitionsHistoryHolderStub -
+type TransitionsHistoryHolderStub struct {
+	AddTransitionsHistoryProviderCalled func(provider core.TransitionsHistoryProvider) error
+	GetAvailableStateMachinesCalled     func() []string
+	GetTransitionsHistoryCalled         func(name string) ([]core.StepTransition, error)
+}
+
+// AddTransitionsHistoryProvider -
+func (stub *TransitionsHistoryHolderStub) AddTransitionsHistoryProvider(provider core.TransitionsHistoryProvider) error {
+	if stub.AddTransitionsHistoryProviderCalled != nil {
+		return stub.AddTransitionsHistoryProviderCalled(provider)
+	}
+
+	return nil
+}
+
+// GetAvailableStateMachines -
+func (stub *TransitionsHistoryHolderStub) GetAvailableStateMachines() []string {
+	if stub.GetAvailableStateMachinesCalled != nil {
+		return stub.GetAvailableStateMachinesCalled()
+	}
+
+	return make([]string, 0)
+}
+
+// GetTransitionsHistory -
+func (stub *TransitionsHistoryHolderStub) GetTransitionsHistory(name string) ([]core.StepTransition, error) {
+	if stub.GetTransitionsHistoryCalled != nil {
+		return stub.GetTransitionsHistoryCalled(name)
+	}
+
+	return make([]core.StepTransition, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *TransitionsHistoryHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}